@@ -413,6 +413,16 @@ func New(
 		keys[feemarkettypes.StoreKey],
 	)
 
+	// The integrity keeper is built before the EVM keeper, which serves it through the integrity precompile.
+	app.IntegrityKeeper = integritykeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[integritytypes.StoreKey]),
+		appCodec,
+		app.AccountKeeper.AddressCodec(),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper,
+		app.DistrKeeper,
+	)
+
 	tracer := cast.ToString(appOpts.Get(srvflags.EVMTracer))
 	app.EVMKeeper = evmkeeper.NewKeeper(
 		appCodec,
@@ -428,7 +438,7 @@ func New(
 		&app.Erc20Keeper,
 		evmChainID,
 		tracer,
-	).WithStaticPrecompiles(map[common.Address]corevm.PrecompiledContract(kudoraStaticPrecompiles()))
+	).WithStaticPrecompiles(map[common.Address]corevm.PrecompiledContract(kudoraAvailableStaticPrecompiles(app.IntegrityKeeper, app.BankKeeper)))
 
 	app.EVMKeeper.EnableVirtualFeeCollection()
 
@@ -461,15 +471,7 @@ func New(
 		wasmtypes.VMConfig{},
 		wasmkeeper.BuiltInCapabilities(),
 		authority,
-		kudoraWasmKeeperOptions()...,
-	)
-
-	app.IntegrityKeeper = integritykeeper.NewKeeper(
-		runtime.NewKVStoreService(keys[integritytypes.StoreKey]),
-		appCodec,
-		app.AccountKeeper.AddressCodec(),
-		authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.BankKeeper,
+		kudoraWasmKeeperOptions(app.IntegrityKeeper)...,
 	)
 
 	vmModule := vm.NewAppModule(app.EVMKeeper, app.AccountKeeper, app.BankKeeper, app.AccountKeeper.AddressCodec())
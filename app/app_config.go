@@ -16,6 +16,9 @@ import (
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	integrityprecompile "github.com/Kudora-Labs/kudora/x/integrity/precompile"
+	integritytypes "github.com/Kudora-Labs/kudora/x/integrity/types"
 )
 
 var moduleAccPerms = map[string][]string{
@@ -29,6 +32,7 @@ var moduleAccPerms = map[string][]string{
 	feemarkettypes.ModuleName:      nil,
 	erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
 	wasmtypes.ModuleName:           {authtypes.Burner},
+	integritytypes.ModuleName:      {authtypes.Burner},
 }
 
 // GetMaccPerms returns a copy of the module account permissions.
@@ -51,6 +55,7 @@ func BlockedAddresses() map[string]bool {
 	}
 
 	precompiles := append([]string{}, vmtypes.AvailableStaticPrecompiles...)
+	precompiles = append(precompiles, integrityprecompile.PrecompileAddress)
 	for _, addr := range corevm.PrecompiledAddressesPrague {
 		precompiles = append(precompiles, addr.Hex())
 	}
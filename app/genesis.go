@@ -5,6 +5,7 @@ import (
 	"sort"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	cmn "github.com/cosmos/evm/precompiles/common"
 	precompiletypes "github.com/cosmos/evm/precompiles/types"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
@@ -14,6 +15,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	integritykeeper "github.com/Kudora-Labs/kudora/x/integrity/keeper"
+	integrityprecompile "github.com/Kudora-Labs/kudora/x/integrity/precompile"
 )
 
 const (
@@ -114,6 +118,16 @@ func kudoraStaticPrecompiles() precompiletypes.StaticPrecompiles {
 		WithBech32Precompile()
 }
 
+// kudoraAvailableStaticPrecompiles extends the default surface with the integrity precompile. It is
+// registered with the EVM keeper but left out of the default active list, so it only serves calls once
+// governance adds its address to the active static precompiles.
+func kudoraAvailableStaticPrecompiles(integrityKeeper integritykeeper.Keeper, bankKeeper cmn.BankKeeper) precompiletypes.StaticPrecompiles {
+	precompiles := kudoraStaticPrecompiles()
+	integrityPrecompile := integrityprecompile.NewPrecompile(integrityKeeper, bankKeeper)
+	precompiles[integrityPrecompile.Address()] = integrityPrecompile
+	return precompiles
+}
+
 func kudoraActiveStaticPrecompiles() []string {
 	precompiles := kudoraStaticPrecompiles()
 	prague := make(map[string]struct{}, len(corevm.PrecompiledAddressesPrague))
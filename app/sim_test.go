@@ -10,6 +10,7 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	clientflags "github.com/cosmos/cosmos-sdk/client/flags"
 	evmserverflags "github.com/cosmos/evm/server/flags"
+	cosmosevmutils "github.com/cosmos/evm/utils"
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
@@ -23,6 +24,9 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
+
+	integritykeeper "github.com/Kudora-Labs/kudora/x/integrity/keeper"
+	integrityprecompile "github.com/Kudora-Labs/kudora/x/integrity/precompile"
 )
 
 var (
@@ -108,6 +112,21 @@ func TestStaticPrecompileSurfaceRemainsNarrow(t *testing.T) {
 	}
 }
 
+func TestIntegrityPrecompileIsAvailableButInactive(t *testing.T) {
+	t.Helper()
+
+	precompiles := kudoraAvailableStaticPrecompiles(integritykeeper.Keeper{}, nil)
+	_, found := precompiles[common.HexToAddress(integrityprecompile.PrecompileAddress)]
+	require.True(t, found)
+	require.NotContains(t, kudoraActiveStaticPrecompiles(), integrityprecompile.PrecompileAddress)
+
+	for addr := range kudoraStaticPrecompiles() {
+		_, found := precompiles[addr]
+		require.Truef(t, found, "default static precompile %s is not available", addr.Hex())
+	}
+	require.True(t, BlockedAddresses()[cosmosevmutils.Bech32StringFromHexAddress(integrityprecompile.PrecompileAddress)])
+}
+
 func TestInitGenesisStoresEvmCoinInfoWith18Decimals(t *testing.T) {
 	t.Helper()
 
@@ -9,6 +9,9 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	integritykeeper "github.com/Kudora-Labs/kudora/x/integrity/keeper"
+	integritywasm "github.com/Kudora-Labs/kudora/x/integrity/wasmbinding"
 )
 
 type disabledTransferPortSource struct{}
@@ -17,14 +20,17 @@ func (disabledTransferPortSource) GetPort(sdk.Context) string {
 	return ""
 }
 
-func kudoraWasmKeeperOptions() []wasmkeeper.Option {
+// kudoraWasmKeeperOptions disables the contract-facing IBC surfaces and serves x/integrity reads to contracts
+// as KudoraQuery custom queries.
+func kudoraWasmKeeperOptions(integrityKeeper integritykeeper.Keeper) []wasmkeeper.Option {
 	return []wasmkeeper.Option{
 		wasmkeeper.WithMessageEncoders(&wasmkeeper.MessageEncoders{
 			IBC:  disabledWasmIBCEncoder,
 			IBC2: disabledWasmIBCv2Encoder,
 		}),
 		wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
-			IBC: disabledWasmIBCQuerier,
+			IBC:    disabledWasmIBCQuerier,
+			Custom: integritywasm.CustomQuerier(integrityKeeper),
 		}),
 	}
 }
@@ -71,6 +71,7 @@ func initRootCmd(rootCmd *cobra.Command, tempApp *app.App) {
 		sdkserver.StatusCommand(),
 		queryCommand(),
 		txCommand(),
+		integritycli.GetOfflineCmd(),
 	)
 
 	if _, err := srvflags.AddTxFlags(rootCmd); err != nil {
@@ -57,11 +57,12 @@ jq -e '
     "0x0000000000000000000000000000000000000804",
     "0x0000000000000000000000000000000000000805",
     "0x0000000000000000000000000000000000000806",
-    "0x0000000000000000000000000000000000000807"
+    "0x0000000000000000000000000000000000000807",
+    "0x0000000000000000000000000000000000000900"
   ] as $forbidden
   | [(.app_state.evm.params.active_static_precompiles // [])[] | select(. as $addr | $forbidden | index($addr))] | length == 0
 ' "$GENESIS_PATH" >/dev/null || {
-  echo "assert-evm-precompile-policy: a forbidden stateful Cosmos precompile is active by default" >&2
+  echo "assert-evm-precompile-policy: a forbidden stateful precompile is active by default" >&2
   exit 1
 }
 
@@ -44,6 +44,7 @@ stateful_precompiles=(
   "0x0000000000000000000000000000000000000805:gov"
   "0x0000000000000000000000000000000000000806:slashing"
   "0x0000000000000000000000000000000000000807:ics02"
+  "0x0000000000000000000000000000000000000900:integrity"
 )
 
 label_for() {
@@ -92,6 +93,7 @@ staking_active="no"
 gov_active="no"
 ics20_active="no"
 ics02_active="no"
+integrity_active="no"
 custom_stateful_active="no"
 
 for entry in "${active_stateful[@]}"; do
@@ -102,6 +104,7 @@ for entry in "${active_stateful[@]}"; do
     *"(gov)") gov_active="yes" ;;
     *"(ics20)") ics20_active="yes" ;;
     *"(ics02)") ics02_active="yes" ;;
+    *"(integrity)") integrity_active="yes" ;;
   esac
 done
 
@@ -112,10 +115,10 @@ fi
 source_wiring="$(
   {
     echo "app/genesis.go"
-    sed -n '96,120p' app/genesis.go
+    sed -n '114,148p' app/genesis.go
     echo
     echo "app/app.go"
-    sed -n '400,416p' app/app.go
+    sed -n '416,441p' app/app.go
   }
 )"
 
@@ -171,6 +174,7 @@ fi
   echo "- gov precompile active: ${gov_active}"
   echo "- ICS-20 precompile active: ${ics20_active}"
   echo "- ICS-02 precompile active: ${ics02_active}"
+  echo "- integrity precompile active: ${integrity_active}"
   echo "- custom stateful precompile active: ${custom_stateful_active}"
   echo
   echo "## Result"
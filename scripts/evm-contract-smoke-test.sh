@@ -10,6 +10,8 @@ USE_EXISTING_NODE="${KUDORA_USE_EXISTING_NODE:-0}"
 CHAIN_ID="${KUDORA_CHAIN_ID:-kudora_12000-1}"
 EVM_CHAIN_ID="${KUDORA_EVM_CHAIN_ID:-120001}"
 EXPECTED_ETH_CHAIN_ID="${KUDORA_ETH_CHAIN_ID:-0x1d4c1}"
+INTEGRITY_PRECOMPILE_SMOKE="${KUDORA_EVM_INTEGRITY_PRECOMPILE_SMOKE:-0}"
+INTEGRITY_PRECOMPILE_ADDRESS="0x0000000000000000000000000000000000000900"
 SENDER_GENESIS_FUNDS="500000000000000000000akud"
 VALIDATOR_GENESIS_FUNDS="100000000000000000000akud"
 VALIDATOR_SELF_DELEGATION="1000000000000000000akud"
@@ -35,6 +37,7 @@ HELPER_BIN="${WORK_DIR}/evm-smoke-helper"
 SENDER_KEY_FILE="${WORK_DIR}/sender.key"
 SENDER_INFO_FILE="${WORK_DIR}/sender.json"
 RESULT_FILE="${WORK_DIR}/result.json"
+INTEGRITY_RESULT_FILE="${WORK_DIR}/integrity-precompile-result.json"
 
 command -v jq >/dev/null 2>&1 || {
   echo "evm-contract-smoke-test: jq is required" >&2
@@ -126,6 +129,16 @@ if [[ "${USE_EXISTING_NODE}" != "1" ]]; then
     --home "${HOME_DIR}" \
     >"${LOG_DIR}/collect-gentxs.stdout" 2>"${LOG_DIR}/collect-gentxs.stderr"
 
+  if [[ "${INTEGRITY_PRECOMPILE_SMOKE}" == "1" ]]; then
+    # The integrity precompile is registered but never active by default; this throwaway chain activates it
+    # in genesis the same way a governance params update would.
+    genesis_tmp="${HOME_DIR}/config/genesis.json.tmp"
+    jq --arg addr "${INTEGRITY_PRECOMPILE_ADDRESS}" \
+      '.app_state.evm.params.active_static_precompiles = ((.app_state.evm.params.active_static_precompiles + [$addr]) | unique)' \
+      "${HOME_DIR}/config/genesis.json" >"${genesis_tmp}"
+    mv "${genesis_tmp}" "${HOME_DIR}/config/genesis.json"
+  fi
+
   rg -n '"chain_id": "kudora_12000-1"' "${HOME_DIR}/config/genesis.json" >/dev/null
   rg -n '"evm_denom": "akud"' "${HOME_DIR}/config/genesis.json" >/dev/null
   rg -n '"no_base_fee": true' "${HOME_DIR}/config/genesis.json" >/dev/null
@@ -219,4 +232,31 @@ jq -e '
   .updated_value == "888"
 ' "${RESULT_FILE}" >/dev/null
 
+if [[ "${INTEGRITY_PRECOMPILE_SMOKE}" == "1" ]]; then
+  "${HELPER_BIN}" integrity-precompile-smoke \
+    --rpc-url "${JSONRPC_URL}" \
+    --chain-id "${EVM_CHAIN_ID}" \
+    --sender-key-file "${SENDER_KEY_FILE}" \
+    --result-file "${INTEGRITY_RESULT_FILE}" \
+    >"${LOG_DIR}/integrity-precompile.stdout" 2>"${LOG_DIR}/integrity-precompile.stderr" || {
+    echo "evm-contract-smoke-test: integrity precompile smoke failed; is ${INTEGRITY_PRECOMPILE_ADDRESS} active?" >&2
+    tail -n 40 "${LOG_DIR}/integrity-precompile.stderr" >&2 || true
+    exit 1
+  }
+
+  jq -e '
+    .deployment_receipt_status == "0x1" and
+    .register_receipt_status == "0x1" and
+    .commit_receipt_status == "0x1" and
+    .register_logs_validated == true and
+    .commit_logs_validated == true and
+    .set_found == true and
+    .set_root == .root and
+    .record_count == 2 and
+    .proof_valid == true
+  ' "${INTEGRITY_RESULT_FILE}" >/dev/null
+
+  echo "evm-contract-smoke-test: integrity precompile PASS (forwarder=$(jq -r '.forwarder_cosmos_address' "${INTEGRITY_RESULT_FILE}") tenant=$(jq -r '.tenant' "${INTEGRITY_RESULT_FILE}") commitTx=$(jq -r '.commit_tx_hash' "${INTEGRITY_RESULT_FILE}"))"
+fi
+
 echo "evm-contract-smoke-test: PASS (sender=${sender_eth_address} contract=$(jq -r '.contract_address' "${RESULT_FILE}") deployTx=$(jq -r '.deployment_tx_hash' "${RESULT_FILE}"))"
@@ -139,6 +139,8 @@ check_phase5_artifacts() {
     "scripts/wasm-smoke-test.sh"
     "scripts/phase-5-validate.sh"
     "testutil/wasm/reflect_1_5.wasm"
+    "testutil/wasm/integrity_query.wasm"
+    "testutil/wasm/integrity_query.wat"
   )
   local path
 
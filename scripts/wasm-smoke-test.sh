@@ -9,6 +9,8 @@ BINARY="${ROOT_DIR}/build/kudorad"
 USE_EXISTING_NODE="${KUDORA_USE_EXISTING_NODE:-0}"
 WASM_FILE="${ROOT_DIR}/testutil/wasm/reflect_1_5.wasm"
 WASM_SHA256="45de7a3ac8a72368a71c813d6b0cf7024f8b3581ffa1fc8d2c5fd4060f950c01"
+INTEGRITY_WASM_FILE="${ROOT_DIR}/testutil/wasm/integrity_query.wasm"
+INTEGRITY_WASM_SHA256="e688e00d161c692842c61274cf345bf315e0f77242afc977bb3a0b24ef10e8a1"
 CHAIN_ID="${KUDORA_CHAIN_ID:-kudora_12000-1}"
 EVM_CHAIN_ID="${KUDORA_EVM_CHAIN_ID:-120001}"
 EXPECTED_ETH_CHAIN_ID="${KUDORA_ETH_CHAIN_ID:-0x1d4c1}"
@@ -17,6 +19,7 @@ VALIDATOR_GENESIS_FUNDS="100000000000000000000akud"
 VALIDATOR_SELF_DELEGATION="1000000000000000000akud"
 TX_FEES="1000000000000000akud"
 TX_GAS="5000000"
+INTEGRITY_PERIOD="2026-01-01"
 
 if [[ "${USE_EXISTING_NODE}" == "1" ]]; then
   NODE_HOME="${KUDORA_HOME:-}"
@@ -36,6 +39,7 @@ fi
 
 LOG_DIR="${WORK_DIR}/logs"
 RESULT_FILE="${WORK_DIR}/result.json"
+INTEGRITY_DIR="${WORK_DIR}/integrity"
 NODE_RPC_ENDPOINT="tcp://${COMET_RPC_URL#http://}"
 
 command -v jq >/dev/null 2>&1 || {
@@ -53,6 +57,11 @@ command -v shasum >/dev/null 2>&1 || {
   exit 1
 }
 
+command -v go >/dev/null 2>&1 || {
+  echo "wasm-smoke-test: Go is required to build the integrity mock set" >&2
+  exit 1
+}
+
 if [[ ! -x "${BINARY}" ]]; then
   echo "wasm-smoke-test: expected built binary at ${BINARY}. Run make build first." >&2
   exit 1
@@ -68,8 +77,13 @@ if [[ "$(shasum -a 256 "${WASM_FILE}" | awk '{print $1}')" != "${WASM_SHA256}" ]
   exit 1
 fi
 
+if [[ "$(shasum -a 256 "${INTEGRITY_WASM_FILE}" | awk '{print $1}')" != "${INTEGRITY_WASM_SHA256}" ]]; then
+  echo "wasm-smoke-test: integrity query contract hash drifted from the documented integrity_query.wasm artifact" >&2
+  exit 1
+fi
+
 rm -rf "${WORK_DIR}"
-mkdir -p "${LOG_DIR}"
+mkdir -p "${LOG_DIR}" "${INTEGRITY_DIR}"
 
 cleanup() {
   if [[ -n "${node_pid:-}" ]]; then
@@ -114,6 +128,59 @@ wait_for_tx() {
   return 1
 }
 
+# submit_tx runs a tx command, waits for it and prints its hash. Extra arguments are the command and its
+# positional arguments; signing flags are appended here.
+submit_tx() {
+  local label="$1"
+  shift
+
+  local sync_json
+  sync_json="$("$@" \
+    --from "${uploader_key_name}" \
+    --keyring-backend test \
+    --home "${NODE_HOME}" \
+    --chain-id "${CHAIN_ID}" \
+    --node "${NODE_RPC_ENDPOINT}" \
+    -b sync \
+    --yes \
+    --gas "${TX_GAS}" \
+    --fees "${TX_FEES}" \
+    --output json \
+    2>"${LOG_DIR}/${label}.stderr")"
+  printf '%s\n' "${sync_json}" >"${LOG_DIR}/${label}-sync.json"
+
+  local txhash
+  txhash="$(printf '%s\n' "${sync_json}" | jq -r '.txhash // empty')"
+  if [[ -z "${txhash}" ]]; then
+    echo "wasm-smoke-test: missing ${label} tx hash" >&2
+    return 1
+  fi
+
+  wait_for_tx "${txhash}" "${LOG_DIR}/${label}-tx.json" "${LOG_DIR}/${label}-query.stderr"
+  jq -e '.code == 0' "${LOG_DIR}/${label}-tx.json" >/dev/null || {
+    echo "wasm-smoke-test: ${label} transaction failed" >&2
+    jq -r '.raw_log' "${LOG_DIR}/${label}-tx.json" >&2
+    return 1
+  }
+
+  printf '%s\n' "${txhash}"
+}
+
+# smart_query sends a KudoraQuery to the integrity query contract and writes the decoded answer.
+smart_query() {
+  local label="$1"
+  local msg="$2"
+
+  "${BINARY}" query wasm contract-state smart "${integrity_contract_address}" "${msg}" \
+    --node "${NODE_RPC_ENDPOINT}" \
+    --output json \
+    >"${INTEGRITY_DIR}/${label}.json" 2>"${LOG_DIR}/${label}.stderr" || {
+    echo "wasm-smoke-test: ${label} custom query failed" >&2
+    cat "${LOG_DIR}/${label}.stderr" >&2
+    return 1
+  }
+}
+
 jsonrpc_request() {
   local payload="$1"
   curl -sS -H 'Content-Type: application/json' --data "${payload}" "${JSONRPC_URL}"
@@ -131,7 +198,7 @@ if [[ "${USE_EXISTING_NODE}" == "1" ]]; then
   validator_address="$("${BINARY}" keys show "${validator_name}" --address --keyring-backend test --home "${NODE_HOME}")"
 else
   rm -rf "${NODE_HOME}"
-  mkdir -p "${LOG_DIR}"
+  mkdir -p "${LOG_DIR}" "${INTEGRITY_DIR}"
 
   "${BINARY}" init phase5-wasm \
     --chain-id "${CHAIN_ID}" \
@@ -332,6 +399,91 @@ if [[ "${owner_after}" != "${validator_address}" ]]; then
   exit 1
 fi
 
+# The integrity query contract forwards KudoraQuery messages to the x/integrity custom querier.
+if [[ "${USE_EXISTING_NODE}" == "1" ]]; then
+  integrity_tenant="${KUDORA_WASM_INTEGRITY_TENANT:-wasm-smoke-$(date +%s)}"
+else
+  integrity_tenant="wasm-smoke"
+fi
+integrity_type="${integrity_tenant}.daily.v1"
+
+go run ./testutil/integrity-smoke build-set \
+  --tenant "${integrity_tenant}" \
+  --type "${integrity_type}" \
+  --period "${INTEGRITY_PERIOD}" \
+  --record-count 2 \
+  --records-file "${INTEGRITY_DIR}/records.json" \
+  --expected-file "${INTEGRITY_DIR}/expected.json" \
+  >"${LOG_DIR}/integrity-build.stdout" 2>"${LOG_DIR}/integrity-build.stderr"
+integrity_root="$(jq -r '.root' "${INTEGRITY_DIR}/expected.json")"
+integrity_first_tag="$(jq -r '.sorted_tags[0]' "${INTEGRITY_DIR}/expected.json")"
+
+integrity_register_txhash="$(submit_tx integrity-register "${BINARY}" tx integrity register-tenant "${integrity_tenant}")"
+integrity_commit_txhash="$(submit_tx integrity-commit "${BINARY}" tx integrity commit-set "${integrity_tenant}" "${integrity_type}" "${INTEGRITY_PERIOD}" "${integrity_root}" "${INTEGRITY_DIR}/records.json")"
+
+integrity_store_txhash="$(submit_tx integrity-store "${BINARY}" tx wasm store "${INTEGRITY_WASM_FILE}")"
+integrity_code_id="$(jq -r '.events[] | select(.type=="store_code") | .attributes[] | select(.key=="code_id") | .value' "${LOG_DIR}/integrity-store-tx.json" | tail -n1)"
+if [[ -z "${integrity_code_id}" ]]; then
+  echo "wasm-smoke-test: could not extract integrity query code id from store transaction" >&2
+  exit 1
+fi
+
+integrity_instantiate_txhash="$(submit_tx integrity-instantiate "${BINARY}" tx wasm instantiate "${integrity_code_id}" '{}' --label integrity-query-smoke --no-admin)"
+integrity_contract_address="$(jq -r '.events[] | select(.type=="instantiate") | .attributes[] | select(.key=="_contract_address") | .value' "${LOG_DIR}/integrity-instantiate-tx.json" | tail -n1)"
+if [[ -z "${integrity_contract_address}" ]]; then
+  echo "wasm-smoke-test: could not extract integrity query contract address from instantiate transaction" >&2
+  exit 1
+fi
+
+smart_query integrity-tenant "$(jq -cn --arg tenant "${integrity_tenant}" '{integrity:{tenant:{tenant:$tenant}}}')"
+jq -e --arg owner "${uploader_address}" '.data.tenant.owner == $owner' "${INTEGRITY_DIR}/integrity-tenant.json" >/dev/null || {
+  echo "wasm-smoke-test: tenant custom query did not return the uploader as owner" >&2
+  exit 1
+}
+
+smart_query integrity-set "$(jq -cn --arg tenant "${integrity_tenant}" --arg type "${integrity_type}" --arg period "${INTEGRITY_PERIOD}" \
+  '{integrity:{set:{tenant:$tenant,type:$type,period:$period}}}')"
+jq -e --arg root "${integrity_root}" '.data.set.root == $root and .data.set.record_count == 2 and .data.set.records_on_chain == true' \
+  "${INTEGRITY_DIR}/integrity-set.json" >/dev/null || {
+  echo "wasm-smoke-test: set custom query did not return the committed root" >&2
+  exit 1
+}
+
+smart_query integrity-missing-set "$(jq -cn --arg tenant "${integrity_tenant}" --arg type "${integrity_type}" \
+  '{integrity:{set:{tenant:$tenant,type:$type,period:"1999-01-01"}}}')"
+jq -e '.data.set == null' "${INTEGRITY_DIR}/integrity-missing-set.json" >/dev/null || {
+  echo "wasm-smoke-test: missing set custom query did not return null" >&2
+  exit 1
+}
+
+smart_query integrity-record "$(jq -cn --arg tenant "${integrity_tenant}" --arg type "${integrity_type}" --arg period "${INTEGRITY_PERIOD}" --arg tag "${integrity_first_tag}" \
+  '{integrity:{record:{tenant:$tenant,type:$type,period:$period,tag:$tag}}}')"
+jq -e --slurpfile expected "${INTEGRITY_DIR}/expected.json" '.data.record.ciphertext == $expected[0].records[0].ciphertext and .data.revoked == false' \
+  "${INTEGRITY_DIR}/integrity-record.json" >/dev/null || {
+  echo "wasm-smoke-test: record custom query did not return the committed ciphertext" >&2
+  exit 1
+}
+
+"${BINARY}" query integrity record-proof "${integrity_tenant}" "${integrity_type}" "${INTEGRITY_PERIOD}" "${integrity_first_tag}" \
+  --node "${NODE_RPC_ENDPOINT}" \
+  --output json \
+  >"${INTEGRITY_DIR}/record-proof.json" 2>"${LOG_DIR}/integrity-record-proof.stderr"
+smart_query integrity-verify-proof "$(jq -c --arg tenant "${integrity_tenant}" --arg type "${integrity_type}" --arg period "${INTEGRITY_PERIOD}" '
+  {integrity:{verify_proof:{
+    tenant:$tenant, type:$type, period:$period,
+    record:{tag:.record.tag, nonce:.record.nonce, ciphertext:.record.ciphertext},
+    proof:{
+      leaf_index:(.proof.leaf_index // "0" | tonumber),
+      leaf_count:(.proof.leaf_count // "0" | tonumber),
+      siblings:(.proof.siblings // []),
+      sibling_on_left:(.proof.sibling_on_left // [])
+    }
+  }}}' "${INTEGRITY_DIR}/record-proof.json")"
+jq -e '.data.valid == true and .data.revoked == false' "${INTEGRITY_DIR}/integrity-verify-proof.json" >/dev/null || {
+  echo "wasm-smoke-test: verify_proof custom query rejected the committed record" >&2
+  exit 1
+}
+
 if git ls-files "${WORK_DIR}" | rg -q .; then
   echo "wasm-smoke-test: temporary wasm smoke result directory must not be tracked" >&2
   exit 1
@@ -352,6 +504,16 @@ jq -n \
   --arg owner_after "${owner_after}" \
   --arg wasm_file "${WASM_FILE}" \
   --arg wasm_sha256 "${WASM_SHA256}" \
+  --arg integrity_tenant "${integrity_tenant}" \
+  --arg integrity_root "${integrity_root}" \
+  --arg integrity_code_id "${integrity_code_id}" \
+  --arg integrity_contract "${integrity_contract_address}" \
+  --arg integrity_register_txhash "${integrity_register_txhash}" \
+  --arg integrity_commit_txhash "${integrity_commit_txhash}" \
+  --arg integrity_store_txhash "${integrity_store_txhash}" \
+  --arg integrity_instantiate_txhash "${integrity_instantiate_txhash}" \
+  --arg integrity_wasm_file "${INTEGRITY_WASM_FILE}" \
+  --arg integrity_wasm_sha256 "${INTEGRITY_WASM_SHA256}" \
   '{
     chain_id: $chain_id,
     evm_chain_id: ($evm_chain_id | tonumber),
@@ -366,7 +528,17 @@ jq -n \
     owner_before: $owner_before,
     owner_after: $owner_after,
     wasm_file: $wasm_file,
-    wasm_sha256: $wasm_sha256
+    wasm_sha256: $wasm_sha256,
+    integrity_tenant: $integrity_tenant,
+    integrity_root: $integrity_root,
+    integrity_code_id: ($integrity_code_id | tonumber),
+    integrity_contract_address: $integrity_contract,
+    integrity_register_txhash: $integrity_register_txhash,
+    integrity_commit_txhash: $integrity_commit_txhash,
+    integrity_store_txhash: $integrity_store_txhash,
+    integrity_instantiate_txhash: $integrity_instantiate_txhash,
+    integrity_wasm_file: $integrity_wasm_file,
+    integrity_wasm_sha256: $integrity_wasm_sha256
   }' >"${RESULT_FILE}"
 
-echo "wasm-smoke-test: PASS (code_id=${code_id} contract=${contract_address})"
+echo "wasm-smoke-test: PASS (code_id=${code_id} contract=${contract_address} integrity_contract=${integrity_contract_address})"
@@ -0,0 +1,21 @@
+package main
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// integrityForwarderRuntimeHex forwards its calldata to the integrity precompile at 0x0900 with CALL and
+// returns or reverts with the precompile output, so the forwarder itself is msg.sender and owns what it
+// registers. It stands in for a tenant-owning contract such as a DAO and lets anyone act through it, so it is
+// test-only.
+//
+//	CALLDATASIZE PUSH1 0 PUSH1 0 CALLDATACOPY
+//	PUSH1 0 PUSH1 0 CALLDATASIZE PUSH1 0 PUSH1 0 PUSH2 0x0900 GAS CALL
+//	RETURNDATASIZE PUSH1 0 PUSH1 0 RETURNDATACOPY
+//	PUSH1 0x21 JUMPI RETURNDATASIZE PUSH1 0 REVERT
+//	JUMPDEST RETURNDATASIZE PUSH1 0 RETURN
+const integrityForwarderRuntimeHex = "3660006000376000600036600060006109005af13d600060003e6021573d6000fd5b3d6000f3"
+
+func integrityForwarderCreationBytecode() []byte {
+	return creationBytecode(common.FromHex("0x" + integrityForwarderRuntimeHex))
+}
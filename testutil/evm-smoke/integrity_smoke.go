@@ -0,0 +1,289 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"flag"
+	"fmt"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/Kudora-Labs/kudora/testutil/integritymock"
+	integrityprecompile "github.com/Kudora-Labs/kudora/x/integrity/precompile"
+	integritytypes "github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+const (
+	defaultIntegrityTenant = "evm-smoke"
+	defaultIntegrityPeriod = "2026-01-01"
+)
+
+type integrityPrecompileResult struct {
+	ChainIDHex              string `json:"chain_id_hex"`
+	SenderAddress           string `json:"sender_address"`
+	ForwarderAddress        string `json:"forwarder_address"`
+	ForwarderCosmosAddress  string `json:"forwarder_cosmos_address"`
+	DeploymentTxHash        string `json:"deployment_tx_hash"`
+	RegisterTxHash          string `json:"register_tx_hash"`
+	CommitTxHash            string `json:"commit_tx_hash"`
+	DeploymentReceiptStatus string `json:"deployment_receipt_status"`
+	RegisterReceiptStatus   string `json:"register_receipt_status"`
+	CommitReceiptStatus     string `json:"commit_receipt_status"`
+	Tenant                  string `json:"tenant"`
+	Type                    string `json:"type"`
+	Period                  string `json:"period"`
+	Root                    string `json:"root"`
+	RecordCount             uint64 `json:"record_count"`
+	SetFound                bool   `json:"set_found"`
+	SetRoot                 string `json:"set_root"`
+	ProofValid              bool   `json:"proof_valid"`
+	RegisterLogsValidated   bool   `json:"register_logs_validated"`
+	CommitLogsValidated     bool   `json:"commit_logs_validated"`
+}
+
+// runIntegrityPrecompileSmoke deploys a forwarder contract that registers a tenant and commits a set through
+// the integrity precompile, then reads the set back and verifies a record proof with eth_call. The precompile
+// must be active on the target chain.
+func runIntegrityPrecompileSmoke(args []string) error {
+	fs := flag.NewFlagSet("integrity-precompile-smoke", flag.ContinueOnError)
+	rpcURL := fs.String("rpc-url", "", "JSON-RPC endpoint")
+	chainIDFlag := fs.Uint64("chain-id", 0, "expected EVM chain ID")
+	senderKeyFile := fs.String("sender-key-file", "", "path to the funded deployer key")
+	resultFile := fs.String("result-file", "", "path to write the integrity precompile smoke result JSON")
+	tenant := fs.String("tenant", defaultIntegrityTenant, "tenant registered by the forwarder contract")
+	period := fs.String("period", defaultIntegrityPeriod, "period of the committed set")
+
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	if *rpcURL == "" || *chainIDFlag == 0 || *senderKeyFile == "" || *resultFile == "" {
+		return errors.New("integrity-precompile-smoke: --rpc-url, --chain-id, --sender-key-file, and --result-file are required")
+	}
+
+	client, ctx, cancel, err := dialClient(*rpcURL, receiptTimeout)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: %w", err)
+	}
+	defer cancel()
+	defer client.Close()
+
+	chainID, err := ensureChainID(ctx, client, *chainIDFlag)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: %w", err)
+	}
+
+	senderKey, err := readKey(*senderKeyFile)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: read sender key: %w", err)
+	}
+	senderAddress := crypto.PubkeyToAddress(senderKey.PublicKey)
+	precompileAddress := common.HexToAddress(integrityprecompile.PrecompileAddress)
+	integrityType := *tenant + ".daily.v1"
+
+	deployTx, deployReceipt, err := sendSmokeTx(ctx, client, chainID, senderKey, nil, integrityForwarderCreationBytecode())
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: deploy forwarder: %w", err)
+	}
+	if deployReceipt.ContractAddress == (common.Address{}) {
+		return errors.New("integrity-precompile-smoke: contractAddress missing from deploy receipt")
+	}
+	forwarder := deployReceipt.ContractAddress
+
+	registerData, err := integrityprecompile.ABI.Pack(integrityprecompile.RegisterTenantMethod, *tenant)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: pack registerTenant: %w", err)
+	}
+	registerTx, registerReceipt, err := sendSmokeTx(ctx, client, chainID, senderKey, &forwarder, registerData)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: registerTenant: %w", err)
+	}
+	registerLogsValidated := hasPrecompileLog(registerReceipt, precompileAddress, integrityprecompile.EventTypeTenantRegistered, forwarder)
+	if !registerLogsValidated {
+		return errors.New("integrity-precompile-smoke: registerTenant receipt has no TenantRegistered log for the forwarder")
+	}
+
+	mockSet, err := integritymock.BuildMockSet(2, *tenant, integrityType, *period)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: build mock set: %w", err)
+	}
+	records := make([]integrityprecompile.Record, len(mockSet.Records))
+	for i, record := range mockSet.Records {
+		records[i] = integrityprecompile.Record{
+			Tag:        common.HexToHash(record.Tag),
+			Nonce:      common.FromHex(record.Nonce),
+			Ciphertext: common.FromHex(record.Ciphertext),
+		}
+	}
+	root := common.HexToHash(mockSet.Root)
+	commitData, err := integrityprecompile.ABI.Pack(integrityprecompile.CommitIntegritySetMethod, *tenant, integrityType, *period, [32]byte(root), records, uint8(0), uint8(0))
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: pack commitIntegritySet: %w", err)
+	}
+	commitTx, commitReceipt, err := sendSmokeTx(ctx, client, chainID, senderKey, &forwarder, commitData)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: commitIntegritySet: %w", err)
+	}
+	commitLogsValidated := hasPrecompileLog(commitReceipt, precompileAddress, integrityprecompile.EventTypeIntegritySetCommitted, forwarder)
+	if !commitLogsValidated {
+		return errors.New("integrity-precompile-smoke: commitIntegritySet receipt has no IntegritySetCommitted log for the forwarder")
+	}
+
+	setOut, err := callPrecompile(ctx, client, senderAddress, precompileAddress, integrityprecompile.GetSetMethod, *tenant, integrityType, *period)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: getSet: %w", err)
+	}
+	setFound, _ := setOut[0].(bool)
+	setRoot, _ := setOut[1].([32]byte)
+	recordCount, _ := setOut[3].(uint64)
+	if !setFound || common.Hash(setRoot) != root || recordCount != uint64(len(mockSet.Records)) {
+		return fmt.Errorf("integrity-precompile-smoke: getSet = found %t root %s records %d, want root %s records %d", setFound, common.Hash(setRoot).Hex(), recordCount, root.Hex(), len(mockSet.Records))
+	}
+
+	proof, err := integritytypes.CalculateMerkleProofFromPreparedRecords(mockSet.SortedRecords, 0)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: build proof: %w", err)
+	}
+	siblings := make([][32]byte, len(proof.Siblings))
+	for i, sibling := range proof.Siblings {
+		siblings[i] = common.HexToHash(sibling)
+	}
+	proofRecord := mockSet.SortedRecords[0]
+	proofOut, err := callPrecompile(
+		ctx, client, senderAddress, precompileAddress, integrityprecompile.VerifyProofMethod,
+		*tenant, integrityType, *period,
+		[32]byte(common.HexToHash(proofRecord.Tag)), common.FromHex(proofRecord.Nonce), common.FromHex(proofRecord.Ciphertext),
+		proof.LeafIndex, proof.LeafCount, siblings, proof.SiblingOnLeft,
+	)
+	if err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: verifyProof: %w", err)
+	}
+	proofValid, _ := proofOut[0].(bool)
+	if !proofValid {
+		return errors.New("integrity-precompile-smoke: verifyProof rejected the proof of a committed record")
+	}
+
+	result := integrityPrecompileResult{
+		ChainIDHex:              chainIDHex(chainID),
+		SenderAddress:           senderAddress.Hex(),
+		ForwarderAddress:        forwarder.Hex(),
+		ForwarderCosmosAddress:  sdk.AccAddress(forwarder.Bytes()).String(),
+		DeploymentTxHash:        deployTx.Hash().Hex(),
+		RegisterTxHash:          registerTx.Hash().Hex(),
+		CommitTxHash:            commitTx.Hash().Hex(),
+		DeploymentReceiptStatus: statusHex(deployReceipt.Status),
+		RegisterReceiptStatus:   statusHex(registerReceipt.Status),
+		CommitReceiptStatus:     statusHex(commitReceipt.Status),
+		Tenant:                  *tenant,
+		Type:                    integrityType,
+		Period:                  *period,
+		Root:                    root.Hex(),
+		RecordCount:             recordCount,
+		SetFound:                setFound,
+		SetRoot:                 common.Hash(setRoot).Hex(),
+		ProofValid:              proofValid,
+		RegisterLogsValidated:   registerLogsValidated,
+		CommitLogsValidated:     commitLogsValidated,
+	}
+
+	if err := writeJSON(*resultFile, result, 0o644); err != nil {
+		return fmt.Errorf("integrity-precompile-smoke: write result: %w", err)
+	}
+
+	fmt.Printf(
+		"integrity-precompile-smoke: PASS (forwarder=%s tenant=%s root=%s proofValid=%t)\n",
+		result.ForwarderAddress,
+		result.Tenant,
+		result.SetRoot,
+		result.ProofValid,
+	)
+	return nil
+}
+
+// sendSmokeTx signs and sends a legacy transaction with an estimated gas limit and waits for a successful
+// receipt. A nil recipient deploys data as creation bytecode.
+func sendSmokeTx(ctx context.Context, client *ethclient.Client, chainID *big.Int, key *ecdsa.PrivateKey, to *common.Address, data []byte) (*ethtypes.Transaction, *ethtypes.Receipt, error) {
+	from := crypto.PubkeyToAddress(key.PublicKey)
+
+	nonce, err := client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return nil, nil, fmt.Errorf("nonce: %w", err)
+	}
+	gasPrice, err := suggestedGasPrice(ctx, client)
+	if err != nil {
+		return nil, nil, fmt.Errorf("gas price: %w", err)
+	}
+	gas, err := client.EstimateGas(ctx, ethereum.CallMsg{
+		From:     from,
+		To:       to,
+		GasPrice: gasPrice,
+		Data:     data,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("estimate gas: %w", err)
+	}
+
+	tx := ethtypes.NewTx(&ethtypes.LegacyTx{
+		Nonce:    nonce,
+		To:       to,
+		GasPrice: gasPrice,
+		Gas:      paddedGasLimit(gas),
+		Data:     data,
+		Value:    big.NewInt(0),
+	})
+	signedTx, err := ethtypes.SignTx(tx, ethtypes.LatestSignerForChainID(chainID), key)
+	if err != nil {
+		return nil, nil, fmt.Errorf("sign tx: %w", err)
+	}
+	if err := client.SendTransaction(ctx, signedTx); err != nil {
+		return nil, nil, fmt.Errorf("send tx: %w", err)
+	}
+
+	receipt, err := waitForReceipt(ctx, client, signedTx.Hash())
+	if err != nil {
+		return nil, nil, fmt.Errorf("wait receipt: %w", err)
+	}
+	if receipt.Status != ethtypes.ReceiptStatusSuccessful {
+		return nil, nil, fmt.Errorf("receipt status = %s, want 0x1", statusHex(receipt.Status))
+	}
+
+	return signedTx, receipt, nil
+}
+
+// callPrecompile runs a read-only integrity precompile method with eth_call and unpacks its outputs.
+func callPrecompile(ctx context.Context, client *ethclient.Client, from, precompileAddress common.Address, method string, args ...interface{}) ([]interface{}, error) {
+	callData, err := integrityprecompile.ABI.Pack(method, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := client.CallContract(ctx, ethereum.CallMsg{
+		From: from,
+		To:   &precompileAddress,
+		Data: callData,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return integrityprecompile.ABI.Unpack(method, output)
+}
+
+// hasPrecompileLog reports whether the receipt carries the event emitted by the precompile for the given
+// indexed caller.
+func hasPrecompileLog(receipt *ethtypes.Receipt, precompileAddress common.Address, event string, caller common.Address) bool {
+	eventID := integrityprecompile.ABI.Events[event].ID
+	for _, log := range receipt.Logs {
+		if log.Address == precompileAddress && len(log.Topics) == 2 && log.Topics[0] == eventID && log.Topics[1] == common.BytesToHash(caller.Bytes()) {
+			return true
+		}
+	}
+	return false
+}
@@ -81,7 +81,7 @@ type contractResult struct {
 
 func main() {
 	if len(os.Args) < 2 {
-		exitf("usage: %s <create-account|transfer-smoke|contract-smoke> [flags]", filepath.Base(os.Args[0]))
+		exitf("usage: %s <create-account|transfer-smoke|contract-smoke|integrity-precompile-smoke> [flags]", filepath.Base(os.Args[0]))
 	}
 
 	var err error
@@ -94,6 +94,8 @@ func main() {
 		err = runTransferSmoke(os.Args[2:])
 	case "contract-smoke":
 		err = runContractSmoke(os.Args[2:])
+	case "integrity-precompile-smoke":
+		err = runIntegrityPrecompileSmoke(os.Args[2:])
 	default:
 		err = fmt.Errorf("unknown command %q", os.Args[1])
 	}
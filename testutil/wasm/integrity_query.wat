@@ -0,0 +1,194 @@
+;; integrity_query forwards every smart query to the chain as QueryRequest::Custom and returns the answer, so
+;; `{"integrity":{"set":{...}}}` sent to the contract reaches the x/integrity custom querier unchanged. It is a
+;; test-only CosmWasm 2.x contract (interface_version_8) with a bump allocator that never frees.
+;;
+;; Region layout shared with the host: offset i32, capacity i32, length i32.
+(module
+  (type $t_i32_i32 (func (param i32) (result i32)))
+  (type $t_void (func))
+  (type $t_i32_void (func (param i32)))
+  (type $t_3i32_i32 (func (param i32 i32 i32) (result i32)))
+  (type $t_2i32_i32 (func (param i32 i32) (result i32)))
+  (type $t_3i32_void (func (param i32 i32 i32)))
+
+  (import "env" "query_chain" (func $query_chain (type $t_i32_i32)))
+
+  (memory $memory 1)
+  (global $heap (mut i32) (i32.const 1024))
+
+  (export "memory" (memory $memory))
+  (export "interface_version_8" (func $interface_version_8))
+  (export "allocate" (func $allocate))
+  (export "deallocate" (func $deallocate))
+  (export "instantiate" (func $instantiate))
+  (export "query" (func $query))
+
+  (func $interface_version_8 (type $t_void))
+
+  ;; allocate reserves a region header followed by size bytes, growing memory when the heap passes its end.
+  (func $allocate (type $t_i32_i32) (param $size i32) (result i32)
+    (local $region i32)
+    (local $pages i32)
+    global.get $heap
+    local.set $region
+    local.get $region
+    local.get $size
+    i32.add
+    i32.const 15
+    i32.add
+    i32.const -4
+    i32.and
+    global.set $heap
+    global.get $heap
+    i32.const 65535
+    i32.add
+    i32.const 16
+    i32.shr_u
+    memory.size
+    i32.sub
+    local.tee $pages
+    i32.const 0
+    i32.gt_s
+    if
+      local.get $pages
+      memory.grow
+      drop
+    end
+    local.get $region
+    local.get $region
+    i32.const 12
+    i32.add
+    i32.store offset=0
+    local.get $region
+    local.get $size
+    i32.store offset=4
+    local.get $region
+    i32.const 0
+    i32.store offset=8
+    local.get $region)
+
+  (func $deallocate (type $t_i32_void) (param $region i32))
+
+  ;; instantiate ignores its inputs and returns an empty Response.
+  (func $instantiate (type $t_3i32_i32) (param $env i32) (param $info i32) (param $msg i32) (result i32)
+    i32.const 16
+    i32.const 62
+    call $region)
+
+  ;; query wraps msg as {"custom":msg}, calls query_chain and unwraps the SystemResult. A system error becomes a
+  ;; fixed contract error.
+  (func $query (type $t_2i32_i32) (param $env i32) (param $msg i32) (result i32)
+    (local $msg_offset i32)
+    (local $msg_length i32)
+    (local $request i32)
+    (local $buffer i32)
+    (local $response i32)
+    (local $response_offset i32)
+    (local $response_length i32)
+    local.get $msg
+    i32.load offset=0
+    local.set $msg_offset
+    local.get $msg
+    i32.load offset=8
+    local.set $msg_length
+    local.get $msg_length
+    i32.const 11
+    i32.add
+    call $allocate
+    local.set $request
+    local.get $request
+    i32.load offset=0
+    local.set $buffer
+    local.get $buffer
+    i32.const 80
+    i32.const 10
+    call $copy
+    local.get $buffer
+    i32.const 10
+    i32.add
+    local.get $msg_offset
+    local.get $msg_length
+    call $copy
+    local.get $buffer
+    local.get $msg_length
+    i32.add
+    i32.const 125
+    i32.store8 offset=10
+    local.get $request
+    local.get $msg_length
+    i32.const 11
+    i32.add
+    i32.store offset=8
+    local.get $request
+    call $query_chain
+    local.set $response
+    local.get $response
+    i32.load offset=0
+    local.set $response_offset
+    local.get $response
+    i32.load offset=8
+    local.set $response_length
+    local.get $response_offset
+    i32.load8_u offset=2
+    i32.const 111
+    i32.eq
+    if (result i32)
+      local.get $response_offset
+      i32.const 6
+      i32.add
+      local.get $response_length
+      i32.const 7
+      i32.sub
+      call $region
+    else
+      i32.const 96
+      i32.const 44
+      call $region
+    end)
+
+  ;; region returns a new region header pointing at length bytes already in memory.
+  (func $region (type $t_2i32_i32) (param $offset i32) (param $length i32) (result i32)
+    (local $region i32)
+    i32.const 0
+    call $allocate
+    local.set $region
+    local.get $region
+    local.get $offset
+    i32.store offset=0
+    local.get $region
+    local.get $length
+    i32.store offset=4
+    local.get $region
+    local.get $length
+    i32.store offset=8
+    local.get $region)
+
+  (func $copy (type $t_3i32_void) (param $dst i32) (param $src i32) (param $length i32)
+    block
+      loop
+        local.get $length
+        i32.eqz
+        br_if 1
+        local.get $dst
+        local.get $src
+        i32.load8_u offset=0
+        i32.store8 offset=0
+        local.get $dst
+        i32.const 1
+        i32.add
+        local.set $dst
+        local.get $src
+        i32.const 1
+        i32.add
+        local.set $src
+        local.get $length
+        i32.const 1
+        i32.sub
+        local.set $length
+        br 0
+      end
+    end)
+
+  (data (i32.const 16) "{\"ok\":{\"messages\":[],\"attributes\":[],\"events\":[],\"data\":null}}")
+  (data (i32.const 80) "{\"custom\":")
+  (data (i32.const 96) "{\"error\":\"kudora custom query system error\"}"))
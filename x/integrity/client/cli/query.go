@@ -1,6 +1,9 @@
 package cli
 
 import (
+	"fmt"
+	"strconv"
+
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -9,6 +12,15 @@ import (
 	"github.com/Kudora-Labs/kudora/x/integrity/types"
 )
 
+const (
+	flagExcludeRecords = "exclude-records"
+	flagSetVersion     = "set-version"
+	flagFromPeriod     = "from-period"
+	flagFromRoot       = "from-root"
+	flagToPeriod       = "to-period"
+	flagLimit          = "limit"
+)
+
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -22,6 +34,24 @@ func GetQueryCmd() *cobra.Command {
 		CmdQueryTenant(),
 		CmdQuerySet(),
 		CmdQueryRecord(),
+		CmdQueryRecordProof(),
+		CmdQueryRecordAbsenceProof(),
+		CmdQuerySetsByTenant(),
+		CmdQuerySetsByTenantAndType(),
+		CmdQueryRecords(),
+		CmdQueryTenants(),
+		CmdQueryTenantsByOwner(),
+		CmdQueryPendingTenantTransfersTo(),
+		CmdQueryTenantWriter(),
+		CmdQueryTenantWriters(),
+		CmdQueryChainHead(),
+		CmdQueryVerifyChain(),
+		CmdQueryPeriodFormat(),
+		CmdQuerySetsByPeriodRange(),
+		CmdQueryType(),
+		CmdQueryTypes(),
+		CmdQueryTenantProposal(),
+		CmdQueryTenantProposals(),
 	)
 
 	return cmd
@@ -62,10 +92,21 @@ func CmdQuerySet() *cobra.Command {
 				return err
 			}
 
+			excludeRecords, err := cmd.Flags().GetBool(flagExcludeRecords)
+			if err != nil {
+				return err
+			}
+			version, err := cmd.Flags().GetUint64(flagSetVersion)
+			if err != nil {
+				return err
+			}
+
 			res, err := types.NewQueryClient(clientCtx).IntegritySet(cmd.Context(), &types.QueryIntegritySetRequest{
-				Tenant: args[0],
-				Type:   args[1],
-				Period: args[2],
+				Tenant:         args[0],
+				Type:           args[1],
+				Period:         args[2],
+				ExcludeRecords: excludeRecords,
+				Version:        version,
 			})
 			if err != nil {
 				return err
@@ -75,6 +116,8 @@ func CmdQuerySet() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().Bool(flagExcludeRecords, false, "Return only the set header without its records")
+	cmd.Flags().Uint64(flagSetVersion, 0, "Set version to query; 0 selects the latest version")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
@@ -90,11 +133,523 @@ func CmdQueryRecord() *cobra.Command {
 				return err
 			}
 
+			version, err := cmd.Flags().GetUint64(flagSetVersion)
+			if err != nil {
+				return err
+			}
+
 			res, err := types.NewQueryClient(clientCtx).IntegrityRecord(cmd.Context(), &types.QueryIntegrityRecordRequest{
+				Tenant:  args[0],
+				Type:    args[1],
+				Period:  args[2],
+				Tag:     args[3],
+				Version: version,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSetVersion, 0, "Set version to query; 0 selects the latest version")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryRecordProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "record-proof [tenant] [type] [period] [tag]",
+		Short: "Query one encrypted integrity record with its Merkle inclusion proof",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetUint64(flagSetVersion)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).IntegrityRecordProof(cmd.Context(), &types.QueryIntegrityRecordProofRequest{
+				Tenant:  args[0],
+				Type:    args[1],
+				Period:  args[2],
+				Tag:     args[3],
+				Version: version,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSetVersion, 0, "Set version to query; 0 selects the latest version")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryRecordAbsenceProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "absence-proof [tenant] [type] [period] [tag]",
+		Short: "Prove that a tag is not part of an integrity set",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			version, err := cmd.Flags().GetUint64(flagSetVersion)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).IntegrityRecordAbsenceProof(cmd.Context(), &types.QueryIntegrityRecordAbsenceProofRequest{
+				Tenant:  args[0],
+				Type:    args[1],
+				Period:  args[2],
+				Tag:     args[3],
+				Version: version,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Uint64(flagSetVersion, 0, "Set version to query; 0 selects the latest version")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQuerySetsByTenant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sets [tenant]",
+		Short: "List the integrity set headers committed by one tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).IntegritySetsByTenant(cmd.Context(), &types.QueryIntegritySetsByTenantRequest{
+				Tenant:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sets")
+	return cmd
+}
+
+func CmdQuerySetsByTenantAndType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sets-by-type [tenant] [type]",
+		Short: "List the integrity set headers committed by one tenant for one type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).IntegritySetsByTenantAndType(cmd.Context(), &types.QueryIntegritySetsByTenantAndTypeRequest{
+				Tenant:     args[0],
+				Type:       args[1],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sets-by-type")
+	return cmd
+}
+
+func CmdQueryRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "records [tenant] [type] [period]",
+		Short: "Page through the encrypted records of one committed integrity set in tag order",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).IntegrityRecords(cmd.Context(), &types.QueryIntegrityRecordsRequest{
+				Tenant:     args[0],
+				Type:       args[1],
+				Period:     args[2],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+	return cmd
+}
+
+func CmdQueryTenants() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenants",
+		Short: "List all registered tenants",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).Tenants(cmd.Context(), &types.QueryTenantsRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tenants")
+	return cmd
+}
+
+func CmdQueryTenantsByOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenants-by-owner [owner]",
+		Short: "List the tenants owned by one address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).TenantsByOwner(cmd.Context(), &types.QueryTenantsByOwnerRequest{
+				Owner:      args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tenants-by-owner")
+	return cmd
+}
+
+func CmdQueryPendingTenantTransfersTo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-tenant-transfers [pending-owner]",
+		Short: "List the tenant ownership transfers waiting for one address to accept",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).PendingTenantTransfersTo(cmd.Context(), &types.QueryPendingTenantTransfersToRequest{
+				PendingOwner: args[0],
+				Pagination:   pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-tenant-transfers")
+	return cmd
+}
+
+func CmdQueryTenantWriter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenant-writer [tenant] [writer]",
+		Short: "Query the writer grant of one address on a tenant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).TenantWriter(cmd.Context(), &types.QueryTenantWriterRequest{
+				Tenant: args[0],
+				Writer: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryTenantWriters() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenant-writers [tenant]",
+		Short: "List the writer grants of a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).TenantWriters(cmd.Context(), &types.QueryTenantWritersRequest{
+				Tenant:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tenant-writers")
+	return cmd
+}
+
+func CmdQueryChainHead() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "chain-head [tenant] [type]",
+		Short: "Query the most recently committed set of a tenant and type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).ChainHead(cmd.Context(), &types.QueryChainHeadRequest{
+				Tenant: args[0],
+				Type:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryVerifyChain() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-chain [tenant] [type]",
+		Short: "Walk and check the set chain of a tenant and type from its head",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			fromPeriod, err := cmd.Flags().GetString(flagFromPeriod)
+			if err != nil {
+				return err
+			}
+			fromRoot, err := cmd.Flags().GetString(flagFromRoot)
+			if err != nil {
+				return err
+			}
+			limit, err := cmd.Flags().GetUint64(flagLimit)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).VerifyChain(cmd.Context(), &types.QueryVerifyChainRequest{
+				Tenant:     args[0],
+				Type:       args[1],
+				FromPeriod: fromPeriod,
+				FromRoot:   fromRoot,
+				Limit:      limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagFromPeriod, "", "Period to resume the walk from, taken from next_period of an earlier response")
+	cmd.Flags().String(flagFromRoot, "", "Root to resume the walk from, taken from next_root of an earlier response")
+	cmd.Flags().Uint64(flagLimit, 0, "Maximum number of links to visit; 0 uses the default")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryPeriodFormat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "period-format [tenant] [type]",
+		Short: "Query the period format a tenant declared for a type",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).PeriodFormat(cmd.Context(), &types.QueryPeriodFormatRequest{
+				Tenant: args[0],
+				Type:   args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQuerySetsByPeriodRange() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sets-by-period-range [tenant] [type]",
+		Short: "List the integrity set headers of a tenant and type between two periods, in period order",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from, err := cmd.Flags().GetString(flagFromPeriod)
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetString(flagToPeriod)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).IntegritySetsByPeriodRange(cmd.Context(), &types.QueryIntegritySetsByPeriodRangeRequest{
+				Tenant:     args[0],
+				Type:       args[1],
+				From:       from,
+				To:         to,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagFromPeriod, "", "First period of the range, inclusive; empty leaves the range open")
+	cmd.Flags().String(flagToPeriod, "", "Last period of the range, inclusive; empty leaves the range open")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "sets-by-period-range")
+	return cmd
+}
+
+func CmdQueryType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "type [tenant] [type]",
+		Short: "Query one registered integrity type of a tenant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).IntegrityType(cmd.Context(), &types.QueryIntegrityTypeRequest{
 				Tenant: args[0],
 				Type:   args[1],
-				Period: args[2],
-				Tag:    args[3],
 			})
 			if err != nil {
 				return err
@@ -107,3 +662,101 @@ func CmdQueryRecord() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdQueryTypes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "types [tenant]",
+		Short: "List the registered integrity types of a tenant",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).IntegrityTypes(cmd.Context(), &types.QueryIntegrityTypesRequest{
+				Tenant:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "types")
+	return cmd
+}
+
+func CmdQueryTenantProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenant-proposal [tenant] [proposal-id]",
+		Short: "Query one open proposal of a tenant owned by signers",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id %q: %w", args[1], err)
+			}
+
+			res, err := types.NewQueryClient(clientCtx).TenantProposal(cmd.Context(), &types.QueryTenantProposalRequest{
+				Tenant:     args[0],
+				ProposalId: proposalID,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdQueryTenantProposals() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tenant-proposals [tenant]",
+		Short: "List the open proposals of a tenant owned by signers",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := types.NewQueryClient(clientCtx).TenantProposals(cmd.Context(), &types.QueryTenantProposalsRequest{
+				Tenant:     args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "tenant-proposals")
+	return cmd
+}
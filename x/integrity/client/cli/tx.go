@@ -2,7 +2,10 @@ package cli
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
+	"strconv"
+	"strings"
 
 	"github.com/spf13/cobra"
 
@@ -13,6 +16,20 @@ import (
 	"github.com/Kudora-Labs/kudora/x/integrity/types"
 )
 
+const (
+	flagTypePrefixes  = "type-prefixes"
+	flagExpiresAt     = "expires-at"
+	flagExpiresHeight = "expires-height"
+	flagStorageURI    = "storage-uri"
+	flagStorageDigest = "storage-digest"
+	flagHashScheme    = "hash-scheme"
+	flagHashAlgorithm = "hash-algorithm"
+	flagPreviousRoot  = "previous-root"
+	flagDescription   = "description"
+	flagPeriodFormat  = "period-format"
+	flagDeprecated    = "deprecated"
+)
+
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -27,7 +44,26 @@ func GetTxCmd() *cobra.Command {
 		CmdTransferTenantOwnership(),
 		CmdAcceptTenantOwnership(),
 		CmdCancelTenantOwnershipTransfer(),
+		CmdFreezeTenant(),
+		CmdUnfreezeTenant(),
+		CmdArchiveTenant(),
 		CmdCommitSet(),
+		CmdCommitSets(),
+		CmdSupersedeSet(),
+		CmdOpenSet(),
+		CmdAppendRecords(),
+		CmdSealSet(),
+		CmdCancelSet(),
+		CmdAnchorRoot(),
+		CmdRevokeRecords(),
+		CmdGrantTenantWriter(),
+		CmdRevokeTenantWriter(),
+		CmdSetPeriodFormat(),
+		CmdRegisterType(),
+		CmdSetTenantSigners(),
+		CmdProposeTenantAction(),
+		CmdApproveTenantAction(),
+		CmdExecuteTenantAction(),
 	)
 
 	return cmd
@@ -76,12 +112,177 @@ func CmdCommitSet() *cobra.Command {
 				return err
 			}
 
+			hasher, err := merkleHasherFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			previousRoot, err := cmd.Flags().GetString(flagPreviousRoot)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgCommitIntegritySet{
+				Creator:       clientCtx.GetFromAddress().String(),
+				Tenant:        args[0],
+				Type:          args[1],
+				Period:        args[2],
+				Root:          args[3],
+				Records:       records,
+				HashScheme:    hasher.Scheme,
+				HashAlgorithm: hasher.Algorithm,
+				PreviousRoot:  previousRoot,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMerkleHashFlags(cmd)
+	cmd.Flags().String(flagPreviousRoot, "", "Root of the chain head of the tenant and type the set links to; checked against the head when set")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCommitSets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-sets [sets-file]",
+		Short: "Commit several encrypted integrity sets in one transaction",
+		Long:  "Commit several encrypted integrity sets atomically. The file holds a JSON array of {tenant, type, period, root, records} entries, optionally wrapped in {\"sets\": [...]}.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sets, err := readIntegritySetsFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCommitIntegritySets{
+				Creator: clientCtx.GetFromAddress().String(),
+				Sets:    sets,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSupersedeSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supersede-set [tenant] [type] [period] [root] [reason-hash] [records-file]",
+		Short: "Commit a corrected version of an existing integrity set",
+		Args:  cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			records, err := readIntegrityRecordsFile(args[5])
+			if err != nil {
+				return err
+			}
+
+			hasher, err := merkleHasherFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSupersedeIntegritySet{
+				Creator:       clientCtx.GetFromAddress().String(),
+				Tenant:        args[0],
+				Type:          args[1],
+				Period:        args[2],
+				Root:          args[3],
+				ReasonHash:    args[4],
+				Records:       records,
+				HashScheme:    hasher.Scheme,
+				HashAlgorithm: hasher.Algorithm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMerkleHashFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdOpenSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "open-set [tenant] [type] [period]",
+		Short: "Open an integrity set that accepts records in batches until it is sealed",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hasher, err := merkleHasherFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgOpenIntegritySet{
+				Creator:       clientCtx.GetFromAddress().String(),
+				Tenant:        args[0],
+				Type:          args[1],
+				Period:        args[2],
+				HashScheme:    hasher.Scheme,
+				HashAlgorithm: hasher.Algorithm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addMerkleHashFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdAppendRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "append-records [tenant] [type] [period] [records-file]",
+		Short: "Append a batch of encrypted records to an open integrity set",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			records, err := readIntegrityRecordsFile(args[3])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAppendIntegrityRecords{
 				Creator: clientCtx.GetFromAddress().String(),
 				Tenant:  args[0],
 				Type:    args[1],
 				Period:  args[2],
-				Root:    args[3],
 				Records: records,
 			}
 			if err := msg.ValidateBasic(); err != nil {
@@ -96,6 +297,121 @@ func CmdCommitSet() *cobra.Command {
 	return cmd
 }
 
+func CmdSealSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "seal-set [tenant] [type] [period] [root]",
+		Short: "Seal an open integrity set against its expected final root",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSealIntegritySet{
+				Creator: clientCtx.GetFromAddress().String(),
+				Tenant:  args[0],
+				Type:    args[1],
+				Period:  args[2],
+				Root:    args[3],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdCancelSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-set [tenant] [type] [period]",
+		Short: "Discard an open integrity set and the records appended to it",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelIntegritySet{
+				Creator: clientCtx.GetFromAddress().String(),
+				Tenant:  args[0],
+				Type:    args[1],
+				Period:  args[2],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdAnchorRoot() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "anchor-root [tenant] [type] [period] [root] [record-count]",
+		Short: "Anchor the root of an integrity set whose records are kept off chain",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			recordCount, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid record count %q: %w", args[4], err)
+			}
+			storageURI, err := cmd.Flags().GetString(flagStorageURI)
+			if err != nil {
+				return err
+			}
+			storageDigest, err := cmd.Flags().GetString(flagStorageDigest)
+			if err != nil {
+				return err
+			}
+
+			hasher, err := merkleHasherFromFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgAnchorIntegrityRoot{
+				Creator:       clientCtx.GetFromAddress().String(),
+				Tenant:        args[0],
+				Type:          args[1],
+				Period:        args[2],
+				Root:          args[3],
+				RecordCount:   recordCount,
+				StorageUri:    storageURI,
+				StorageDigest: storageDigest,
+				HashScheme:    hasher.Scheme,
+				HashAlgorithm: hasher.Algorithm,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagStorageURI, "", "Where the records of the set are kept off chain")
+	cmd.Flags().String(flagStorageDigest, "", "0x-prefixed 32-byte digest of the off-chain record bundle")
+	addMerkleHashFlags(cmd)
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdTransferTenantOwnership() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "transfer-tenant-ownership [tenant] [new-owner]",
@@ -107,10 +423,21 @@ func CmdTransferTenantOwnership() *cobra.Command {
 				return err
 			}
 
+			expiresAt, err := cmd.Flags().GetString(flagExpiresAt)
+			if err != nil {
+				return err
+			}
+			expiresHeight, err := cmd.Flags().GetUint64(flagExpiresHeight)
+			if err != nil {
+				return err
+			}
+
 			msg := &types.MsgTransferTenantOwnership{
-				Creator:  clientCtx.GetFromAddress().String(),
-				Tenant:   args[0],
-				NewOwner: args[1],
+				Creator:       clientCtx.GetFromAddress().String(),
+				Tenant:        args[0],
+				NewOwner:      args[1],
+				ExpiresAt:     expiresAt,
+				ExpiresHeight: expiresHeight,
 			}
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -120,6 +447,8 @@ func CmdTransferTenantOwnership() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagExpiresAt, "", "RFC3339 block time at which the offer expires")
+	cmd.Flags().Uint64(flagExpiresHeight, 0, "Block height at which the offer expires")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -178,23 +507,497 @@ func CmdCancelTenantOwnershipTransfer() *cobra.Command {
 	return cmd
 }
 
-func readIntegrityRecordsFile(path string) ([]types.IntegrityRecord, error) {
-	payload, err := os.ReadFile(path)
-	if err != nil {
-		return nil, err
-	}
+func CmdFreezeTenant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-tenant [tenant]",
+		Short: "Stop every set write of a tenant until it is unfrozen",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
 
-	var records []types.IntegrityRecord
-	if err := json.Unmarshal(payload, &records); err == nil && len(records) > 0 {
-		return records, nil
-	}
+			msg := &types.MsgFreezeTenant{
+				Creator: clientCtx.GetFromAddress().String(),
+				Tenant:  args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
 
-	var wrapper struct {
-		Records []types.IntegrityRecord `json:"records"`
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
 	}
-	if err := json.Unmarshal(payload, &wrapper); err != nil {
-		return nil, err
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdUnfreezeTenant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unfreeze-tenant [tenant]",
+		Short: "Make a frozen tenant active again",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUnfreezeTenant{
+				Creator: clientCtx.GetFromAddress().String(),
+				Tenant:  args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
 	}
 
-	return wrapper.Records, nil
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdArchiveTenant() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "archive-tenant [tenant]",
+		Short: "Retire a tenant for good, keeping its sets readable",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgArchiveTenant{
+				Creator: clientCtx.GetFromAddress().String(),
+				Tenant:  args[0],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRevokeRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-records [tenant] [type] [period] [reason-code] [tag]...",
+		Short: "Mark records of the latest version of an integrity set as revoked without changing the set",
+		Args:  cobra.MinimumNArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			reasonCode, err := strconv.ParseUint(args[3], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid reason code %q: %w", args[3], err)
+			}
+
+			msg := &types.MsgRevokeIntegrityRecords{
+				Creator:    clientCtx.GetFromAddress().String(),
+				Tenant:     args[0],
+				Type:       args[1],
+				Period:     args[2],
+				Tags:       args[4:],
+				ReasonCode: uint32(reasonCode),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGrantTenantWriter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-tenant-writer [tenant] [writer]",
+		Short: "Allow an address to commit integrity sets for a tenant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			typePrefixes, err := cmd.Flags().GetStringSlice(flagTypePrefixes)
+			if err != nil {
+				return err
+			}
+			expiresAt, err := cmd.Flags().GetString(flagExpiresAt)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgGrantTenantWriter{
+				Creator:      clientCtx.GetFromAddress().String(),
+				Tenant:       args[0],
+				Writer:       args[1],
+				TypePrefixes: typePrefixes,
+				ExpiresAt:    expiresAt,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().StringSlice(flagTypePrefixes, nil, "Restrict the grant to integrity types starting with one of these prefixes")
+	cmd.Flags().String(flagExpiresAt, "", "RFC3339 block time at which the grant expires")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRevokeTenantWriter() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-tenant-writer [tenant] [writer]",
+		Short: "Revoke a tenant writer grant",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRevokeTenantWriter{
+				Creator: clientCtx.GetFromAddress().String(),
+				Tenant:  args[0],
+				Writer:  args[1],
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdSetPeriodFormat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-period-format [tenant] [type] [format]",
+		Short: "Declare the period format of an integrity type: iso-date, iso-week, month, interval, sequence or unspecified",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			format, err := enumFromString(args[2], "period format", "PERIOD_FORMAT_", types.PeriodFormat_value)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgSetPeriodFormat{
+				Creator: clientCtx.GetFromAddress().String(),
+				Tenant:  args[0],
+				Type:    args[1],
+				Format:  types.PeriodFormat(format),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdRegisterType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-type [tenant] [type] [schema-hash]",
+		Short: "Register or update an integrity type of a tenant with the hash of its off-chain record schema",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(flagDescription)
+			if err != nil {
+				return err
+			}
+			periodFormat, err := enumFromFlag(cmd, flagPeriodFormat, "PERIOD_FORMAT_", types.PeriodFormat_value)
+			if err != nil {
+				return err
+			}
+			hashScheme, err := enumFromFlag(cmd, flagHashScheme, "HASH_SCHEME_", types.HashScheme_value)
+			if err != nil {
+				return err
+			}
+			deprecated, err := cmd.Flags().GetBool(flagDeprecated)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgRegisterIntegrityType{
+				Creator:      clientCtx.GetFromAddress().String(),
+				Tenant:       args[0],
+				Type:         args[1],
+				Description:  description,
+				SchemaHash:   args[2],
+				PeriodFormat: types.PeriodFormat(periodFormat),
+				HashScheme:   types.HashScheme(hashScheme),
+				Deprecated:   deprecated,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagDescription, "", "Human readable description of the type")
+	cmd.Flags().String(flagPeriodFormat, "unspecified", "Period format of the type: iso-date, iso-week, month, interval, sequence or unspecified")
+	cmd.Flags().String(flagHashScheme, "v1", "Merkle hash scheme every set of the type must use: v1 or v2")
+	cmd.Flags().Bool(flagDeprecated, false, "Reject new sets of the type")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addMerkleHashFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagHashScheme, "v1", "Merkle hash scheme of the root: v1 (legacy) or v2 (RFC 6962 domain separation)")
+	cmd.Flags().String(flagHashAlgorithm, "sha256", "Hash algorithm of the root: sha256, keccak256 or blake3")
+}
+
+// merkleHasherFromFlags reads the hash scheme and hash algorithm flags.
+func merkleHasherFromFlags(cmd *cobra.Command) (types.MerkleHasher, error) {
+	scheme, err := enumFromFlag(cmd, flagHashScheme, "HASH_SCHEME_", types.HashScheme_value)
+	if err != nil {
+		return types.MerkleHasher{}, err
+	}
+	algorithm, err := enumFromFlag(cmd, flagHashAlgorithm, "HASH_ALGORITHM_", types.HashAlgorithm_value)
+	if err != nil {
+		return types.MerkleHasher{}, err
+	}
+	return types.MerkleHasher{Scheme: types.HashScheme(scheme), Algorithm: types.HashAlgorithm(algorithm)}, nil
+}
+
+// enumFromFlag accepts the short form of an enum value, such as v2 or blake3, as well as its full name.
+func enumFromFlag(cmd *cobra.Command, flag, prefix string, values map[string]int32) (int32, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil {
+		return 0, err
+	}
+	return enumFromString(value, strings.ReplaceAll(flag, "-", " "), prefix, values)
+}
+
+// enumFromString resolves the short, dash-separated form of an enum value, such as iso-date, as well as its
+// full name.
+func enumFromString(value, label, prefix string, values map[string]int32) (int32, error) {
+	name := strings.ReplaceAll(strings.ToUpper(strings.TrimSpace(value)), "-", "_")
+	if !strings.HasPrefix(name, prefix) {
+		name = prefix + name
+	}
+	enum, ok := values[name]
+	if !ok {
+		return 0, fmt.Errorf("unknown %s %q", label, value)
+	}
+	return enum, nil
+}
+
+func CmdSetTenantSigners() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-tenant-signers [tenant] [threshold] [signer]...",
+		Short: "Hand ownership of a tenant to signers that act through threshold-approved proposals",
+		Args:  cobra.MinimumNArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			threshold, err := strconv.ParseUint(args[1], 10, 32)
+			if err != nil {
+				return fmt.Errorf("invalid threshold %q: %w", args[1], err)
+			}
+
+			msg := &types.MsgSetTenantSigners{
+				Creator:   clientCtx.GetFromAddress().String(),
+				Tenant:    args[0],
+				Signers:   args[2:],
+				Threshold: uint32(threshold),
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdProposeTenantAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-tenant-action [tenant] [action-file]",
+		Short: "Propose an owner action for a tenant owned by signers",
+		Long:  "Propose an owner action for a tenant owned by signers. The file holds one JSON TenantAction, for example {\"freeze_tenant\": {\"tenant\": \"acme\"}}. The creator of the wrapped message may be left empty.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			payload, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var action types.TenantAction
+			if err := clientCtx.Codec.UnmarshalJSON(payload, &action); err != nil {
+				return err
+			}
+
+			msg := &types.MsgProposeTenantAction{
+				Creator: clientCtx.GetFromAddress().String(),
+				Tenant:  args[0],
+				Action:  action,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdApproveTenantAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve-tenant-action [tenant] [proposal-id]",
+		Short: "Approve an open proposal of a tenant owned by signers",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id %q: %w", args[1], err)
+			}
+
+			msg := &types.MsgApproveTenantAction{
+				Creator:    clientCtx.GetFromAddress().String(),
+				Tenant:     args[0],
+				ProposalId: proposalID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdExecuteTenantAction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "execute-tenant-action [tenant] [proposal-id]",
+		Short: "Execute a proposal of a tenant owned by signers once it reached its threshold",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proposalID, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid proposal id %q: %w", args[1], err)
+			}
+
+			msg := &types.MsgExecuteTenantAction{
+				Creator:    clientCtx.GetFromAddress().String(),
+				Tenant:     args[0],
+				ProposalId: proposalID,
+			}
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return clienttx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func readIntegrityRecordsFile(path string) ([]types.IntegrityRecord, error) {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var records []types.IntegrityRecord
+	if err := json.Unmarshal(payload, &records); err == nil && len(records) > 0 {
+		return records, nil
+	}
+
+	var wrapper struct {
+		Records []types.IntegrityRecord `json:"records"`
+	}
+	if err := json.Unmarshal(payload, &wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Records, nil
+}
+
+func readIntegritySetsFile(path string) ([]types.IntegritySetPayload, error) {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sets []types.IntegritySetPayload
+	if err := json.Unmarshal(payload, &sets); err == nil && len(sets) > 0 {
+		return sets, nil
+	}
+
+	var wrapper struct {
+		Sets []types.IntegritySetPayload `json:"sets"`
+	}
+	if err := json.Unmarshal(payload, &wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Sets, nil
 }
@@ -0,0 +1,115 @@
+package cli
+
+import (
+	"bytes"
+	"os"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// GetOfflineCmd returns the integrity commands that never contact a node.
+func GetOfflineCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Offline integrity utilities",
+		DisableFlagParsing:         false,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		CmdVerifyProof(),
+		CmdVerifyAbsenceProof(),
+	)
+
+	return cmd
+}
+
+func CmdVerifyProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-proof [root] [proof-file]",
+		Short: "Verify a record inclusion proof against a root without a node connection",
+		Long: `Verify a record inclusion proof against a trusted integrity set root.
+
+The proof file is the JSON output of "query integrity record-proof"; only its
+"record" and "proof" fields are used. The root argument is never read from the file.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			record, proof, err := readRecordProofFile(args[1])
+			if err != nil {
+				return err
+			}
+
+			if err := types.VerifyRecordProof(args[0], record, proof); err != nil {
+				return err
+			}
+
+			cmd.Printf("record %s is included in root %s\n", record.Tag, args[0])
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func CmdVerifyAbsenceProof() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-absence-proof [root] [tag] [proof-file]",
+		Short: "Verify that a tag is not part of a root without a node connection",
+		Long: `Verify a record absence proof against a trusted integrity set root.
+
+The proof file is the JSON output of "query integrity absence-proof"; only its
+"proof" field is used. The root and tag arguments are never read from the file.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proof, err := readRecordAbsenceProofFile(args[2])
+			if err != nil {
+				return err
+			}
+
+			if err := types.VerifyRecordAbsenceProof(args[0], args[1], proof); err != nil {
+				return err
+			}
+
+			cmd.Printf("record %s is not included in root %s\n", args[1], args[0])
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func readRecordProofFile(path string) (types.IntegrityRecord, types.IntegrityRecordProof, error) {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, err
+	}
+
+	var response types.QueryIntegrityRecordProofResponse
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(bytes.NewReader(payload), &response); err != nil {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, err
+	}
+
+	return response.Record, response.Proof, nil
+}
+
+func readRecordAbsenceProofFile(path string) (types.IntegrityRecordAbsenceProof, error) {
+	payload, err := os.ReadFile(path)
+	if err != nil {
+		return types.IntegrityRecordAbsenceProof{}, err
+	}
+
+	var response types.QueryIntegrityRecordAbsenceProofResponse
+	unmarshaler := jsonpb.Unmarshaler{AllowUnknownFields: true}
+	if err := unmarshaler.Unmarshal(bytes.NewReader(payload), &response); err != nil {
+		return types.IntegrityRecordAbsenceProof{}, err
+	}
+
+	return response.Proof, nil
+}
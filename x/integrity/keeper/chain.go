@@ -0,0 +1,145 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k Keeper) GetChainHead(ctx context.Context, tenant, integrityType string) (types.ChainHead, error) {
+	head, err := k.ChainHeads.Get(ctx, collections.Join(tenant, integrityType))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ChainHead{}, types.ErrChainHeadNotFound.Wrapf("%s/%s has no committed chain", tenant, integrityType)
+		}
+		return types.ChainHead{}, err
+	}
+
+	return head, nil
+}
+
+// nextChainLink resolves the chain link of a set about to be committed, sealed or anchored. The set links to
+// the current chain head of its tenant and type, or starts the chain when there is none, so that no set can
+// leave the chain. A non-empty previousRoot must equal the root of the head.
+func (k Keeper) nextChainLink(ctx context.Context, tenant, integrityType, previousRoot string) (types.ChainLink, error) {
+	head, err := k.GetChainHead(ctx, tenant, integrityType)
+	if err != nil {
+		if !errors.Is(err, types.ErrChainHeadNotFound) {
+			return types.ChainLink{}, err
+		}
+		if previousRoot != "" {
+			return types.ChainLink{}, types.ErrChainLinkMismatch.Wrap(err.Error())
+		}
+		return types.ChainLink{Length: 1}, nil
+	}
+	if previousRoot != "" && head.Root != previousRoot {
+		return types.ChainLink{}, types.ErrChainLinkMismatch.Wrapf("previous root %s does not match head %s of %s/%s at period %s", previousRoot, head.Root, tenant, integrityType, head.Period)
+	}
+
+	return types.ChainLink{
+		PreviousPeriod: head.Period,
+		PreviousRoot:   head.Root,
+		Length:         head.Length + 1,
+	}, nil
+}
+
+// setChainHead makes a stored set the head of its tenant and type chain.
+func (k Keeper) setChainHead(ctx context.Context, set types.IntegritySet) error {
+	return k.ChainHeads.Set(ctx, collections.Join(set.Tenant, set.Type), types.ChainHead{
+		Tenant: set.Tenant,
+		Type:   set.Type,
+		Period: set.Period,
+		Root:   set.Root,
+		Length: set.ChainLength,
+	})
+}
+
+// moveChainHead points the chain head at the new version of a superseded set when the set was the head.
+func (k Keeper) moveChainHead(ctx context.Context, superseded, set types.IntegritySet) error {
+	head, err := k.GetChainHead(ctx, set.Tenant, set.Type)
+	if err != nil {
+		if errors.Is(err, types.ErrChainHeadNotFound) {
+			return nil
+		}
+		return err
+	}
+	if head.Period != superseded.Period || head.Root != superseded.Root {
+		return nil
+	}
+
+	return k.setChainHead(ctx, set)
+}
+
+// VerifyChain walks up to limit links of a chain, starting at the set of period whose current or superseded
+// version has root, and checks that every set links to the root and length of the one before it. It
+// returns the visited links and, when the chain start was not reached, the period and root to resume from.
+func (k Keeper) VerifyChain(ctx context.Context, tenant, integrityType, period, root string, limit int) ([]types.ChainLink, string, string, error) {
+	links := make([]types.ChainLink, 0)
+	var expectedLength uint64
+	for len(links) < limit {
+		set, err := k.getIntegritySetVersionByRoot(ctx, tenant, integrityType, period, root)
+		if err != nil {
+			if len(links) > 0 && errors.Is(err, types.ErrIntegritySetNotFound) {
+				return nil, "", "", types.ErrChainBroken.Wrapf("link from %s: %s", links[len(links)-1].Period, err.Error())
+			}
+			return nil, "", "", err
+		}
+		if set.ChainLength == 0 {
+			return nil, "", "", types.ErrChainBroken.Wrapf("set %s/%s/%s is not part of a chain", tenant, integrityType, period)
+		}
+		if expectedLength != 0 && set.ChainLength != expectedLength {
+			return nil, "", "", types.ErrChainBroken.Wrapf("set %s/%s/%s has chain length %d, expected %d", tenant, integrityType, period, set.ChainLength, expectedLength)
+		}
+
+		links = append(links, types.ChainLink{
+			Period:         set.Period,
+			Root:           set.Root,
+			Version:        set.Version,
+			PreviousPeriod: set.ChainPreviousPeriod,
+			PreviousRoot:   set.ChainPreviousRoot,
+			Length:         set.ChainLength,
+		})
+		if set.ChainPreviousRoot == "" {
+			if set.ChainLength != 1 {
+				return nil, "", "", types.ErrChainBroken.Wrapf("set %s/%s/%s starts a chain with length %d", tenant, integrityType, period, set.ChainLength)
+			}
+			return links, "", "", nil
+		}
+
+		period, root, expectedLength = set.ChainPreviousPeriod, set.ChainPreviousRoot, set.ChainLength-1
+	}
+
+	return links, period, root, nil
+}
+
+// getIntegritySetVersionByRoot returns the version of a set that had root, looking at the latest version
+// first and at the version history after that.
+func (k Keeper) getIntegritySetVersionByRoot(ctx context.Context, tenant, integrityType, period, root string) (types.IntegritySet, error) {
+	latest, err := k.GetIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		return types.IntegritySet{}, err
+	}
+	if latest.Root == root {
+		return latest, nil
+	}
+
+	notFound := types.ErrIntegritySetNotFound.Wrapf("no version of set %s/%s/%s has root %s", tenant, integrityType, period, root)
+	// Sets stored before versioning carry version 0 until migrated and have no history either.
+	if latest.Version <= 1 {
+		return types.IntegritySet{}, notFound
+	}
+	for version := latest.Version - 1; version > 0; version-- {
+		archived, found, err := k.getArchivedIntegritySet(ctx, tenant, integrityType, period, version)
+		if err != nil {
+			return types.IntegritySet{}, err
+		}
+		if found && archived.Root == root {
+			return archived, nil
+		}
+	}
+
+	return types.IntegritySet{}, notFound
+}
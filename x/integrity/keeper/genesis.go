@@ -18,23 +18,75 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 	}
 
 	for _, tenant := range genState.Tenants {
+		if len(tenant.Signers) > 0 {
+			policyAddress, err := k.TenantPolicyAddress(tenant.Tenant)
+			if err != nil {
+				return err
+			}
+			if tenant.Owner != policyAddress {
+				return types.ErrInvalidTenantSigners.Wrapf("tenant %s with signers must be owned by %s", tenant.Tenant, policyAddress)
+			}
+		}
 		if err := k.Tenants.Set(ctx, tenant.Tenant, tenant); err != nil {
 			return err
 		}
+		if err := k.enqueuePendingOwner(ctx, tenant); err != nil {
+			return err
+		}
+	}
+
+	for _, grant := range genState.TenantWriters {
+		if err := k.TenantWriters.Set(ctx, collections.Join(grant.Tenant, grant.Writer), grant); err != nil {
+			return err
+		}
+	}
+
+	// Period formats come before the sets so that the period index uses them.
+	for _, periodFormat := range genState.PeriodFormats {
+		if err := k.PeriodFormats.Set(ctx, collections.Join(periodFormat.Tenant, periodFormat.Type), periodFormat); err != nil {
+			return err
+		}
+	}
+
+	for _, integrityType := range genState.IntegrityTypes {
+		if err := k.IntegrityTypes.Set(ctx, collections.Join(integrityType.Tenant, integrityType.Type), integrityType); err != nil {
+			return err
+		}
 	}
 
 	for _, bundle := range genState.IntegritySetBundles {
-		set := bundle.Set
-		if err := k.IntegritySets.Set(ctx, collections.Join3(set.Tenant, set.Type, set.Period), set); err != nil {
+		if err := k.storeIntegritySet(ctx, bundle.Set, bundle.Records); err != nil {
 			return err
 		}
-		for _, record := range bundle.Records {
-			if err := k.IntegrityRecords.Set(ctx, collections.Join4(set.Tenant, set.Type, set.Period, record.Tag), record); err != nil {
-				return err
-			}
+	}
+
+	for _, bundle := range genState.IntegritySetVersions {
+		if err := k.storeArchivedIntegritySet(ctx, bundle.Set, bundle.Records); err != nil {
+			return err
+		}
+	}
+
+	for _, revocation := range genState.RecordRevocations {
+		if err := k.RecordRevocations.Set(ctx, collections.Join4(revocation.Tenant, revocation.Type, revocation.Period, revocation.Tag), revocation); err != nil {
+			return err
 		}
 	}
 
+	for _, head := range genState.ChainHeads {
+		if err := k.ChainHeads.Set(ctx, collections.Join(head.Tenant, head.Type), head); err != nil {
+			return err
+		}
+	}
+
+	for _, proposal := range genState.TenantProposals {
+		if err := k.TenantProposals.Set(ctx, collections.Join(proposal.Tenant, proposal.Id), proposal); err != nil {
+			return err
+		}
+	}
+	if err := k.TenantProposalSeq.Set(ctx, genState.TenantProposalSeq); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -56,6 +108,14 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	genesis.TenantWriters = make([]types.TenantWriter, 0)
+	if err := k.TenantWriters.Walk(ctx, nil, func(_ collections.Pair[string, string], grant types.TenantWriter) (bool, error) {
+		genesis.TenantWriters = append(genesis.TenantWriters, grant)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
 	genesis.IntegritySetBundles = make([]types.IntegritySetBundle, 0)
 	if err := k.IntegritySets.Walk(
 		ctx,
@@ -75,5 +135,64 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	genesis.IntegritySetVersions = make([]types.IntegritySetBundle, 0)
+	if err := k.IntegritySetVersions.Walk(ctx, nil, func(versionKey collections.Quad[string, string, string, uint64], integritySet types.IntegritySet) (bool, error) {
+		records, err := k.listArchivedIntegrityRecords(ctx, versionKey)
+		if err != nil {
+			return true, err
+		}
+		genesis.IntegritySetVersions = append(genesis.IntegritySetVersions, types.IntegritySetBundle{
+			Set:     integritySet,
+			Records: records,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.ChainHeads = make([]types.ChainHead, 0)
+	if err := k.ChainHeads.Walk(ctx, nil, func(_ collections.Pair[string, string], head types.ChainHead) (bool, error) {
+		genesis.ChainHeads = append(genesis.ChainHeads, head)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.PeriodFormats = make([]types.TypePeriodFormat, 0)
+	if err := k.PeriodFormats.Walk(ctx, nil, func(_ collections.Pair[string, string], periodFormat types.TypePeriodFormat) (bool, error) {
+		genesis.PeriodFormats = append(genesis.PeriodFormats, periodFormat)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.IntegrityTypes = make([]types.IntegrityType, 0)
+	if err := k.IntegrityTypes.Walk(ctx, nil, func(_ collections.Pair[string, string], integrityType types.IntegrityType) (bool, error) {
+		genesis.IntegrityTypes = append(genesis.IntegrityTypes, integrityType)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.RecordRevocations = make([]types.IntegrityRecordRevocation, 0)
+	if err := k.RecordRevocations.Walk(ctx, nil, func(_ collections.Quad[string, string, string, string], revocation types.IntegrityRecordRevocation) (bool, error) {
+		genesis.RecordRevocations = append(genesis.RecordRevocations, revocation)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.TenantProposals = make([]types.TenantProposal, 0)
+	if err := k.TenantProposals.Walk(ctx, nil, func(_ collections.Pair[string, uint64], proposal types.TenantProposal) (bool, error) {
+		genesis.TenantProposals = append(genesis.TenantProposals, proposal)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	genesis.TenantProposalSeq, err = k.TenantProposalSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
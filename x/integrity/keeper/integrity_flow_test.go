@@ -2,10 +2,17 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	grpcstatus "google.golang.org/grpc/status"
@@ -71,6 +78,7 @@ func TestCommitIntegritySetLifecycle(t *testing.T) {
 	})
 	require.NoError(t, err)
 	require.Equal(t, mockSet.Root, setResp.Set.Root)
+	require.True(t, setResp.RecordsIncluded)
 	require.Len(t, setResp.Records, 2)
 	require.Equal(t, mockSet.SortedTags, []string{setResp.Records[0].Tag, setResp.Records[1].Tag})
 
@@ -322,6 +330,2018 @@ func TestCommitIntegritySetRejectsUnauthorizedDuplicateAndBadInputs(t *testing.T
 	require.ErrorIs(t, err, types.ErrInvalidRecord)
 }
 
+func TestCommitIntegritySetHonorsGovernanceLimits(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "initech"
+	integrityType := "initech.audit.v1"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.MaxRecordsPerSet = 2
+	params.MaxTenantLength = 8
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	_, err = msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: "initech-labs"})
+	require.ErrorIs(t, err, types.ErrInvalidTenant)
+
+	largeSet, err := integritymock.BuildMockSet(3, tenant, integrityType, "2026-06-25")
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+		Creator: owner,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  "2026-06-25",
+		Root:    largeSet.Root,
+		Records: largeSet.Records,
+	})
+	require.ErrorIs(t, err, types.ErrTooManyRecords)
+
+	commitSetOrFail(t, f.ctx, msgServer, owner, tenant, integrityType, "2026-06-25")
+}
+
+func TestCommitIntegritySetChargesStorageFee(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	owner := randomAddress()
+	ownerAddr := sdk.MustAccAddressFromBech32(owner)
+	tenant := "umbrella"
+	integrityType := "umbrella.audit.v1"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.BaseStorageFee = math.NewInt(1000)
+	params.StorageFeePerByte = math.NewInt(10)
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	buildSet := func(period string) (integritymock.MockSet, sdk.Coins) {
+		set, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+		require.NoError(t, err)
+		_, ciphertextBytes, err := types.PrepareIntegrityRecords(set.Records)
+		require.NoError(t, err)
+		fee := sdk.NewCoins(sdk.NewCoin(types.DefaultStorageFeeDenom, math.NewInt(1000+10*int64(ciphertextBytes))))
+		require.Equal(t, fee, params.StorageFee(ciphertextBytes))
+		return set, fee
+	}
+	commit := func(period string, set integritymock.MockSet) error {
+		_, err := msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+			Creator: owner,
+			Tenant:  tenant,
+			Type:    integrityType,
+			Period:  period,
+			Root:    set.Root,
+			Records: set.Records,
+		})
+		return err
+	}
+
+	poolSet, poolFee := buildSet("2026-06-25")
+	require.ErrorIs(t, commit("2026-06-25", poolSet), types.ErrStorageFeePayment)
+	_, err = f.keeper.GetIntegritySet(f.ctx, tenant, integrityType, "2026-06-25")
+	require.ErrorIs(t, err, types.ErrIntegritySetNotFound)
+
+	burnSet, burnFee := buildSet("2026-06-26")
+	f.bankKeeper.fund(ownerAddr, poolFee.Add(burnFee...))
+	require.NoError(t, commit("2026-06-25", poolSet))
+	require.Equal(t, poolFee, f.distrKeeper.communityPool)
+	require.True(t, f.bankKeeper.burned.IsZero())
+
+	params.BurnStorageFee = true
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	require.NoError(t, commit("2026-06-26", burnSet))
+	require.Equal(t, poolFee, f.distrKeeper.communityPool)
+	require.Equal(t, burnFee, f.bankKeeper.burned)
+	require.True(t, f.bankKeeper.balances[ownerAddr.String()].IsZero())
+}
+
+func TestTenantWriterLifecycle(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	writer := randomAddress()
+	newOwner := randomAddress()
+	tenant := "hooli"
+	now := time.Date(2026, 6, 25, 12, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now)
+
+	_, err := msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	_, err = msgServer.GrantTenantWriter(ctx, &types.MsgGrantTenantWriter{Creator: writer, Tenant: tenant, Writer: writer})
+	require.ErrorIs(t, err, types.ErrInvalidTenantWriter)
+	_, err = msgServer.GrantTenantWriter(ctx, &types.MsgGrantTenantWriter{Creator: newOwner, Tenant: tenant, Writer: writer})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	_, err = msgServer.GrantTenantWriter(ctx, &types.MsgGrantTenantWriter{
+		Creator:   owner,
+		Tenant:    tenant,
+		Writer:    writer,
+		ExpiresAt: now.Format(time.RFC3339),
+	})
+	require.ErrorIs(t, err, types.ErrInvalidTenantWriter)
+
+	expiresAt := now.Add(time.Hour)
+	_, err = msgServer.GrantTenantWriter(ctx, &types.MsgGrantTenantWriter{
+		Creator:      owner,
+		Tenant:       tenant,
+		Writer:       writer,
+		TypePrefixes: []string{"Hooli.Audit.", "hooli.audit."},
+		ExpiresAt:    expiresAt.Format(time.RFC3339),
+	})
+	require.NoError(t, err)
+
+	grantResp, err := queryServer.TenantWriter(ctx, &types.QueryTenantWriterRequest{Tenant: tenant, Writer: writer})
+	require.NoError(t, err)
+	require.Equal(t, []string{"hooli.audit."}, grantResp.Writer.TypePrefixes)
+	require.Equal(t, expiresAt.Format(time.RFC3339Nano), grantResp.Writer.ExpiresAt)
+	require.Equal(t, owner, grantResp.Writer.GrantedBy)
+
+	writersResp, err := queryServer.TenantWriters(ctx, &types.QueryTenantWritersRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Len(t, writersResp.Writers, 1)
+
+	writerSet := commitSetOrFail(t, ctx, msgServer, writer, tenant, "hooli.audit.v1", "2026-06-25")
+	setResp, err := queryServer.IntegritySet(ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: "hooli.audit.v1", Period: "2026-06-25"})
+	require.NoError(t, err)
+	require.Equal(t, writer, setResp.Set.Creator)
+	require.Equal(t, writerSet.Root, setResp.Set.Root)
+
+	outOfScope, err := integritymock.BuildMockSet(2, tenant, "hooli.billing.v1", "2026-06-25")
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(ctx, &types.MsgCommitIntegritySet{
+		Creator: writer,
+		Tenant:  tenant,
+		Type:    "hooli.billing.v1",
+		Period:  "2026-06-25",
+		Root:    outOfScope.Root,
+		Records: outOfScope.Records,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantWriter)
+
+	expired, err := integritymock.BuildMockSet(2, tenant, "hooli.audit.v1", "2026-06-26")
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(ctx.WithBlockTime(expiresAt), &types.MsgCommitIntegritySet{
+		Creator: writer,
+		Tenant:  tenant,
+		Type:    "hooli.audit.v1",
+		Period:  "2026-06-26",
+		Root:    expired.Root,
+		Records: expired.Records,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantWriter)
+
+	_, err = msgServer.RevokeTenantWriter(ctx, &types.MsgRevokeTenantWriter{Creator: owner, Tenant: tenant, Writer: writer})
+	require.NoError(t, err)
+	_, err = msgServer.RevokeTenantWriter(ctx, &types.MsgRevokeTenantWriter{Creator: owner, Tenant: tenant, Writer: writer})
+	require.ErrorIs(t, err, types.ErrTenantWriterNotFound)
+	_, err = msgServer.CommitIntegritySet(ctx, &types.MsgCommitIntegritySet{
+		Creator: writer,
+		Tenant:  tenant,
+		Type:    "hooli.audit.v1",
+		Period:  "2026-06-26",
+		Root:    expired.Root,
+		Records: expired.Records,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+
+	// Accepting a new owner drops the grants issued by the previous one.
+	_, err = msgServer.GrantTenantWriter(ctx, &types.MsgGrantTenantWriter{Creator: owner, Tenant: tenant, Writer: writer})
+	require.NoError(t, err)
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner})
+	require.NoError(t, err)
+	_, err = msgServer.AcceptTenantOwnership(ctx, &types.MsgAcceptTenantOwnership{Creator: newOwner, Tenant: tenant})
+	require.NoError(t, err)
+	_, err = queryServer.TenantWriter(ctx, &types.QueryTenantWriterRequest{Tenant: tenant, Writer: writer})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+}
+
+func TestSupersedeIntegritySetVersionHistory(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "umbrella"
+	integrityType := "umbrella.ledger.v1"
+	period := "2026-06-25"
+	reasonHash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	corrected, err := integritymock.BuildMockSet(3, tenant, integrityType, period)
+	require.NoError(t, err)
+	supersede := &types.MsgSupersedeIntegritySet{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Root:       corrected.Root,
+		Records:    corrected.Records,
+		ReasonHash: reasonHash,
+	}
+	_, err = msgServer.SupersedeIntegritySet(f.ctx, supersede)
+	require.ErrorIs(t, err, types.ErrIntegritySetNotFound)
+
+	original := commitSetOrFail(t, f.ctx, msgServer, owner, tenant, integrityType, period)
+
+	_, err = msgServer.SupersedeIntegritySet(f.ctx, &types.MsgSupersedeIntegritySet{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Root:       original.Root,
+		Records:    original.Records,
+		ReasonHash: reasonHash,
+	})
+	require.ErrorIs(t, err, types.ErrIntegritySetUnchanged)
+	_, err = msgServer.SupersedeIntegritySet(f.ctx, &types.MsgSupersedeIntegritySet{
+		Creator: owner,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Root:    corrected.Root,
+		Records: corrected.Records,
+	})
+	require.ErrorIs(t, err, types.ErrInvalidReasonHash)
+	_, err = msgServer.SupersedeIntegritySet(f.ctx, &types.MsgSupersedeIntegritySet{
+		Creator:    randomAddress(),
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Root:       corrected.Root,
+		Records:    corrected.Records,
+		ReasonHash: reasonHash,
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+
+	resp, err := msgServer.SupersedeIntegritySet(f.ctx, supersede)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), resp.Version)
+
+	latest, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), latest.Set.Version)
+	require.Equal(t, corrected.Root, latest.Set.Root)
+	require.Equal(t, original.Root, latest.Set.PreviousRoot)
+	require.Equal(t, reasonHash, latest.Set.ReasonHash)
+	require.Equal(t, types.StorageMode_STORAGE_MODE_ON_CHAIN, latest.Set.StorageMode)
+	require.Equal(t, corrected.SortedRecords, latest.Records)
+
+	first, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period, Version: 1})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), first.Set.Version)
+	require.Equal(t, original.Root, first.Set.Root)
+	require.Empty(t, first.Set.PreviousRoot)
+	require.Equal(t, original.SortedRecords, first.Records)
+
+	_, err = queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period, Version: 3})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+
+	recordResp, err := queryServer.IntegrityRecord(f.ctx, &types.QueryIntegrityRecordRequest{
+		Tenant: tenant,
+		Type:   integrityType,
+		Period: period,
+		Tag:    corrected.SortedTags[0],
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), recordResp.Set.Version)
+	require.Equal(t, corrected.SortedRecords[0], recordResp.Record)
+	recordResp, err = queryServer.IntegrityRecord(f.ctx, &types.QueryIntegrityRecordRequest{
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Tag:     original.SortedTags[0],
+		Version: 1,
+	})
+	require.NoError(t, err)
+	require.Equal(t, original.SortedRecords[0], recordResp.Record)
+
+	proofResp, err := queryServer.IntegrityRecordProof(f.ctx, &types.QueryIntegrityRecordProofRequest{
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Tag:     original.SortedTags[1],
+		Version: 1,
+	})
+	require.NoError(t, err)
+	require.NoError(t, types.VerifyRecordProof(original.Root, proofResp.Record, proofResp.Proof))
+
+	recordsResp, err := queryServer.IntegrityRecords(f.ctx, &types.QueryIntegrityRecordsRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	require.Equal(t, corrected.SortedRecords, recordsResp.Records)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.IntegritySetVersions, 1)
+
+	imported := initFixture(t)
+	require.NoError(t, imported.keeper.InitGenesis(imported.ctx, *exported))
+	reexported, err := imported.keeper.ExportGenesis(imported.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
+
+func TestStreamingIntegritySetLifecycle(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "soylent"
+	integrityType := "soylent.events.v1"
+	period := "2026-06-25"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(4, tenant, integrityType, period)
+	require.NoError(t, err)
+	appendMsg := func(records []types.IntegrityRecord) *types.MsgAppendIntegrityRecords {
+		return &types.MsgAppendIntegrityRecords{Creator: owner, Tenant: tenant, Type: integrityType, Period: period, Records: records}
+	}
+
+	_, err = msgServer.AppendIntegrityRecords(f.ctx, appendMsg(mockSet.Records[:2]))
+	require.ErrorIs(t, err, types.ErrIntegritySetNotFound)
+
+	_, err = msgServer.OpenIntegritySet(f.ctx, &types.MsgOpenIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	_, err = msgServer.OpenIntegritySet(f.ctx, &types.MsgOpenIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: period})
+	require.ErrorIs(t, err, types.ErrIntegritySetAlreadyExists)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+		Creator: owner,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.ErrorIs(t, err, types.ErrIntegritySetAlreadyExists)
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root})
+	require.ErrorIs(t, err, types.ErrEmptyRecords)
+
+	firstBatch, _, err := types.PrepareIntegrityRecords(mockSet.Records[:2])
+	require.NoError(t, err)
+	appendResp, err := msgServer.AppendIntegrityRecords(f.ctx, appendMsg(mockSet.Records[:2]))
+	require.NoError(t, err)
+	require.Equal(t, types.CalculateMerkleRootFromPreparedRecords(firstBatch), appendResp.Root)
+	require.Equal(t, uint64(2), appendResp.RecordCount)
+
+	openResp, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	require.True(t, openResp.Set.Open)
+	require.Equal(t, appendResp.Root, openResp.Set.Root)
+
+	_, err = msgServer.AppendIntegrityRecords(f.ctx, appendMsg(mockSet.Records[1:3]))
+	require.ErrorIs(t, err, types.ErrDuplicateTag)
+	_, err = msgServer.SupersedeIntegritySet(f.ctx, &types.MsgSupersedeIntegritySet{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Root:       mockSet.Root,
+		Records:    mockSet.Records,
+		ReasonHash: "0x1111111111111111111111111111111111111111111111111111111111111111",
+	})
+	require.ErrorIs(t, err, types.ErrIntegritySetOpen)
+	_, err = msgServer.RevokeIntegrityRecords(f.ctx, &types.MsgRevokeIntegrityRecords{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Tags:       []string{firstBatch[0].Tag},
+		ReasonCode: 1,
+	})
+	require.ErrorIs(t, err, types.ErrIntegritySetOpen)
+
+	appendResp, err = msgServer.AppendIntegrityRecords(f.ctx, appendMsg(mockSet.Records[2:]))
+	require.NoError(t, err)
+	require.Equal(t, mockSet.Root, appendResp.Root)
+	require.Equal(t, uint64(4), appendResp.RecordCount)
+
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: period, Root: firstBatch[0].Tag})
+	require.ErrorIs(t, err, types.ErrRootMismatch)
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root})
+	require.NoError(t, err)
+
+	sealedResp, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	require.False(t, sealedResp.Set.Open)
+	require.Equal(t, mockSet.Root, sealedResp.Set.Root)
+	require.Equal(t, mockSet.SortedRecords, sealedResp.Records)
+
+	extra, err := integritymock.BuildMockSet(5, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.AppendIntegrityRecords(f.ctx, appendMsg(extra.Records[4:]))
+	require.ErrorIs(t, err, types.ErrIntegritySetSealed)
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root})
+	require.ErrorIs(t, err, types.ErrIntegritySetSealed)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+}
+
+func TestCancelOpenIntegritySet(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	owner := randomAddress()
+	writer := randomAddress()
+	tenant := "soylent"
+	integrityType := "soylent.events.v1"
+	period := "2026-06-25"
+	v2 := types.HashScheme_HASH_SCHEME_V2
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+	_, err = msgServer.GrantTenantWriter(f.ctx, &types.MsgGrantTenantWriter{Creator: owner, Tenant: tenant, Writer: writer})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(3, tenant, integrityType, period)
+	require.NoError(t, err)
+	cancelMsg := &types.MsgCancelIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: period}
+	_, err = msgServer.CancelIntegritySet(f.ctx, cancelMsg)
+	require.ErrorIs(t, err, types.ErrIntegritySetNotFound)
+
+	_, err = msgServer.OpenIntegritySet(f.ctx, &types.MsgOpenIntegritySet{Creator: writer, Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	_, err = msgServer.AppendIntegrityRecords(f.ctx, &types.MsgAppendIntegrityRecords{Creator: writer, Tenant: tenant, Type: integrityType, Period: period, Records: mockSet.Records[:2]})
+	require.NoError(t, err)
+
+	// Registering the type under another hash scheme strands the open set: it can neither grow nor be sealed.
+	_, err = msgServer.RegisterIntegrityType(f.ctx, &types.MsgRegisterIntegrityType{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		SchemaHash: "0x2222222222222222222222222222222222222222222222222222222222222222",
+		HashScheme: v2,
+	})
+	require.NoError(t, err)
+	_, err = msgServer.AppendIntegrityRecords(f.ctx, &types.MsgAppendIntegrityRecords{Creator: writer, Tenant: tenant, Type: integrityType, Period: period, Records: mockSet.Records[2:]})
+	require.ErrorIs(t, err, types.ErrHashSchemeMismatch)
+	firstBatch, _, err := types.PrepareIntegrityRecords(mockSet.Records[:2])
+	require.NoError(t, err)
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: writer, Tenant: tenant, Type: integrityType, Period: period, Root: types.CalculateMerkleRootFromPreparedRecords(firstBatch)})
+	require.ErrorIs(t, err, types.ErrHashSchemeMismatch)
+
+	// Only the tenant owner can discard the set.
+	_, err = msgServer.CancelIntegritySet(f.ctx, &types.MsgCancelIntegritySet{Creator: writer, Tenant: tenant, Type: integrityType, Period: period})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	_, err = msgServer.CancelIntegritySet(f.ctx, cancelMsg)
+	require.NoError(t, err)
+
+	_, err = f.keeper.GetIntegritySet(f.ctx, tenant, integrityType, period)
+	require.ErrorIs(t, err, types.ErrIntegritySetNotFound)
+	records, err := f.keeper.ListIntegrityRecords(f.ctx, tenant, integrityType, period)
+	require.NoError(t, err)
+	require.Empty(t, records)
+	iter, err := f.keeper.IntegritySetPeriodIndex.Iterate(f.ctx, collections.NewSuperPrefixedTripleRange[string, string, string](tenant, integrityType))
+	require.NoError(t, err)
+	require.False(t, iter.Valid())
+	require.NoError(t, iter.Close())
+
+	// The period is free again and the reopened set follows the registration.
+	_, err = msgServer.OpenIntegritySet(f.ctx, &types.MsgOpenIntegritySet{Creator: writer, Tenant: tenant, Type: integrityType, Period: period, HashScheme: v2})
+	require.NoError(t, err)
+	_, err = msgServer.AppendIntegrityRecords(f.ctx, &types.MsgAppendIntegrityRecords{Creator: writer, Tenant: tenant, Type: integrityType, Period: period, Records: mockSet.Records})
+	require.NoError(t, err)
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: writer, Tenant: tenant, Type: integrityType, Period: period, Root: v2.CalculateMerkleRoot(mockSet.SortedRecords)})
+	require.NoError(t, err)
+	_, err = msgServer.CancelIntegritySet(f.ctx, cancelMsg)
+	require.ErrorIs(t, err, types.ErrIntegritySetSealed)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+}
+
+func TestCommitIntegritySetsAppliesAllOrNothing(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "umbrella"
+	integrityType := "umbrella.trials.v1"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	periods := []string{"2026-06-24", "2026-06-25", "2026-06-26"}
+	sets := make([]types.IntegritySetPayload, len(periods))
+	for i, period := range periods {
+		mockSet, err := integritymock.BuildMockSet(i+1, tenant, integrityType, period)
+		require.NoError(t, err)
+		sets[i] = types.IntegritySetPayload{Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root, Records: mockSet.Records}
+	}
+	batchMsg := func(sets ...types.IntegritySetPayload) *types.MsgCommitIntegritySets {
+		return &types.MsgCommitIntegritySets{Creator: owner, Sets: sets}
+	}
+
+	_, err = msgServer.CommitIntegritySets(f.ctx, batchMsg())
+	require.ErrorIs(t, err, types.ErrEmptyIntegritySetBatch)
+	_, err = msgServer.CommitIntegritySets(f.ctx, batchMsg(sets[0], sets[1], sets[0]))
+	require.ErrorIs(t, err, types.ErrDuplicateBatchSet)
+
+	// The last entry collides with a set committed on its own, so the whole batch must be rejected.
+	commitSetOrFail(t, f.ctx, msgServer, owner, tenant, integrityType, "2026-06-27")
+	conflicting := sets[2]
+	conflicting.Period = "2026-06-27"
+	_, err = msgServer.CommitIntegritySets(f.ctx, batchMsg(sets[0], sets[1], conflicting))
+	require.ErrorIs(t, err, types.ErrIntegritySetAlreadyExists)
+	for _, period := range periods[:2] {
+		exists, err := f.keeper.HasIntegritySet(f.ctx, tenant, integrityType, period)
+		require.NoError(t, err)
+		require.False(t, exists)
+	}
+
+	_, err = msgServer.CommitIntegritySets(f.ctx, &types.MsgCommitIntegritySets{Creator: randomAddress(), Sets: sets})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.MaxBatchCiphertextBytes = 1
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySets(f.ctx, batchMsg(sets...))
+	require.ErrorIs(t, err, types.ErrBatchCiphertextTooLarge)
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: types.DefaultParams()})
+	require.NoError(t, err)
+
+	sdkCtx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+	resp, err := msgServer.CommitIntegritySets(sdkCtx, batchMsg(sets...))
+	require.NoError(t, err)
+	require.Equal(t, uint64(len(sets)), resp.SetCount)
+
+	for i, period := range periods {
+		set, err := f.keeper.GetIntegritySet(f.ctx, tenant, integrityType, period)
+		require.NoError(t, err)
+		require.Equal(t, sets[i].Root, set.Root)
+		require.Equal(t, uint64(i+1), set.RecordCount)
+		require.Equal(t, owner, set.Creator)
+	}
+
+	var committed, summaries []sdk.Event
+	for _, event := range sdkCtx.EventManager().Events() {
+		switch event.Type {
+		case types.EventTypeIntegrityCommitted:
+			committed = append(committed, event)
+		case types.EventTypeIntegritySetsCommitted:
+			summaries = append(summaries, event)
+		}
+	}
+	require.Len(t, committed, len(sets))
+	require.Len(t, summaries, 1)
+	setCount, found := summaries[0].GetAttribute(types.AttributeKeySetCount)
+	require.True(t, found)
+	require.Equal(t, "3", setCount.Value)
+	recordCount, found := summaries[0].GetAttribute(types.AttributeKeyRecordCount)
+	require.True(t, found)
+	require.Equal(t, "6", recordCount.Value)
+}
+
+func TestAnchorIntegrityRootKeepsRecordsOffChain(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "tyrell"
+	integrityType := "tyrell.replicants.v1"
+	period := "2026-06-25"
+	digest := "0x2222222222222222222222222222222222222222222222222222222222222222"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(3, tenant, integrityType, period)
+	require.NoError(t, err)
+	anchorMsg := func(recordCount uint64, digest string) *types.MsgAnchorIntegrityRoot {
+		return &types.MsgAnchorIntegrityRoot{
+			Creator:       owner,
+			Tenant:        tenant,
+			Type:          integrityType,
+			Period:        period,
+			Root:          mockSet.Root,
+			RecordCount:   recordCount,
+			StorageUri:    " s3://tyrell-archive/2026-06-25.json ",
+			StorageDigest: digest,
+		}
+	}
+
+	_, err = msgServer.AnchorIntegrityRoot(f.ctx, anchorMsg(0, digest))
+	require.ErrorIs(t, err, types.ErrEmptyRecords)
+	_, err = msgServer.AnchorIntegrityRoot(f.ctx, anchorMsg(3, "0x1234"))
+	require.ErrorIs(t, err, types.ErrInvalidStorageReference)
+	_, err = msgServer.AnchorIntegrityRoot(f.ctx, anchorMsg(3, digest))
+	require.NoError(t, err)
+	_, err = msgServer.AnchorIntegrityRoot(f.ctx, anchorMsg(3, digest))
+	require.ErrorIs(t, err, types.ErrIntegritySetAlreadyExists)
+	_, err = msgServer.AppendIntegrityRecords(f.ctx, &types.MsgAppendIntegrityRecords{
+		Creator: owner,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Records: mockSet.Records,
+	})
+	require.ErrorIs(t, err, types.ErrIntegritySetSealed)
+
+	setResp, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	require.Equal(t, types.StorageMode_STORAGE_MODE_ROOT_ONLY, setResp.Set.StorageMode)
+	require.False(t, setResp.Set.RecordsOnChain())
+	require.Equal(t, mockSet.Root, setResp.Set.Root)
+	require.Equal(t, uint64(3), setResp.Set.RecordCount)
+	require.Equal(t, "s3://tyrell-archive/2026-06-25.json", setResp.Set.StorageUri)
+	require.Equal(t, digest, setResp.Set.StorageDigest)
+	require.False(t, setResp.RecordsIncluded)
+
+	_, err = queryServer.IntegrityRecord(f.ctx, &types.QueryIntegrityRecordRequest{Tenant: tenant, Type: integrityType, Period: period, Tag: mockSet.SortedTags[0]})
+	require.Equal(t, codes.FailedPrecondition, grpcstatus.Code(err))
+	_, err = queryServer.IntegrityRecordProof(f.ctx, &types.QueryIntegrityRecordProofRequest{Tenant: tenant, Type: integrityType, Period: period, Tag: mockSet.SortedTags[0]})
+	require.Equal(t, codes.FailedPrecondition, grpcstatus.Code(err))
+	_, err = queryServer.IntegrityRecords(f.ctx, &types.QueryIntegrityRecordsRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.Equal(t, codes.FailedPrecondition, grpcstatus.Code(err))
+
+	// The root was built off chain, so records proven against it locally still verify.
+	proof, err := types.CalculateMerkleProofFromPreparedRecords(mockSet.SortedRecords, 1)
+	require.NoError(t, err)
+	require.NoError(t, types.VerifyRecordProof(setResp.Set.Root, mockSet.SortedRecords[1], proof))
+
+	// A supersede brings the records on chain, while the archived version stays root-only.
+	correction, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.SupersedeIntegritySet(f.ctx, &types.MsgSupersedeIntegritySet{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Root:       correction.Root,
+		Records:    correction.Records,
+		ReasonHash: digest,
+	})
+	require.NoError(t, err)
+	setResp, err = queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	require.Equal(t, types.StorageMode_STORAGE_MODE_ON_CHAIN, setResp.Set.StorageMode)
+	require.Empty(t, setResp.Set.StorageUri)
+	require.True(t, setResp.RecordsIncluded)
+	require.Equal(t, correction.SortedRecords, setResp.Records)
+	archived, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period, Version: 1})
+	require.NoError(t, err)
+	require.Equal(t, types.StorageMode_STORAGE_MODE_ROOT_ONLY, archived.Set.StorageMode)
+	require.Equal(t, digest, archived.Set.StorageDigest)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+
+	imported := initFixture(t)
+	require.NoError(t, imported.keeper.InitGenesis(imported.ctx, *exported))
+	reexported, err := imported.keeper.ExportGenesis(imported.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
+
+func TestHashSchemeV2SetsProveUnderTheirScheme(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "cyberdyne"
+	integrityType := "cyberdyne.telemetry.v1"
+	period := "2026-06-25"
+	v2 := types.HashScheme_HASH_SCHEME_V2
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(5, tenant, integrityType, period)
+	require.NoError(t, err)
+	v2Root := v2.CalculateMerkleRoot(mockSet.SortedRecords)
+	commitMsg := &types.MsgCommitIntegritySet{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Root:       mockSet.Root,
+		Records:    mockSet.Records,
+		HashScheme: v2,
+	}
+
+	// The legacy root does not match once the message asks for V2.
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.ErrorIs(t, err, types.ErrRootMismatch)
+	commitMsg.HashScheme = types.HashScheme(9)
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.ErrorIs(t, err, types.ErrInvalidHashScheme)
+
+	commitMsg.Root = v2Root
+	commitMsg.HashScheme = v2
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.NoError(t, err)
+
+	for i, tag := range mockSet.SortedTags {
+		proofResp, err := queryServer.IntegrityRecordProof(f.ctx, &types.QueryIntegrityRecordProofRequest{Tenant: tenant, Type: integrityType, Period: period, Tag: tag})
+		require.NoError(t, err)
+		require.Equal(t, v2, proofResp.Set.HashScheme)
+		require.Equal(t, v2, proofResp.Proof.HashScheme)
+		require.Equal(t, uint64(i), proofResp.Proof.LeafIndex)
+		require.NoError(t, types.VerifyRecordProof(v2Root, proofResp.Record, proofResp.Proof))
+	}
+
+	// Streaming sets keep the scheme they were opened with for every rolling root and the seal.
+	streamPeriod := "2026-06-26"
+	streamSet, err := integritymock.BuildMockSet(3, tenant, integrityType, streamPeriod)
+	require.NoError(t, err)
+	_, err = msgServer.OpenIntegritySet(f.ctx, &types.MsgOpenIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: streamPeriod, HashScheme: v2})
+	require.NoError(t, err)
+	appendResp, err := msgServer.AppendIntegrityRecords(f.ctx, &types.MsgAppendIntegrityRecords{Creator: owner, Tenant: tenant, Type: integrityType, Period: streamPeriod, Records: streamSet.Records})
+	require.NoError(t, err)
+	require.Equal(t, v2.CalculateMerkleRoot(streamSet.SortedRecords), appendResp.Root)
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: streamPeriod, Root: streamSet.Root})
+	require.ErrorIs(t, err, types.ErrRootMismatch)
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: streamPeriod, Root: appendResp.Root})
+	require.NoError(t, err)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+}
+
+func TestHashAlgorithmsAreChosenPerSetAndGoverned(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "tyrell"
+	integrityType := "tyrell.replicants.v1"
+	keccak := types.HashAlgorithm_HASH_ALGORITHM_KECCAK256
+	blake3 := types.HashAlgorithm_HASH_ALGORITHM_BLAKE3
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(4, tenant, integrityType, "2026-07-01")
+	require.NoError(t, err)
+	keccakHasher := types.MerkleHasher{Algorithm: keccak}
+	keccakRoot := keccakHasher.CalculateMerkleRoot(mockSet.SortedRecords)
+	require.NotEqual(t, mockSet.Root, keccakRoot)
+	commitMsg := &types.MsgCommitIntegritySet{
+		Creator:       owner,
+		Tenant:        tenant,
+		Type:          integrityType,
+		Period:        "2026-07-01",
+		Root:          mockSet.Root,
+		Records:       mockSet.Records,
+		HashAlgorithm: keccak,
+	}
+
+	// The SHA-256 root does not match once the message asks for Keccak-256.
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.ErrorIs(t, err, types.ErrRootMismatch)
+	commitMsg.Root = keccakRoot
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.NoError(t, err)
+
+	for _, tag := range mockSet.SortedTags {
+		proofResp, err := queryServer.IntegrityRecordProof(f.ctx, &types.QueryIntegrityRecordProofRequest{Tenant: tenant, Type: integrityType, Period: "2026-07-01", Tag: tag})
+		require.NoError(t, err)
+		require.Equal(t, keccak, proofResp.Set.HashAlgorithm)
+		require.Equal(t, keccak, proofResp.Proof.HashAlgorithm)
+		require.NoError(t, types.VerifyRecordProof(keccakRoot, proofResp.Record, proofResp.Proof))
+	}
+
+	// Governance narrows the allowed algorithms; sets already committed keep proving under theirs.
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.AllowedHashAlgorithms = []types.HashAlgorithm{types.HashAlgorithm_HASH_ALGORITHM_SHA256, blake3}
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	otherSet, err := integritymock.BuildMockSet(3, tenant, integrityType, "2026-07-02")
+	require.NoError(t, err)
+	commitMsg = &types.MsgCommitIntegritySet{
+		Creator:       owner,
+		Tenant:        tenant,
+		Type:          integrityType,
+		Period:        "2026-07-02",
+		Root:          keccakHasher.CalculateMerkleRoot(otherSet.SortedRecords),
+		Records:       otherSet.Records,
+		HashAlgorithm: keccak,
+	}
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.ErrorIs(t, err, types.ErrHashAlgorithmNotAllowed)
+	_, err = msgServer.OpenIntegritySet(f.ctx, &types.MsgOpenIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: "2026-07-03", HashAlgorithm: keccak})
+	require.ErrorIs(t, err, types.ErrHashAlgorithmNotAllowed)
+	commitMsg.HashAlgorithm = types.HashAlgorithm(9)
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.ErrorIs(t, err, types.ErrInvalidHashAlgorithm)
+
+	blake3Hasher := types.MerkleHasher{Scheme: types.HashScheme_HASH_SCHEME_V2, Algorithm: blake3}
+	commitMsg.Root = blake3Hasher.CalculateMerkleRoot(otherSet.SortedRecords)
+	commitMsg.HashScheme = blake3Hasher.Scheme
+	commitMsg.HashAlgorithm = blake3
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.NoError(t, err)
+
+	proofResp, err := queryServer.IntegrityRecordProof(f.ctx, &types.QueryIntegrityRecordProofRequest{Tenant: tenant, Type: integrityType, Period: "2026-07-01", Tag: mockSet.SortedTags[0]})
+	require.NoError(t, err)
+	require.NoError(t, types.VerifyRecordProof(keccakRoot, proofResp.Record, proofResp.Proof))
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
+
+func TestIntegrityRecordAbsenceProofQuery(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	creator := randomAddress()
+	tenant := "initech"
+	integrityType := "initech.dataset.v1"
+	period := "2026-06-26"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: creator, Tenant: tenant})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(4, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+		Creator: creator,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.NoError(t, err)
+
+	// The lowest and highest possible tags bracket the set from outside, and the tag right after a
+	// committed one falls between two records.
+	firstTag := "0x" + strings.Repeat("00", 32)
+	lastTag := "0x" + strings.Repeat("ff", 32)
+	next, ok := new(big.Int).SetString(mockSet.SortedTags[1][2:], 16)
+	require.True(t, ok)
+	innerTag := fmt.Sprintf("0x%064x", next.Add(next, big.NewInt(1)))
+	require.NotEqual(t, mockSet.SortedTags[2], innerTag)
+	for _, tag := range []string{firstTag, lastTag, innerTag} {
+		resp, err := queryServer.IntegrityRecordAbsenceProof(f.ctx, &types.QueryIntegrityRecordAbsenceProofRequest{
+			Tenant: tenant,
+			Type:   integrityType,
+			Period: period,
+			Tag:    tag,
+		})
+		require.NoError(t, err)
+		require.Equal(t, mockSet.Root, resp.Set.Root)
+		require.NoError(t, types.VerifyRecordAbsenceProof(mockSet.Root, tag, resp.Proof))
+	}
+
+	_, err = queryServer.IntegrityRecordAbsenceProof(f.ctx, &types.QueryIntegrityRecordAbsenceProofRequest{
+		Tenant: tenant,
+		Type:   integrityType,
+		Period: period,
+		Tag:    mockSet.SortedTags[2],
+	})
+	require.Equal(t, codes.FailedPrecondition, grpcstatus.Code(err))
+	_, err = queryServer.IntegrityRecordAbsenceProof(f.ctx, &types.QueryIntegrityRecordAbsenceProofRequest{
+		Tenant: tenant,
+		Type:   integrityType,
+		Period: "2026-06-27",
+		Tag:    firstTag,
+	})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+}
+
+func TestIntegritySetChainLinksPeriods(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "umbrella"
+	integrityType := "umbrella.audit.v1"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	periods := []string{"2026-08-01", "2026-08-02", "2026-08-03"}
+	roots := make([]string, len(periods))
+	for i, period := range periods {
+		mockSet, err := integritymock.BuildMockSet(2+i, tenant, integrityType, period)
+		require.NoError(t, err)
+		msg := &types.MsgCommitIntegritySet{
+			Creator: owner,
+			Tenant:  tenant,
+			Type:    integrityType,
+			Period:  period,
+			Root:    mockSet.Root,
+			Records: mockSet.Records,
+		}
+		if i > 0 {
+			// Linking to anything but the head root is rejected.
+			msg.PreviousRoot = mockSet.Root
+			_, err = msgServer.CommitIntegritySet(f.ctx, msg)
+			require.ErrorIs(t, err, types.ErrChainLinkMismatch)
+			msg.PreviousRoot = roots[i-1]
+		}
+		_, err = msgServer.CommitIntegritySet(f.ctx, msg)
+		require.NoError(t, err)
+		roots[i] = mockSet.Root
+
+		headResp, err := queryServer.ChainHead(f.ctx, &types.QueryChainHeadRequest{Tenant: tenant, Type: integrityType})
+		require.NoError(t, err)
+		require.Equal(t, types.ChainHead{Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root, Length: uint64(i + 1)}, headResp.Head)
+	}
+
+	verifyResp, err := queryServer.VerifyChain(f.ctx, &types.QueryVerifyChainRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.True(t, verifyResp.Complete)
+	require.Len(t, verifyResp.Links, 3)
+	for i, link := range verifyResp.Links {
+		require.Equal(t, periods[2-i], link.Period)
+		require.Equal(t, roots[2-i], link.Root)
+		require.Equal(t, uint64(3-i), link.Length)
+	}
+	require.Empty(t, verifyResp.Links[2].PreviousRoot)
+
+	// A walk can be split and resumed.
+	firstPage, err := queryServer.VerifyChain(f.ctx, &types.QueryVerifyChainRequest{Tenant: tenant, Type: integrityType, Limit: 2})
+	require.NoError(t, err)
+	require.False(t, firstPage.Complete)
+	require.Equal(t, periods[0], firstPage.NextPeriod)
+	secondPage, err := queryServer.VerifyChain(f.ctx, &types.QueryVerifyChainRequest{Tenant: tenant, Type: integrityType, FromPeriod: firstPage.NextPeriod, FromRoot: firstPage.NextRoot})
+	require.NoError(t, err)
+	require.True(t, secondPage.Complete)
+	require.Equal(t, verifyResp.Links[2:], secondPage.Links)
+
+	// Superseding a linked set keeps the chain verifiable through the version history, and superseding
+	// the head moves the head to the new root.
+	for _, i := range []int{1, 2} {
+		correction, err := integritymock.BuildMockSet(5, tenant, integrityType, periods[i])
+		require.NoError(t, err)
+		_, err = msgServer.SupersedeIntegritySet(f.ctx, &types.MsgSupersedeIntegritySet{
+			Creator:    owner,
+			Tenant:     tenant,
+			Type:       integrityType,
+			Period:     periods[i],
+			Root:       correction.Root,
+			Records:    correction.Records,
+			ReasonHash: "0x" + strings.Repeat("ab", 32),
+		})
+		require.NoError(t, err)
+		roots[i] = correction.Root
+	}
+	headResp, err := queryServer.ChainHead(f.ctx, &types.QueryChainHeadRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.Equal(t, roots[2], headResp.Head.Root)
+	require.Equal(t, uint64(3), headResp.Head.Length)
+	verifyResp, err = queryServer.VerifyChain(f.ctx, &types.QueryVerifyChainRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.True(t, verifyResp.Complete)
+	require.Equal(t, uint64(1), verifyResp.Links[1].Version)
+	require.Equal(t, uint64(2), verifyResp.Links[0].Version)
+
+	// A set committed without a previous root, a sealed set and an anchored root all link to the head, so
+	// none of them can leave the chain.
+	unlinked, err := integritymock.BuildMockSet(2, tenant, integrityType, "2026-08-05")
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: "2026-08-05", Root: unlinked.Root, Records: unlinked.Records})
+	require.NoError(t, err)
+	streamed, err := integritymock.BuildMockSet(2, tenant, integrityType, "2026-08-06")
+	require.NoError(t, err)
+	_, err = msgServer.OpenIntegritySet(f.ctx, &types.MsgOpenIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: "2026-08-06"})
+	require.NoError(t, err)
+	_, err = msgServer.AppendIntegrityRecords(f.ctx, &types.MsgAppendIntegrityRecords{Creator: owner, Tenant: tenant, Type: integrityType, Period: "2026-08-06", Records: streamed.Records})
+	require.NoError(t, err)
+	_, err = msgServer.SealIntegritySet(f.ctx, &types.MsgSealIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: "2026-08-06", Root: streamed.Root})
+	require.NoError(t, err)
+	anchored, err := integritymock.BuildMockSet(3, tenant, integrityType, "2026-08-07")
+	require.NoError(t, err)
+	_, err = msgServer.AnchorIntegrityRoot(f.ctx, &types.MsgAnchorIntegrityRoot{
+		Creator:       owner,
+		Tenant:        tenant,
+		Type:          integrityType,
+		Period:        "2026-08-07",
+		Root:          anchored.Root,
+		RecordCount:   3,
+		StorageUri:    "s3://umbrella-audit/2026-08-07.json",
+		StorageDigest: "0x" + strings.Repeat("22", 32),
+	})
+	require.NoError(t, err)
+	for i, period := range []string{"2026-08-05", "2026-08-06", "2026-08-07"} {
+		set, err := f.keeper.GetIntegritySet(f.ctx, tenant, integrityType, period)
+		require.NoError(t, err)
+		require.Equal(t, uint64(4+i), set.ChainLength)
+	}
+	headResp, err = queryServer.ChainHead(f.ctx, &types.QueryChainHeadRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.Equal(t, types.ChainHead{Tenant: tenant, Type: integrityType, Period: "2026-08-07", Root: anchored.Root, Length: 6}, headResp.Head)
+	verifyResp, err = queryServer.VerifyChain(f.ctx, &types.QueryVerifyChainRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.True(t, verifyResp.Complete)
+	require.Len(t, verifyResp.Links, 6)
+	require.Equal(t, periods[2], verifyResp.Links[3].Period)
+
+	_, err = queryServer.ChainHead(f.ctx, &types.QueryChainHeadRequest{Tenant: tenant, Type: "umbrella.other.v1"})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+	_, err = queryServer.VerifyChain(f.ctx, &types.QueryVerifyChainRequest{Tenant: tenant, Type: integrityType, Limit: types.MaxChainVerifyLimit + 1})
+	require.Equal(t, codes.InvalidArgument, grpcstatus.Code(err))
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.ChainHeads, 1)
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
+
+func TestPeriodFormatsNormalizeAndOrderPeriods(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "globex"
+	dailyType := "globex.daily.v1"
+	batchType := "globex.batch.v1"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	_, err = msgServer.SetPeriodFormat(f.ctx, &types.MsgSetPeriodFormat{Creator: randomAddress(), Tenant: tenant, Type: dailyType, Format: types.PeriodFormat_PERIOD_FORMAT_ISO_DATE})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	_, err = msgServer.SetPeriodFormat(f.ctx, &types.MsgSetPeriodFormat{Creator: owner, Tenant: tenant, Type: dailyType, Format: types.PeriodFormat_PERIOD_FORMAT_ISO_DATE})
+	require.NoError(t, err)
+	_, err = msgServer.SetPeriodFormat(f.ctx, &types.MsgSetPeriodFormat{Creator: owner, Tenant: tenant, Type: batchType, Format: types.PeriodFormat_PERIOD_FORMAT_SEQUENCE})
+	require.NoError(t, err)
+
+	formatResp, err := queryServer.PeriodFormat(f.ctx, &types.QueryPeriodFormatRequest{Tenant: tenant, Type: dailyType})
+	require.NoError(t, err)
+	require.Equal(t, types.PeriodFormat_PERIOD_FORMAT_ISO_DATE, formatResp.PeriodFormat.Format)
+	require.Equal(t, owner, formatResp.PeriodFormat.DeclaredBy)
+	_, err = queryServer.PeriodFormat(f.ctx, &types.QueryPeriodFormatRequest{Tenant: tenant, Type: "globex.other.v1"})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+
+	commit := func(integrityType, period string) error {
+		mockSet, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+		require.NoError(t, err)
+		_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+			Creator: owner,
+			Tenant:  tenant,
+			Type:    integrityType,
+			Period:  period,
+			Root:    mockSet.Root,
+			Records: mockSet.Records,
+		})
+		return err
+	}
+
+	// Spellings of one date name one set.
+	require.NoError(t, commit(dailyType, "2026-6-25"))
+	require.ErrorIs(t, commit(dailyType, "2026-06-25"), types.ErrIntegritySetAlreadyExists)
+	require.ErrorIs(t, commit(dailyType, "2026/06/25"), types.ErrInvalidPeriod)
+	setResp, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: dailyType, Period: "2026-6-25", ExcludeRecords: true})
+	require.NoError(t, err)
+	require.Equal(t, "2026-06-25", setResp.Set.Period)
+
+	// The format is locked once the type has sets.
+	_, err = msgServer.SetPeriodFormat(f.ctx, &types.MsgSetPeriodFormat{Creator: owner, Tenant: tenant, Type: dailyType, Format: types.PeriodFormat_PERIOD_FORMAT_MONTH})
+	require.ErrorIs(t, err, types.ErrPeriodFormatLocked)
+	_, err = msgServer.SetPeriodFormat(f.ctx, &types.MsgSetPeriodFormat{Creator: owner, Tenant: tenant, Type: dailyType, Format: types.PeriodFormat_PERIOD_FORMAT_ISO_DATE})
+	require.NoError(t, err)
+
+	for _, period := range []string{"2026-07-01", "2026-06-24", "2026-06-30"} {
+		require.NoError(t, commit(dailyType, period))
+	}
+	rangeResp, err := queryServer.IntegritySetsByPeriodRange(f.ctx, &types.QueryIntegritySetsByPeriodRangeRequest{Tenant: tenant, Type: dailyType, From: "2026-6-25", To: "2026-06-30"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2026-06-25", "2026-06-30"}, setPeriods(rangeResp.Sets))
+	require.Nil(t, rangeResp.Pagination.NextKey)
+
+	firstPage, err := queryServer.IntegritySetsByPeriodRange(f.ctx, &types.QueryIntegritySetsByPeriodRangeRequest{Tenant: tenant, Type: dailyType, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2026-06-24", "2026-06-25"}, setPeriods(firstPage.Sets))
+	secondPage, err := queryServer.IntegritySetsByPeriodRange(f.ctx, &types.QueryIntegritySetsByPeriodRangeRequest{Tenant: tenant, Type: dailyType, Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"2026-06-30", "2026-07-01"}, setPeriods(secondPage.Sets))
+	require.Nil(t, secondPage.Pagination.NextKey)
+
+	// Sequence numbers are ordered numerically, not as strings.
+	for _, period := range []string{"100", "010", "9"} {
+		require.NoError(t, commit(batchType, period))
+	}
+	rangeResp, err = queryServer.IntegritySetsByPeriodRange(f.ctx, &types.QueryIntegritySetsByPeriodRangeRequest{Tenant: tenant, Type: batchType, From: "9"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"9", "10", "100"}, setPeriods(rangeResp.Sets))
+
+	_, err = queryServer.IntegritySetsByPeriodRange(f.ctx, &types.QueryIntegritySetsByPeriodRangeRequest{Tenant: tenant, Type: dailyType, From: "2026-07-01", To: "2026-06-01"})
+	require.Equal(t, codes.InvalidArgument, grpcstatus.Code(err))
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.PeriodFormats, 2)
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+	rangeResp, err = keeper.NewQueryServerImpl(g.keeper).IntegritySetsByPeriodRange(g.ctx, &types.QueryIntegritySetsByPeriodRangeRequest{Tenant: tenant, Type: batchType})
+	require.NoError(t, err)
+	require.Equal(t, []string{"9", "10", "100"}, setPeriods(rangeResp.Sets))
+}
+
+func setPeriods(sets []types.IntegritySet) []string {
+	periods := make([]string, len(sets))
+	for i, set := range sets {
+		periods[i] = set.Period
+	}
+	return periods
+}
+
+func TestIntegrityTypeRegistry(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "hooli"
+	integrityType := "hooli.ledger.v1"
+	schemaHash := "0x2222222222222222222222222222222222222222222222222222222222222222"
+	v2 := types.HashScheme_HASH_SCHEME_V2
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	register := &types.MsgRegisterIntegrityType{
+		Creator:      randomAddress(),
+		Tenant:       tenant,
+		Type:         integrityType,
+		Description:  "Daily ledger balances",
+		SchemaHash:   schemaHash,
+		PeriodFormat: types.PeriodFormat_PERIOD_FORMAT_ISO_DATE,
+		HashScheme:   v2,
+	}
+	_, err = msgServer.RegisterIntegrityType(f.ctx, register)
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	register.Creator = owner
+	_, err = msgServer.RegisterIntegrityType(f.ctx, register)
+	require.NoError(t, err)
+
+	typeResp, err := queryServer.IntegrityType(f.ctx, &types.QueryIntegrityTypeRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.Equal(t, schemaHash, typeResp.IntegrityType.SchemaHash)
+	require.Equal(t, owner, typeResp.IntegrityType.RegisteredBy)
+	formatResp, err := queryServer.PeriodFormat(f.ctx, &types.QueryPeriodFormatRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.Equal(t, types.PeriodFormat_PERIOD_FORMAT_ISO_DATE, formatResp.PeriodFormat.Format)
+
+	mockSet, err := integritymock.BuildMockSet(3, tenant, integrityType, "2026-06-25")
+	require.NoError(t, err)
+	commitMsg := &types.MsgCommitIntegritySet{
+		Creator: owner,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  "2026-06-25",
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	}
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.ErrorIs(t, err, types.ErrHashSchemeMismatch)
+	commitMsg.Root = v2.CalculateMerkleRoot(mockSet.SortedRecords)
+	commitMsg.HashScheme = v2
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.NoError(t, err)
+
+	// Once registration is required, unregistered types accept no new sets.
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.RequireRegisteredTypes = true
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	otherSet, err := integritymock.BuildMockSet(2, tenant, "hooli.other.v1", "2026-06-25")
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+		Creator: owner,
+		Tenant:  tenant,
+		Type:    "hooli.other.v1",
+		Period:  "2026-06-25",
+		Root:    otherSet.Root,
+		Records: otherSet.Records,
+	})
+	require.ErrorIs(t, err, types.ErrIntegrityTypeNotFound)
+
+	// A deprecated type rejects new sets but its existing sets can still be corrected.
+	register.Deprecated = true
+	_, err = msgServer.RegisterIntegrityType(f.ctx, register)
+	require.NoError(t, err)
+	commitMsg.Period = "2026-06-26"
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.ErrorIs(t, err, types.ErrIntegrityTypeDeprecated)
+	corrected, err := integritymock.BuildMockSet(4, tenant, integrityType, "2026-06-25")
+	require.NoError(t, err)
+	_, err = msgServer.SupersedeIntegritySet(f.ctx, &types.MsgSupersedeIntegritySet{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     "2026-06-25",
+		Root:       v2.CalculateMerkleRoot(corrected.SortedRecords),
+		Records:    corrected.Records,
+		ReasonHash: schemaHash,
+		HashScheme: v2,
+	})
+	require.NoError(t, err)
+
+	// The schema, hash scheme and period format are locked by the existing sets.
+	register.SchemaHash = "0x3333333333333333333333333333333333333333333333333333333333333333"
+	_, err = msgServer.RegisterIntegrityType(f.ctx, register)
+	require.ErrorIs(t, err, types.ErrIntegrityTypeLocked)
+	register.SchemaHash = schemaHash
+	register.HashScheme = types.HashScheme_HASH_SCHEME_V1
+	_, err = msgServer.RegisterIntegrityType(f.ctx, register)
+	require.ErrorIs(t, err, types.ErrIntegrityTypeLocked)
+	register.HashScheme = v2
+	register.Description = "Daily ledger balances, retired"
+	_, err = msgServer.RegisterIntegrityType(f.ctx, register)
+	require.NoError(t, err)
+	typeResp, err = queryServer.IntegrityType(f.ctx, &types.QueryIntegrityTypeRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.Equal(t, schemaHash, typeResp.IntegrityType.SchemaHash)
+	require.Equal(t, "Daily ledger balances, retired", typeResp.IntegrityType.Description)
+	register.PeriodFormat = types.PeriodFormat_PERIOD_FORMAT_MONTH
+	_, err = msgServer.RegisterIntegrityType(f.ctx, register)
+	require.ErrorIs(t, err, types.ErrPeriodFormatLocked)
+
+	register.Type = "hooli.archive.v1"
+	_, err = msgServer.RegisterIntegrityType(f.ctx, register)
+	require.NoError(t, err)
+	typesResp, err := queryServer.IntegrityTypes(f.ctx, &types.QueryIntegrityTypesRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Len(t, typesResp.IntegrityTypes, 2)
+	require.Equal(t, "hooli.archive.v1", typesResp.IntegrityTypes[0].Type)
+	require.Equal(t, types.PeriodFormat_PERIOD_FORMAT_MONTH, typesResp.IntegrityTypes[0].PeriodFormat)
+	_, err = queryServer.IntegrityType(f.ctx, &types.QueryIntegrityTypeRequest{Tenant: tenant, Type: "hooli.other.v1"})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.IntegrityTypes, 2)
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
+
+func TestRevokeIntegrityRecordsKeepsSetImmutable(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "vandelay"
+	integrityType := "vandelay.invoice.v1"
+	period := "2026-06-25"
+	reasonHash := "0x1111111111111111111111111111111111111111111111111111111111111111"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+	mockSet, err := integritymock.BuildMockSet(3, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+		Creator: owner,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.NoError(t, err)
+
+	revoke := &types.MsgRevokeIntegrityRecords{
+		Creator:    randomAddress(),
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Tags:       []string{mockSet.SortedTags[2], mockSet.SortedTags[0]},
+		ReasonCode: 3,
+	}
+	_, err = msgServer.RevokeIntegrityRecords(f.ctx, revoke)
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	revoke.Creator = owner
+	revoke.ReasonCode = 0
+	_, err = msgServer.RevokeIntegrityRecords(f.ctx, revoke)
+	require.ErrorIs(t, err, types.ErrInvalidRevocation)
+	revoke.ReasonCode = 3
+	revoke.Tags = []string{mockSet.SortedTags[0], "0x" + strings.Repeat("ff", 32)}
+	_, err = msgServer.RevokeIntegrityRecords(f.ctx, revoke)
+	require.ErrorIs(t, err, types.ErrIntegrityRecordNotFound)
+	revoke.Tags = []string{mockSet.SortedTags[2], mockSet.SortedTags[0]}
+	_, err = msgServer.RevokeIntegrityRecords(f.ctx, revoke)
+	require.NoError(t, err)
+	_, err = msgServer.RevokeIntegrityRecords(f.ctx, revoke)
+	require.ErrorIs(t, err, types.ErrRecordAlreadyRevoked)
+
+	var revoked []sdk.Event
+	for _, event := range sdk.UnwrapSDKContext(f.ctx).EventManager().Events() {
+		if event.Type == types.EventTypeIntegrityRecordsRevoked {
+			revoked = append(revoked, event)
+		}
+	}
+	require.Len(t, revoked, 1)
+	tags, found := revoked[0].GetAttribute(types.AttributeKeyTags)
+	require.True(t, found)
+	require.Equal(t, mockSet.SortedTags[0]+","+mockSet.SortedTags[2], tags.Value)
+
+	// The set and its root are untouched; the queries carry the revocation status.
+	setResp, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period, ExcludeRecords: true})
+	require.NoError(t, err)
+	require.Equal(t, mockSet.Root, setResp.Set.Root)
+	require.Equal(t, uint64(1), setResp.Set.Version)
+	require.Len(t, setResp.Revocations, 2)
+	require.Equal(t, mockSet.SortedTags[0], setResp.Revocations[0].Tag)
+	require.Equal(t, uint32(3), setResp.Revocations[0].ReasonCode)
+	require.Equal(t, owner, setResp.Revocations[0].RevokedBy)
+
+	recordResp, err := queryServer.IntegrityRecord(f.ctx, &types.QueryIntegrityRecordRequest{Tenant: tenant, Type: integrityType, Period: period, Tag: mockSet.SortedTags[2]})
+	require.NoError(t, err)
+	require.True(t, recordResp.Revoked)
+	require.Equal(t, mockSet.SortedTags[2], recordResp.Revocation.Tag)
+	recordResp, err = queryServer.IntegrityRecord(f.ctx, &types.QueryIntegrityRecordRequest{Tenant: tenant, Type: integrityType, Period: period, Tag: mockSet.SortedTags[1]})
+	require.NoError(t, err)
+	require.False(t, recordResp.Revoked)
+
+	// A correction that drops a revoked record only reports revocations of the records it still holds.
+	corrected := types.IntegrityRecord{}
+	for _, record := range mockSet.Records {
+		if record.Tag == mockSet.SortedTags[0] {
+			corrected = record
+		}
+	}
+	correctedRecords := []types.IntegrityRecord{corrected}
+	correctedRoot, _, err := types.CalculateMerkleRoot(correctedRecords)
+	require.NoError(t, err)
+	_, err = msgServer.SupersedeIntegritySet(f.ctx, &types.MsgSupersedeIntegritySet{
+		Creator:    owner,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Root:       correctedRoot,
+		Records:    correctedRecords,
+		ReasonHash: reasonHash,
+	})
+	require.NoError(t, err)
+	setResp, err = queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	require.Len(t, setResp.Revocations, 1)
+	setResp, err = queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period, Version: 1})
+	require.NoError(t, err)
+	require.Len(t, setResp.Revocations, 2)
+	setResp, err = queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period, ExcludeRecords: true})
+	require.NoError(t, err)
+	require.False(t, setResp.RecordsIncluded)
+	require.Empty(t, setResp.Records)
+	require.Len(t, setResp.Revocations, 1)
+	require.Equal(t, mockSet.SortedTags[0], setResp.Revocations[0].Tag)
+	setResp, err = queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period, Version: 1, ExcludeRecords: true})
+	require.NoError(t, err)
+	require.Len(t, setResp.Revocations, 2)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.RecordRevocations, 2)
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
+
+func TestTenantFreezeAndArchiveLifecycle(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	writer := randomAddress()
+	tenant := "soylent"
+	integrityType := "soylent.batch.v1"
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	_, err = msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+	_, err = msgServer.GrantTenantWriter(f.ctx, &types.MsgGrantTenantWriter{Creator: owner, Tenant: tenant, Writer: writer})
+	require.NoError(t, err)
+	commitSetOrFail(t, f.ctx, msgServer, owner, tenant, integrityType, "2026-06-24")
+
+	commit := func(creator, period string) error {
+		mockSet, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+		require.NoError(t, err)
+		_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+			Creator: creator,
+			Tenant:  tenant,
+			Type:    integrityType,
+			Period:  period,
+			Root:    mockSet.Root,
+			Records: mockSet.Records,
+		})
+		return err
+	}
+
+	_, err = msgServer.FreezeTenant(f.ctx, &types.MsgFreezeTenant{Creator: writer, Tenant: tenant})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	_, err = msgServer.FreezeTenant(f.ctx, &types.MsgFreezeTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+	_, err = msgServer.FreezeTenant(f.ctx, &types.MsgFreezeTenant{Creator: owner, Tenant: tenant})
+	require.ErrorIs(t, err, types.ErrInvalidTenantStatus)
+	require.ErrorIs(t, commit(owner, "2026-06-25"), types.ErrTenantFrozen)
+	require.ErrorIs(t, commit(writer, "2026-06-25"), types.ErrTenantFrozen)
+	tenantResp, err := queryServer.Tenant(f.ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Equal(t, types.TenantStatus_TENANT_STATUS_FROZEN, tenantResp.Tenant.Status)
+	require.Equal(t, owner, tenantResp.Tenant.StatusChangedBy)
+
+	_, err = msgServer.UnfreezeTenant(f.ctx, &types.MsgUnfreezeTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+	require.NoError(t, commit(writer, "2026-06-25"))
+
+	// The owner cannot lift a freeze imposed by the module authority.
+	_, err = msgServer.FreezeTenant(f.ctx, &types.MsgFreezeTenant{Creator: authority, Tenant: tenant})
+	require.NoError(t, err)
+	_, err = msgServer.UnfreezeTenant(f.ctx, &types.MsgUnfreezeTenant{Creator: owner, Tenant: tenant})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	_, err = msgServer.UnfreezeTenant(f.ctx, &types.MsgUnfreezeTenant{Creator: authority, Tenant: tenant})
+	require.NoError(t, err)
+
+	newOwner := randomAddress()
+	_, err = msgServer.TransferTenantOwnership(f.ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner})
+	require.NoError(t, err)
+	_, err = msgServer.ArchiveTenant(f.ctx, &types.MsgArchiveTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	// Archived tenants keep their sets readable but accept no writes, updates or transfers.
+	tenantResp, err = queryServer.Tenant(f.ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Equal(t, types.TenantStatus_TENANT_STATUS_ARCHIVED, tenantResp.Tenant.Status)
+	require.Empty(t, tenantResp.Tenant.PendingOwner)
+	writersResp, err := queryServer.TenantWriters(f.ctx, &types.QueryTenantWritersRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Empty(t, writersResp.Writers)
+	setsResp, err := queryServer.IntegritySetsByTenant(f.ctx, &types.QueryIntegritySetsByTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Len(t, setsResp.Sets, 2)
+
+	require.ErrorIs(t, commit(owner, "2026-06-26"), types.ErrTenantArchived)
+	_, err = msgServer.AcceptTenantOwnership(f.ctx, &types.MsgAcceptTenantOwnership{Creator: newOwner, Tenant: tenant})
+	require.ErrorIs(t, err, types.ErrTenantArchived)
+	_, err = msgServer.TransferTenantOwnership(f.ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner})
+	require.ErrorIs(t, err, types.ErrTenantArchived)
+	_, err = msgServer.UnfreezeTenant(f.ctx, &types.MsgUnfreezeTenant{Creator: authority, Tenant: tenant})
+	require.ErrorIs(t, err, types.ErrTenantArchived)
+	_, err = msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: newOwner, Tenant: tenant})
+	require.ErrorIs(t, err, types.ErrTenantAlreadyExists)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
+
+func TestTenantOwnershipTransferExpiry(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	newOwner := randomAddress()
+	otherOwner := randomAddress()
+	tenant := "initech"
+	now := time.Date(2026, 6, 25, 12, 0, 0, 0, time.UTC)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(now).WithBlockHeight(10)
+
+	_, err := msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner, ExpiresAt: "tomorrow"})
+	require.ErrorIs(t, err, types.ErrInvalidTransferExpiry)
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner, ExpiresAt: now.Format(time.RFC3339)})
+	require.ErrorIs(t, err, types.ErrInvalidTransferExpiry)
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner, ExpiresHeight: 10})
+	require.ErrorIs(t, err, types.ErrInvalidTransferExpiry)
+
+	// A new offer replaces the expiry of the previous one.
+	expiresAt := now.Add(time.Hour)
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner, ExpiresAt: expiresAt.In(time.FixedZone("CEST", 2*60*60)).Format(time.RFC3339)})
+	require.NoError(t, err)
+	tenantResp, err := queryServer.Tenant(ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Equal(t, expiresAt.Format(time.RFC3339Nano), tenantResp.Tenant.PendingOwnerExpiresAt)
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: otherOwner, ExpiresHeight: 12})
+	require.NoError(t, err)
+	hasTimeEntry, err := f.keeper.TransferExpiryTimeQueue.Has(ctx, collections.Join(expiresAt, tenant))
+	require.NoError(t, err)
+	require.False(t, hasTimeEntry)
+
+	require.NoError(t, f.keeper.ExpireTenantTransfers(ctx.WithBlockHeight(11)))
+	tenantResp, err = queryServer.Tenant(ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Equal(t, otherOwner, tenantResp.Tenant.PendingOwner)
+
+	// The lapsed offer cannot be accepted, and the end of the block withdraws it.
+	expiredCtx := ctx.WithBlockHeight(12).WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.AcceptTenantOwnership(expiredCtx, &types.MsgAcceptTenantOwnership{Creator: otherOwner, Tenant: tenant})
+	require.ErrorIs(t, err, types.ErrTenantTransferExpired)
+	require.NoError(t, f.keeper.ExpireTenantTransfers(expiredCtx))
+	tenantResp, err = queryServer.Tenant(ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Empty(t, tenantResp.Tenant.PendingOwner)
+	require.Zero(t, tenantResp.Tenant.PendingOwnerExpiresHeight)
+	require.Equal(t, owner, tenantResp.Tenant.Owner)
+	var expired []sdk.Event
+	for _, event := range expiredCtx.EventManager().Events() {
+		if event.Type == types.EventTypeTenantOwnershipTransferExpired {
+			expired = append(expired, event)
+		}
+	}
+	require.Len(t, expired, 1)
+	pendingOwner, found := expired[0].GetAttribute(types.AttributeKeyPendingOwner)
+	require.True(t, found)
+	require.Equal(t, otherOwner, pendingOwner.Value)
+	_, err = msgServer.AcceptTenantOwnership(expiredCtx, &types.MsgAcceptTenantOwnership{Creator: otherOwner, Tenant: tenant})
+	require.ErrorIs(t, err, types.ErrTenantTransferNotPending)
+
+	// Offers expiring by block time are withdrawn once the block time reaches the expiry.
+	ctx = ctx.WithBlockHeight(12)
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner, ExpiresAt: expiresAt.Format(time.RFC3339)})
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.ExpireTenantTransfers(ctx.WithBlockTime(expiresAt.Add(-time.Second))))
+	tenantResp, err = queryServer.Tenant(ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Equal(t, newOwner, tenantResp.Tenant.PendingOwner)
+	require.NoError(t, f.keeper.ExpireTenantTransfers(ctx.WithBlockTime(expiresAt)))
+	tenantResp, err = queryServer.Tenant(ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Empty(t, tenantResp.Tenant.PendingOwner)
+	require.Empty(t, tenantResp.Tenant.PendingOwnerExpiresAt)
+
+	// An offer accepted in time leaves nothing behind in the expiry queues.
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: tenant, NewOwner: newOwner, ExpiresHeight: 20})
+	require.NoError(t, err)
+	_, err = msgServer.AcceptTenantOwnership(ctx.WithBlockHeight(19), &types.MsgAcceptTenantOwnership{Creator: newOwner, Tenant: tenant})
+	require.NoError(t, err)
+	hasHeightEntry, err := f.keeper.TransferExpiryHeightQueue.Has(ctx, collections.Join(uint64(20), tenant))
+	require.NoError(t, err)
+	require.False(t, hasHeightEntry)
+
+	// Genesis rebuilds the expiry queues from the pending offers.
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: newOwner, Tenant: tenant, NewOwner: owner, ExpiresHeight: 30})
+	require.NoError(t, err)
+	exported, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	hasHeightEntry, err = g.keeper.TransferExpiryHeightQueue.Has(g.ctx, collections.Join(uint64(30), tenant))
+	require.NoError(t, err)
+	require.True(t, hasHeightEntry)
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+}
+
+func TestTenantSignersActThroughProposals(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	signerA := randomAddress()
+	signerB := randomAddress()
+	signerC := randomAddress()
+	outsider := randomAddress()
+	writer := randomAddress()
+	tenant := "umbrella"
+	integrityType := "umbrella.audit.v1"
+	period := "2026-06-25"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+	_, err = msgServer.GrantTenantWriter(f.ctx, &types.MsgGrantTenantWriter{Creator: owner, Tenant: tenant, Writer: writer})
+	require.NoError(t, err)
+
+	_, err = msgServer.SetTenantSigners(f.ctx, &types.MsgSetTenantSigners{Creator: outsider, Tenant: tenant, Signers: []string{signerA, signerB}, Threshold: 1})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	_, err = msgServer.SetTenantSigners(f.ctx, &types.MsgSetTenantSigners{Creator: owner, Tenant: tenant, Signers: []string{signerA, signerB}, Threshold: 3})
+	require.ErrorIs(t, err, types.ErrInvalidTenantSigners)
+	_, err = msgServer.SetTenantSigners(f.ctx, &types.MsgSetTenantSigners{Creator: owner, Tenant: tenant, Signers: []string{signerA, signerA}, Threshold: 1})
+	require.ErrorIs(t, err, types.ErrInvalidTenantSigners)
+	// The upper case form of an address is the same signer and cannot count twice towards the threshold.
+	_, err = msgServer.SetTenantSigners(f.ctx, &types.MsgSetTenantSigners{Creator: owner, Tenant: tenant, Signers: []string{signerA, strings.ToUpper(signerA)}, Threshold: 2})
+	require.ErrorIs(t, err, types.ErrInvalidTenantSigners)
+	_, err = msgServer.SetTenantSigners(f.ctx, &types.MsgSetTenantSigners{Creator: owner, Tenant: tenant, Signers: []string{strings.ToUpper(signerC), signerB, signerA}, Threshold: 2})
+	require.NoError(t, err)
+
+	// The tenant now belongs to its keyless policy address, so no single key can act as owner.
+	policyAddress, err := f.keeper.TenantPolicyAddress(tenant)
+	require.NoError(t, err)
+	tenantResp, err := queryServer.Tenant(f.ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Equal(t, policyAddress, tenantResp.Tenant.Owner)
+	require.Len(t, tenantResp.Tenant.Signers, 3)
+	require.Contains(t, tenantResp.Tenant.Signers, signerC)
+	require.Equal(t, uint32(2), tenantResp.Tenant.Threshold)
+	mockSet, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+	require.NoError(t, err)
+	commitMsg := &types.MsgCommitIntegritySet{Creator: owner, Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root, Records: mockSet.Records}
+	_, err = msgServer.CommitIntegritySet(f.ctx, commitMsg)
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{Creator: signerA, Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root, Records: mockSet.Records})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	// Writer grants of the previous owner are dropped with the handover.
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{Creator: writer, Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root, Records: mockSet.Records})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+	_, err = queryServer.TenantWriter(f.ctx, &types.QueryTenantWriterRequest{Tenant: tenant, Writer: writer})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+
+	commitAction := types.TenantAction{Action: &types.TenantAction_CommitIntegritySet{CommitIntegritySet: &types.MsgCommitIntegritySet{Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root, Records: mockSet.Records}}}
+	_, err = msgServer.ProposeTenantAction(f.ctx, &types.MsgProposeTenantAction{Creator: outsider, Tenant: tenant, Action: commitAction})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantSigner)
+	_, err = msgServer.ProposeTenantAction(f.ctx, &types.MsgProposeTenantAction{Creator: signerA, Tenant: tenant, Action: types.TenantAction{}})
+	require.ErrorIs(t, err, types.ErrInvalidTenantAction)
+	otherTenantAction := types.TenantAction{Action: &types.TenantAction_FreezeTenant{FreezeTenant: &types.MsgFreezeTenant{Tenant: "other"}}}
+	_, err = msgServer.ProposeTenantAction(f.ctx, &types.MsgProposeTenantAction{Creator: signerA, Tenant: tenant, Action: otherTenantAction})
+	require.ErrorIs(t, err, types.ErrInvalidTenantAction)
+
+	proposeResp, err := msgServer.ProposeTenantAction(f.ctx, &types.MsgProposeTenantAction{Creator: signerA, Tenant: tenant, Action: commitAction})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), proposeResp.ProposalId)
+	_, err = msgServer.ExecuteTenantAction(f.ctx, &types.MsgExecuteTenantAction{Creator: signerA, Tenant: tenant, ProposalId: 1})
+	require.ErrorIs(t, err, types.ErrTenantProposalThreshold)
+	_, err = msgServer.ApproveTenantAction(f.ctx, &types.MsgApproveTenantAction{Creator: signerA, Tenant: tenant, ProposalId: 1})
+	require.ErrorIs(t, err, types.ErrTenantProposalApproved)
+	_, err = msgServer.ApproveTenantAction(f.ctx, &types.MsgApproveTenantAction{Creator: outsider, Tenant: tenant, ProposalId: 1})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantSigner)
+	_, err = msgServer.ApproveTenantAction(f.ctx, &types.MsgApproveTenantAction{Creator: signerB, Tenant: tenant, ProposalId: 1})
+	require.NoError(t, err)
+	proposalResp, err := queryServer.TenantProposal(f.ctx, &types.QueryTenantProposalRequest{Tenant: tenant, ProposalId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []string{signerA, signerB}, proposalResp.Proposal.Approvals)
+
+	_, err = msgServer.ExecuteTenantAction(f.ctx, &types.MsgExecuteTenantAction{Creator: signerC, Tenant: tenant, ProposalId: 1})
+	require.NoError(t, err)
+	setResp, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{Tenant: tenant, Type: integrityType, Period: period})
+	require.NoError(t, err)
+	require.Equal(t, mockSet.Root, setResp.Set.Root)
+	require.Equal(t, policyAddress, setResp.Set.Creator)
+	_, err = queryServer.TenantProposal(f.ctx, &types.QueryTenantProposalRequest{Tenant: tenant, ProposalId: 1})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+	_, err = msgServer.ExecuteTenantAction(f.ctx, &types.MsgExecuteTenantAction{Creator: signerC, Tenant: tenant, ProposalId: 1})
+	require.ErrorIs(t, err, types.ErrTenantProposalNotFound)
+
+	// A failing action reverts its transaction, which leaves the proposal open.
+	proposeResp, err = msgServer.ProposeTenantAction(f.ctx, &types.MsgProposeTenantAction{Creator: signerB, Tenant: tenant, Action: commitAction})
+	require.NoError(t, err)
+	_, err = msgServer.ApproveTenantAction(f.ctx, &types.MsgApproveTenantAction{Creator: signerC, Tenant: tenant, ProposalId: proposeResp.ProposalId})
+	require.NoError(t, err)
+	cacheCtx, _ := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+	_, err = msgServer.ExecuteTenantAction(cacheCtx, &types.MsgExecuteTenantAction{Creator: signerC, Tenant: tenant, ProposalId: proposeResp.ProposalId})
+	require.ErrorIs(t, err, types.ErrIntegritySetAlreadyExists)
+	proposalsResp, err := queryServer.TenantProposals(f.ctx, &types.QueryTenantProposalsRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Len(t, proposalsResp.Proposals, 1)
+
+	// Ownership changes go through proposals too, and a single new owner replaces the signers.
+	transferAction := types.TenantAction{Action: &types.TenantAction_TransferTenantOwnership{TransferTenantOwnership: &types.MsgTransferTenantOwnership{Tenant: tenant, NewOwner: signerC}}}
+	proposeResp, err = msgServer.ProposeTenantAction(f.ctx, &types.MsgProposeTenantAction{Creator: signerC, Tenant: tenant, Action: transferAction})
+	require.NoError(t, err)
+	_, err = msgServer.ApproveTenantAction(f.ctx, &types.MsgApproveTenantAction{Creator: signerA, Tenant: tenant, ProposalId: proposeResp.ProposalId})
+	require.NoError(t, err)
+	_, err = msgServer.ExecuteTenantAction(f.ctx, &types.MsgExecuteTenantAction{Creator: signerA, Tenant: tenant, ProposalId: proposeResp.ProposalId})
+	require.NoError(t, err)
+	tenantResp, err = queryServer.Tenant(f.ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Equal(t, signerC, tenantResp.Tenant.PendingOwner)
+
+	exported, err := f.keeper.ExportGenesis(f.ctx)
+	require.NoError(t, err)
+	require.NoError(t, exported.Validate())
+	require.Len(t, exported.TenantProposals, 1)
+	require.Equal(t, uint64(3), exported.TenantProposalSeq)
+	g := initFixture(t)
+	require.NoError(t, g.keeper.InitGenesis(g.ctx, *exported))
+	reexported, err := g.keeper.ExportGenesis(g.ctx)
+	require.NoError(t, err)
+	require.Equal(t, exported, reexported)
+
+	_, err = msgServer.AcceptTenantOwnership(f.ctx, &types.MsgAcceptTenantOwnership{Creator: signerC, Tenant: tenant})
+	require.NoError(t, err)
+	tenantResp, err = queryServer.Tenant(f.ctx, &types.QueryTenantRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Equal(t, signerC, tenantResp.Tenant.Owner)
+	require.Empty(t, tenantResp.Tenant.Signers)
+	require.Zero(t, tenantResp.Tenant.Threshold)
+	proposalsResp, err = queryServer.TenantProposals(f.ctx, &types.QueryTenantProposalsRequest{Tenant: tenant})
+	require.NoError(t, err)
+	require.Empty(t, proposalsResp.Proposals)
+	_, err = msgServer.ProposeTenantAction(f.ctx, &types.MsgProposeTenantAction{Creator: signerC, Tenant: tenant, Action: transferAction})
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantSigner)
+}
+
+func TestTenantProposalCommitsChargeThePolicyAddress(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	owner := randomAddress()
+	signerA := randomAddress()
+	signerB := randomAddress()
+	tenant := "initech"
+	integrityType := "initech.tps.v1"
+	period := "2026-06-25"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+	_, err = msgServer.SetTenantSigners(f.ctx, &types.MsgSetTenantSigners{Creator: owner, Tenant: tenant, Signers: []string{signerA, signerB}, Threshold: 2})
+	require.NoError(t, err)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	params := types.DefaultParams()
+	params.BaseStorageFee = math.NewInt(1000)
+	params.StorageFeePerByte = math.NewInt(10)
+	_, err = msgServer.UpdateParams(f.ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, ciphertextBytes, err := types.PrepareIntegrityRecords(mockSet.Records)
+	require.NoError(t, err)
+	fee := params.StorageFee(ciphertextBytes)
+	commitAction := types.TenantAction{Action: &types.TenantAction_CommitIntegritySet{CommitIntegritySet: &types.MsgCommitIntegritySet{Tenant: tenant, Type: integrityType, Period: period, Root: mockSet.Root, Records: mockSet.Records}}}
+	proposeResp, err := msgServer.ProposeTenantAction(f.ctx, &types.MsgProposeTenantAction{Creator: signerA, Tenant: tenant, Action: commitAction})
+	require.NoError(t, err)
+	_, err = msgServer.ApproveTenantAction(f.ctx, &types.MsgApproveTenantAction{Creator: signerB, Tenant: tenant, ProposalId: proposeResp.ProposalId})
+	require.NoError(t, err)
+	execute := &types.MsgExecuteTenantAction{Creator: signerB, Tenant: tenant, ProposalId: proposeResp.ProposalId}
+
+	// The policy address pays, not the executing signer, so funds of the signer do not help.
+	signerAddr := sdk.MustAccAddressFromBech32(signerB)
+	f.bankKeeper.fund(signerAddr, fee)
+	cacheCtx, _ := sdk.UnwrapSDKContext(f.ctx).CacheContext()
+	_, err = msgServer.ExecuteTenantAction(cacheCtx, execute)
+	require.ErrorIs(t, err, types.ErrStorageFeePayment)
+
+	// Anyone can fund the policy address with a plain bank transfer to the tenant owner.
+	policyAddr := sdk.AccAddress(types.TenantPolicyAddress(tenant))
+	f.bankKeeper.fund(policyAddr, fee)
+	_, err = msgServer.ExecuteTenantAction(f.ctx, execute)
+	require.NoError(t, err)
+	require.Equal(t, fee, f.distrKeeper.communityPool)
+	require.True(t, f.bankKeeper.balances[policyAddr.String()].IsZero())
+	require.Equal(t, fee, f.bankKeeper.balances[signerAddr.String()])
+	set, err := f.keeper.GetIntegritySet(f.ctx, tenant, integrityType, period)
+	require.NoError(t, err)
+	require.Equal(t, policyAddr.String(), set.Creator)
+}
+
+func TestIntegrityRecordProofQuery(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	creator := randomAddress()
+	tenant := "initech"
+	integrityType := "initech.dataset.v1"
+	period := "2026-06-25"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: creator, Tenant: tenant})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(3, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+		Creator: creator,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.NoError(t, err)
+
+	for i, tag := range mockSet.SortedTags {
+		proofResp, err := queryServer.IntegrityRecordProof(f.ctx, &types.QueryIntegrityRecordProofRequest{
+			Tenant: tenant,
+			Type:   integrityType,
+			Period: period,
+			Tag:    tag,
+		})
+		require.NoError(t, err)
+		require.Equal(t, mockSet.Root, proofResp.Set.Root)
+		require.Equal(t, mockSet.SortedRecords[i], proofResp.Record)
+
+		expectedProof, err := types.CalculateMerkleProofFromPreparedRecords(mockSet.SortedRecords, i)
+		require.NoError(t, err)
+		require.Equal(t, expectedProof, proofResp.Proof)
+	}
+
+	_, err = queryServer.IntegrityRecordProof(f.ctx, &types.QueryIntegrityRecordProofRequest{
+		Tenant: tenant,
+		Type:   integrityType,
+		Period: period,
+		Tag:    "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+	})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+}
+
+func TestIntegritySetListingQueries(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+
+	for _, tenant := range []string{"acme", "acme-labs"} {
+		_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+		require.NoError(t, err)
+	}
+	commitSetOrFail(t, f.ctx, msgServer, owner, "acme", "acme.alpha.v1", "2026-06-25")
+	commitSetOrFail(t, f.ctx, msgServer, owner, "acme", "acme.alpha.v1", "2026-06-26")
+	commitSetOrFail(t, f.ctx, msgServer, owner, "acme", "acme.beta.v1", "2026-06-25")
+	commitSetOrFail(t, f.ctx, msgServer, owner, "acme-labs", "acme.alpha.v1", "2026-06-25")
+
+	firstPage, err := queryServer.IntegritySetsByTenant(f.ctx, &types.QueryIntegritySetsByTenantRequest{
+		Tenant:     "acme",
+		Pagination: &query.PageRequest{Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, firstPage.Sets, 2)
+	require.NotEmpty(t, firstPage.Pagination.NextKey)
+	require.Equal(t, "2026-06-25", firstPage.Sets[0].Period)
+	require.Equal(t, "2026-06-26", firstPage.Sets[1].Period)
+
+	secondPage, err := queryServer.IntegritySetsByTenant(f.ctx, &types.QueryIntegritySetsByTenantRequest{
+		Tenant:     "acme",
+		Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey, Limit: 2},
+	})
+	require.NoError(t, err)
+	require.Len(t, secondPage.Sets, 1)
+	require.Equal(t, "acme.beta.v1", secondPage.Sets[0].Type)
+	require.Empty(t, secondPage.Pagination.NextKey)
+
+	byType, err := queryServer.IntegritySetsByTenantAndType(f.ctx, &types.QueryIntegritySetsByTenantAndTypeRequest{
+		Tenant:     "acme",
+		Type:       "acme.alpha.v1",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, byType.Sets, 2)
+	require.Equal(t, uint64(2), byType.Pagination.Total)
+	for _, set := range byType.Sets {
+		require.Equal(t, "acme", set.Tenant)
+		require.Equal(t, "acme.alpha.v1", set.Type)
+	}
+
+	empty, err := queryServer.IntegritySetsByTenant(f.ctx, &types.QueryIntegritySetsByTenantRequest{Tenant: "missing"})
+	require.NoError(t, err)
+	require.Empty(t, empty.Sets)
+}
+
+func TestIntegrityRecordsPaginationAndHeaderOnlySet(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	owner := randomAddress()
+	tenant := "umbrella"
+	integrityType := "umbrella.dataset.v1"
+	period := "2026-06-25"
+
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: tenant})
+	require.NoError(t, err)
+	mockSet, err := integritymock.BuildMockSet(5, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(f.ctx, &types.MsgCommitIntegritySet{
+		Creator: owner,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.NoError(t, err)
+
+	headerResp, err := queryServer.IntegritySet(f.ctx, &types.QueryIntegritySetRequest{
+		Tenant:         tenant,
+		Type:           integrityType,
+		Period:         period,
+		ExcludeRecords: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, mockSet.Root, headerResp.Set.Root)
+	require.Equal(t, uint64(5), headerResp.Set.RecordCount)
+	require.Empty(t, headerResp.Records)
+	require.False(t, headerResp.RecordsIncluded)
+
+	tags := make([]string, 0, len(mockSet.SortedTags))
+	var nextKey []byte
+	for {
+		page, err := queryServer.IntegrityRecords(f.ctx, &types.QueryIntegrityRecordsRequest{
+			Tenant:     tenant,
+			Type:       integrityType,
+			Period:     period,
+			Pagination: &query.PageRequest{Key: nextKey, Limit: 2},
+		})
+		require.NoError(t, err)
+		require.LessOrEqual(t, len(page.Records), 2)
+		for _, record := range page.Records {
+			tags = append(tags, record.Tag)
+		}
+		nextKey = page.Pagination.NextKey
+		if len(nextKey) == 0 {
+			break
+		}
+	}
+	require.Equal(t, mockSet.SortedTags, tags)
+
+	_, err = queryServer.IntegrityRecords(f.ctx, &types.QueryIntegrityRecordsRequest{
+		Tenant: tenant,
+		Type:   integrityType,
+		Period: "2026-06-26",
+	})
+	require.Equal(t, codes.NotFound, grpcstatus.Code(err))
+}
+
+func TestTenantIndexQueries(t *testing.T) {
+	f := initFixture(t)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	ownerA := randomAddress()
+	ownerB := randomAddress()
+
+	for _, tenant := range []string{"alpha", "beta", "gamma"} {
+		_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: ownerA, Tenant: tenant})
+		require.NoError(t, err)
+	}
+	_, err := msgServer.RegisterTenant(f.ctx, &types.MsgRegisterTenant{Creator: ownerB, Tenant: "delta"})
+	require.NoError(t, err)
+	_, err = msgServer.TransferTenantOwnership(f.ctx, &types.MsgTransferTenantOwnership{Creator: ownerA, Tenant: "beta", NewOwner: ownerB})
+	require.NoError(t, err)
+
+	allTenants, err := queryServer.Tenants(f.ctx, &types.QueryTenantsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, allTenants.Tenants, 4)
+	require.Equal(t, uint64(4), allTenants.Pagination.Total)
+
+	ownedByA, err := queryServer.TenantsByOwner(f.ctx, &types.QueryTenantsByOwnerRequest{Owner: ownerA})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alpha", "beta", "gamma"}, tenantNames(ownedByA.Tenants))
+
+	firstPage, err := queryServer.TenantsByOwner(f.ctx, &types.QueryTenantsByOwnerRequest{Owner: ownerA, Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alpha", "beta"}, tenantNames(firstPage.Tenants))
+	secondPage, err := queryServer.TenantsByOwner(f.ctx, &types.QueryTenantsByOwnerRequest{Owner: ownerA, Pagination: &query.PageRequest{Key: firstPage.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []string{"gamma"}, tenantNames(secondPage.Tenants))
+
+	pendingForB, err := queryServer.PendingTenantTransfersTo(f.ctx, &types.QueryPendingTenantTransfersToRequest{PendingOwner: ownerB})
+	require.NoError(t, err)
+	require.Equal(t, []string{"beta"}, tenantNames(pendingForB.Tenants))
+
+	_, err = msgServer.AcceptTenantOwnership(f.ctx, &types.MsgAcceptTenantOwnership{Creator: ownerB, Tenant: "beta"})
+	require.NoError(t, err)
+
+	ownedByA, err = queryServer.TenantsByOwner(f.ctx, &types.QueryTenantsByOwnerRequest{Owner: ownerA})
+	require.NoError(t, err)
+	require.Equal(t, []string{"alpha", "gamma"}, tenantNames(ownedByA.Tenants))
+	ownedByB, err := queryServer.TenantsByOwner(f.ctx, &types.QueryTenantsByOwnerRequest{Owner: ownerB})
+	require.NoError(t, err)
+	require.Equal(t, []string{"beta", "delta"}, tenantNames(ownedByB.Tenants))
+	pendingForB, err = queryServer.PendingTenantTransfersTo(f.ctx, &types.QueryPendingTenantTransfersToRequest{PendingOwner: ownerB})
+	require.NoError(t, err)
+	require.Empty(t, pendingForB.Tenants)
+
+	_, err = queryServer.TenantsByOwner(f.ctx, &types.QueryTenantsByOwnerRequest{Owner: "invalid"})
+	require.Equal(t, codes.InvalidArgument, grpcstatus.Code(err))
+}
+
 func TestQueryIntegrityNotFound(t *testing.T) {
 	f := initFixture(t)
 	queryServer := keeper.NewQueryServerImpl(f.keeper)
@@ -406,3 +2426,11 @@ func commitSetOrFail(t *testing.T, ctx context.Context, msgServer types.MsgServe
 
 	return mockSet
 }
+
+func tenantNames(tenants []types.Tenant) []string {
+	names := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		names = append(names, tenant.Tenant)
+	}
+	return names
+}
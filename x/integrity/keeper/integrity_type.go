@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k Keeper) GetIntegrityType(ctx context.Context, tenant, integrityType string) (types.IntegrityType, error) {
+	integrityTypeRecord, err := k.IntegrityTypes.Get(ctx, collections.Join(tenant, integrityType))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.IntegrityType{}, types.ErrIntegrityTypeNotFound.Wrapf("type %s is not registered by tenant %s", integrityType, tenant)
+		}
+		return types.IntegrityType{}, err
+	}
+
+	return integrityTypeRecord, nil
+}
+
+// checkIntegrityType applies the registration of a type to a set written under hashScheme. New sets of
+// unregistered types are rejected when the params require registration, and new sets of deprecated types
+// are always rejected. Corrections of existing sets pass newSet false.
+func (k Keeper) checkIntegrityType(ctx context.Context, params types.Params, tenant, integrityType string, hashScheme types.HashScheme, newSet bool) error {
+	integrityTypeRecord, err := k.GetIntegrityType(ctx, tenant, integrityType)
+	if err != nil {
+		if errors.Is(err, types.ErrIntegrityTypeNotFound) && (!newSet || !params.RequireRegisteredTypes) {
+			return nil
+		}
+		return err
+	}
+	if newSet && integrityTypeRecord.Deprecated {
+		return types.ErrIntegrityTypeDeprecated.Wrapf("type %s of tenant %s accepts no new sets", integrityType, tenant)
+	}
+	if hashScheme != integrityTypeRecord.HashScheme {
+		return types.ErrHashSchemeMismatch.Wrapf("type %s requires %s, got %s", integrityType, integrityTypeRecord.HashScheme, hashScheme)
+	}
+
+	return nil
+}
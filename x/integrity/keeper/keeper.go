@@ -1,12 +1,17 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/Kudora-Labs/kudora/x/integrity/types"
 )
@@ -21,11 +26,37 @@ type Keeper struct {
 
 	Schema           collections.Schema
 	Params           collections.Item[types.Params]
-	Tenants          collections.Map[string, types.Tenant]
+	Tenants          *collections.IndexedMap[string, types.Tenant, TenantIndexes]
 	IntegritySets    collections.Map[collections.Triple[string, string, string], types.IntegritySet]
 	IntegrityRecords collections.Map[collections.Quad[string, string, string, string], types.IntegrityRecord]
+	TenantWriters    collections.Map[collections.Pair[string, string], types.TenantWriter]
+	// IntegritySetVersions archives the headers of superseded set versions.
+	IntegritySetVersions collections.Map[collections.Quad[string, string, string, uint64], types.IntegritySet]
+	// IntegritySetVersionRecords archives the records of superseded set versions under their tag, so that
+	// one archived record or header can be read without the rest of the version.
+	IntegritySetVersionRecords collections.Map[collections.Pair[collections.Quad[string, string, string, uint64], string], types.IntegrityRecord]
+	// ChainHeads tracks the most recently committed set of each tenant and type.
+	ChainHeads collections.Map[collections.Pair[string, string], types.ChainHead]
+	// PeriodFormats holds the period format declared for a tenant and type.
+	PeriodFormats collections.Map[collections.Pair[string, string], types.TypePeriodFormat]
+	// IntegritySetPeriodIndex maps the period sort key of every set to its period, so that sets of one
+	// tenant and type can be listed in period order.
+	IntegritySetPeriodIndex collections.Map[collections.Triple[string, string, string], string]
+	// IntegrityTypes holds the integrity types registered by each tenant.
+	IntegrityTypes collections.Map[collections.Pair[string, string], types.IntegrityType]
+	// RecordRevocations marks revoked records under the key of the record they revoke.
+	RecordRevocations collections.Map[collections.Quad[string, string, string, string], types.IntegrityRecordRevocation]
+	// TransferExpiryTimeQueue and TransferExpiryHeightQueue order pending ownership offers by their expiry
+	// so that EndBlock only visits the lapsed ones.
+	TransferExpiryTimeQueue   collections.KeySet[collections.Pair[time.Time, string]]
+	TransferExpiryHeightQueue collections.KeySet[collections.Pair[uint64, string]]
+	// TenantProposals holds the open proposals of tenants owned by signers.
+	TenantProposals collections.Map[collections.Pair[string, uint64], types.TenantProposal]
+	// TenantProposalSeq is the id of the last tenant proposal.
+	TenantProposalSeq collections.Sequence
 
-	bankKeeper types.BankKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
 }
 
 func NewKeeper(
@@ -34,6 +65,7 @@ func NewKeeper(
 	addressCodec address.Codec,
 	authority []byte,
 	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -47,13 +79,15 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
 		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Tenants: collections.NewMap(
+		Tenants: collections.NewIndexedMap(
 			sb,
 			types.TenantKeyPrefix,
 			"tenants",
 			collections.StringKey,
 			codec.CollValue[types.Tenant](cdc),
+			NewTenantIndexes(sb),
 		),
 		IntegritySets: collections.NewMap(
 			sb,
@@ -69,6 +103,82 @@ func NewKeeper(
 			collections.QuadKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey, collections.StringKey),
 			codec.CollValue[types.IntegrityRecord](cdc),
 		),
+		TenantWriters: collections.NewMap(
+			sb,
+			types.TenantWriterPrefix,
+			"tenant_writers",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.TenantWriter](cdc),
+		),
+		IntegritySetVersions: collections.NewMap(
+			sb,
+			types.IntegritySetVersionPrefix,
+			"integrity_set_versions",
+			integritySetVersionKeyCodec,
+			codec.CollValue[types.IntegritySet](cdc),
+		),
+		IntegritySetVersionRecords: collections.NewMap(
+			sb,
+			types.IntegritySetVersionRecordPrefix,
+			"integrity_set_version_records",
+			collections.PairKeyCodec(integritySetVersionKeyCodec, collections.StringKey),
+			codec.CollValue[types.IntegrityRecord](cdc),
+		),
+		ChainHeads: collections.NewMap(
+			sb,
+			types.ChainHeadPrefix,
+			"chain_heads",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.ChainHead](cdc),
+		),
+		PeriodFormats: collections.NewMap(
+			sb,
+			types.PeriodFormatPrefix,
+			"period_formats",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.TypePeriodFormat](cdc),
+		),
+		IntegritySetPeriodIndex: collections.NewMap(
+			sb,
+			types.IntegritySetPeriodIndexPrefix,
+			"integrity_set_period_index",
+			collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey),
+			collections.StringValue,
+		),
+		IntegrityTypes: collections.NewMap(
+			sb,
+			types.IntegrityTypePrefix,
+			"integrity_types",
+			collections.PairKeyCodec(collections.StringKey, collections.StringKey),
+			codec.CollValue[types.IntegrityType](cdc),
+		),
+		RecordRevocations: collections.NewMap(
+			sb,
+			types.RecordRevocationPrefix,
+			"record_revocations",
+			collections.QuadKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey, collections.StringKey),
+			codec.CollValue[types.IntegrityRecordRevocation](cdc),
+		),
+		TransferExpiryTimeQueue: collections.NewKeySet(
+			sb,
+			types.TransferExpiryTimePrefix,
+			"transfer_expiry_time_queue",
+			collections.PairKeyCodec(sdk.TimeKey, collections.StringKey),
+		),
+		TransferExpiryHeightQueue: collections.NewKeySet(
+			sb,
+			types.TransferExpiryHeightPrefix,
+			"transfer_expiry_height_queue",
+			collections.PairKeyCodec(collections.Uint64Key, collections.StringKey),
+		),
+		TenantProposals: collections.NewMap(
+			sb,
+			types.TenantProposalPrefix,
+			"tenant_proposals",
+			collections.PairKeyCodec(collections.StringKey, collections.Uint64Key),
+			codec.CollValue[types.TenantProposal](cdc),
+		),
+		TenantProposalSeq: collections.NewSequence(sb, types.TenantProposalSeqKey, "tenant_proposal_seq"),
 	}
 
 	schema, err := sb.Build()
@@ -80,6 +190,72 @@ func NewKeeper(
 	return k
 }
 
+// integritySetVersionKeyCodec encodes the tenant, type, period and version of an archived set version.
+var integritySetVersionKeyCodec = collections.QuadKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey, collections.Uint64Key)
+
+// TenantIndexes defines the secondary indexes of the Tenants map.
+type TenantIndexes struct {
+	// Owner indexes tenants by their current owner address.
+	Owner *indexes.Multi[string, string, types.Tenant]
+	// PendingOwner indexes tenants by their pending owner address.
+	PendingOwner *indexes.Multi[string, string, types.Tenant]
+}
+
+func (i TenantIndexes) IndexesList() []collections.Index[string, types.Tenant] {
+	pendingOwner := skipEmptyIndex{
+		Multi:  i.PendingOwner,
+		refKey: func(tenant types.Tenant) string { return tenant.PendingOwner },
+	}
+	return []collections.Index[string, types.Tenant]{i.Owner, pendingOwner}
+}
+
+// skipEmptyIndex maintains a Multi index only for tenants whose reference key is set, so that tenants without
+// a pending owner are not all indexed under the empty address.
+type skipEmptyIndex struct {
+	*indexes.Multi[string, string, types.Tenant]
+	refKey func(types.Tenant) string
+}
+
+func (i skipEmptyIndex) Reference(ctx context.Context, pk string, newValue types.Tenant, lazyOldValue func() (types.Tenant, error)) error {
+	if i.refKey(newValue) != "" {
+		return i.Multi.Reference(ctx, pk, newValue, lazyOldValue)
+	}
+
+	oldValue, err := lazyOldValue()
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return i.Unreference(ctx, pk, func() (types.Tenant, error) { return oldValue, nil })
+}
+
+func NewTenantIndexes(sb *collections.SchemaBuilder) TenantIndexes {
+	return TenantIndexes{
+		Owner: indexes.NewMulti(
+			sb,
+			types.TenantOwnerIndexPrefix,
+			"tenants_by_owner",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, tenant types.Tenant) (string, error) {
+				return tenant.Owner, nil
+			},
+		),
+		PendingOwner: indexes.NewMulti(
+			sb,
+			types.TenantPendingOwnerIndexPrefix,
+			"tenants_by_pending_owner",
+			collections.StringKey,
+			collections.StringKey,
+			func(_ string, tenant types.Tenant) (string, error) {
+				return tenant.PendingOwner, nil
+			},
+		),
+	}
+}
+
 // GetAuthority returns the module's authority.
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
@@ -5,11 +5,14 @@ import (
 	"testing"
 
 	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -21,7 +24,63 @@ import (
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
 	addressCodec address.Codec
+	bankKeeper   *mockBankKeeper
+	distrKeeper  *mockDistributionKeeper
+}
+
+// mockBankKeeper tracks account balances, module balances and burned coins in memory.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	modules  map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{
+		balances: make(map[string]sdk.Coins),
+		modules:  make(map[string]sdk.Coins),
+	}
+}
+
+func (m *mockBankKeeper) fund(addr sdk.AccAddress, amt sdk.Coins) {
+	m.balances[addr.String()] = m.balances[addr.String()].Add(amt...)
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	balance, negative := m.balances[senderAddr.String()].SafeSub(amt...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("%s is smaller than %s", m.balances[senderAddr.String()], amt)
+	}
+	m.balances[senderAddr.String()] = balance
+	m.modules[recipientModule] = m.modules[recipientModule].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amounts sdk.Coins) error {
+	balance, negative := m.modules[moduleName].SafeSub(amounts...)
+	if negative {
+		return sdkerrors.ErrInsufficientFunds.Wrapf("module %s cannot burn %s", moduleName, amounts)
+	}
+	m.modules[moduleName] = balance
+	m.burned = m.burned.Add(amounts...)
+	return nil
+}
+
+// mockDistributionKeeper funds an in-memory community pool through the mock bank keeper.
+type mockDistributionKeeper struct {
+	bankKeeper    *mockBankKeeper
+	communityPool sdk.Coins
+}
+
+func (m *mockDistributionKeeper) FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if err := m.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, "distribution", amount); err != nil {
+		return err
+	}
+	m.communityPool = m.communityPool.Add(amount...)
+	return nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,13 +94,16 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	distrKeeper := &mockDistributionKeeper{bankKeeper: bankKeeper}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
-		nil,
+		bankKeeper,
+		distrKeeper,
 	)
 
 	// Initialize params
@@ -52,6 +114,10 @@ func initFixture(t *testing.T) *fixture {
 	return &fixture{
 		ctx:          ctx,
 		keeper:       k,
+		storeService: storeService,
+		cdc:          encCfg.Codec,
 		addressCodec: addressCodec,
+		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
 	}
 }
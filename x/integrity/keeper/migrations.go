@@ -0,0 +1,127 @@
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// Migrator handles in-place store migrations of the integrity module.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 writes the default params. Version 1 stored no validation limits, storage fees or hash
+// algorithms, so every field of the params is new.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.Params.Set(ctx, types.DefaultParams())
+}
+
+// Migrate2to3 rewrites every tenant so that the owner and pending owner indexes cover tenants registered
+// before the indexes existed, and drops pending owner entries recorded under the empty address.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	iter, err := m.keeper.Tenants.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	tenants, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, tenant := range tenants {
+		if err := m.keeper.Tenants.Set(ctx, tenant.Tenant, tenant); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate3to4 numbers the sets stored before versioning as version 1 and records the hash scheme their roots
+// were built with, so that they pass genesis validation and version lookups.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	iter, err := m.keeper.IntegritySets.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	sets, err := iter.KeyValues()
+	if err != nil {
+		return err
+	}
+
+	for _, kv := range sets {
+		set := kv.Value
+		if set.Version != 0 {
+			continue
+		}
+		set.Version = 1
+		set.HashScheme = types.HashScheme_HASH_SCHEME_V1
+		if err := m.keeper.IntegritySets.Set(ctx, kv.Key, set); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate4to5 fills the period index for sets stored before it existed, so that period range queries cover
+// them.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	iter, err := m.keeper.IntegritySets.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	sets, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, set := range sets {
+		if err := m.keeper.indexIntegritySetPeriod(ctx, set); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Migrate5to6 splits every archived set version, stored as one bundle of header plus records, into a header
+// and one entry per record, so that a header or a single record can be read without the whole version.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	sb := collections.NewSchemaBuilder(m.keeper.storeService)
+	legacyVersions := collections.NewMap(
+		sb,
+		types.LegacyIntegritySetVersionPrefix,
+		"legacy_integrity_set_versions",
+		integritySetVersionKeyCodec,
+		codec.CollValue[types.IntegritySetBundle](m.keeper.cdc),
+	)
+	if _, err := sb.Build(); err != nil {
+		return err
+	}
+
+	iter, err := legacyVersions.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	bundles, err := iter.Values()
+	if err != nil {
+		return err
+	}
+
+	for _, bundle := range bundles {
+		if err := m.keeper.storeArchivedIntegritySet(ctx, bundle.Set, bundle.Records); err != nil {
+			return err
+		}
+	}
+
+	return legacyVersions.Clear(ctx, nil)
+}
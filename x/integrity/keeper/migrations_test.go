@@ -0,0 +1,215 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kudora-Labs/kudora/testutil/integritymock"
+	"github.com/Kudora-Labs/kudora/x/integrity/keeper"
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func TestMigrate1to2WritesDefaultParams(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	require.NoError(t, f.keeper.Params.Remove(ctx))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), params)
+}
+
+func TestMigrate2to3BackfillsTenantIndexes(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	owner := randomAddress()
+	pendingOwner := randomAddress()
+
+	_, err := msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: "acme"})
+	require.NoError(t, err)
+	_, err = msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: "globex"})
+	require.NoError(t, err)
+	_, err = msgServer.TransferTenantOwnership(ctx, &types.MsgTransferTenantOwnership{Creator: owner, Tenant: "globex", NewOwner: pendingOwner})
+	require.NoError(t, err)
+
+	pendingOwners := func() []collections.Pair[string, string] {
+		iter, err := f.keeper.Tenants.Indexes.PendingOwner.Iterate(ctx, nil)
+		require.NoError(t, err)
+		keys, err := iter.FullKeys()
+		require.NoError(t, err)
+		return keys
+	}
+	require.Equal(t, []collections.Pair[string, string]{collections.Join(pendingOwner, "globex")}, pendingOwners())
+
+	// Drop the index entries, as on a chain that registered tenants before the indexes existed.
+	for _, tenant := range []string{"acme", "globex"} {
+		tenantData, err := f.keeper.GetTenant(ctx, tenant)
+		require.NoError(t, err)
+		getTenant := func() (types.Tenant, error) { return tenantData, nil }
+		require.NoError(t, f.keeper.Tenants.Indexes.Owner.Unreference(ctx, tenant, getTenant))
+		require.NoError(t, f.keeper.Tenants.Indexes.PendingOwner.Unreference(ctx, tenant, getTenant))
+	}
+	require.Empty(t, pendingOwners())
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+
+	iter, err := f.keeper.Tenants.Indexes.Owner.MatchExact(ctx, owner)
+	require.NoError(t, err)
+	owned, err := iter.PrimaryKeys()
+	require.NoError(t, err)
+	require.Equal(t, []string{"acme", "globex"}, owned)
+	require.Equal(t, []collections.Pair[string, string]{collections.Join(pendingOwner, "globex")}, pendingOwners())
+}
+
+func TestMigrate3to4NumbersUnversionedSets(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	creator := randomAddress()
+	tenant, integrityType, period := "acme", "acme.daily.v1", "2026-06-25"
+
+	_, err := msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: creator, Tenant: tenant})
+	require.NoError(t, err)
+	mockSet, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(ctx, &types.MsgCommitIntegritySet{
+		Creator: creator,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.NoError(t, err)
+
+	// Store the set as it was before versioning.
+	key := collections.Join3(tenant, integrityType, period)
+	set, err := f.keeper.IntegritySets.Get(ctx, key)
+	require.NoError(t, err)
+	set.Version = 0
+	require.NoError(t, f.keeper.IntegritySets.Set(ctx, key, set))
+
+	otherRoot := "0x" + strings.Repeat("ab", 32)
+	_, _, _, err = f.keeper.VerifyChain(ctx, tenant, integrityType, period, otherRoot, 1)
+	require.ErrorIs(t, err, types.ErrIntegritySetNotFound)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(ctx))
+
+	set, err = f.keeper.IntegritySets.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), set.Version)
+	require.Equal(t, types.HashScheme_HASH_SCHEME_V1, set.HashScheme)
+
+	genState, err := f.keeper.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.NoError(t, genState.Validate())
+}
+
+func TestMigrate4to5IndexesSetPeriods(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	queryServer := keeper.NewQueryServerImpl(f.keeper)
+	creator := randomAddress()
+	tenant, integrityType, period := "acme", "acme.daily.v1", "2026-06-25"
+
+	_, err := msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: creator, Tenant: tenant})
+	require.NoError(t, err)
+	mockSet, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(ctx, &types.MsgCommitIntegritySet{
+		Creator: creator,
+		Tenant:  tenant,
+		Type:    integrityType,
+		Period:  period,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.NoError(t, err)
+
+	// Drop the index entry, as on a chain that stored the set before the index existed.
+	require.NoError(t, f.keeper.IntegritySetPeriodIndex.Clear(ctx, nil))
+	rangeResp, err := queryServer.IntegritySetsByPeriodRange(ctx, &types.QueryIntegritySetsByPeriodRangeRequest{Tenant: tenant, Type: integrityType})
+	require.NoError(t, err)
+	require.Empty(t, rangeResp.Sets)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate4to5(ctx))
+
+	rangeResp, err = queryServer.IntegritySetsByPeriodRange(ctx, &types.QueryIntegritySetsByPeriodRangeRequest{Tenant: tenant, Type: integrityType, From: period, To: period})
+	require.NoError(t, err)
+	require.Len(t, rangeResp.Sets, 1)
+	require.Equal(t, period, rangeResp.Sets[0].Period)
+	require.Equal(t, mockSet.Root, rangeResp.Sets[0].Root)
+}
+
+func TestMigrate5to6SplitsArchivedVersions(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	msgServer := keeper.NewMsgServerImpl(f.keeper)
+	creator := randomAddress()
+	tenant, integrityType, period := "acme", "acme.daily.v1", "2026-06-25"
+
+	_, err := msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: creator, Tenant: tenant})
+	require.NoError(t, err)
+	original, err := integritymock.BuildMockSet(3, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(ctx, &types.MsgCommitIntegritySet{Creator: creator, Tenant: tenant, Type: integrityType, Period: period, Root: original.Root, Records: original.Records})
+	require.NoError(t, err)
+	corrected, err := integritymock.BuildMockSet(2, tenant, integrityType, period)
+	require.NoError(t, err)
+	_, err = msgServer.SupersedeIntegritySet(ctx, &types.MsgSupersedeIntegritySet{
+		Creator:    creator,
+		Tenant:     tenant,
+		Type:       integrityType,
+		Period:     period,
+		Root:       corrected.Root,
+		Records:    corrected.Records,
+		ReasonHash: "0x" + strings.Repeat("ab", 32),
+	})
+	require.NoError(t, err)
+
+	// Store version 1 as one bundle, as it was before the archive was split.
+	sb := collections.NewSchemaBuilder(f.storeService)
+	legacyVersions := collections.NewMap(
+		sb,
+		types.LegacyIntegritySetVersionPrefix,
+		"legacy_integrity_set_versions",
+		collections.QuadKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey, collections.Uint64Key),
+		codec.CollValue[types.IntegritySetBundle](f.cdc),
+	)
+	_, err = sb.Build()
+	require.NoError(t, err)
+	versionKey := collections.Join4(tenant, integrityType, period, uint64(1))
+	archived, err := f.keeper.IntegritySetVersions.Get(ctx, versionKey)
+	require.NoError(t, err)
+	require.NoError(t, legacyVersions.Set(ctx, versionKey, types.IntegritySetBundle{Set: archived, Records: original.SortedRecords}))
+	require.NoError(t, f.keeper.IntegritySetVersions.Remove(ctx, versionKey))
+	require.NoError(t, f.keeper.IntegritySetVersionRecords.Clear(ctx, nil))
+	_, err = f.keeper.GetIntegritySetVersion(ctx, tenant, integrityType, period, 1)
+	require.ErrorIs(t, err, types.ErrIntegritySetNotFound)
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate5to6(ctx))
+
+	set, err := f.keeper.GetIntegritySetVersion(ctx, tenant, integrityType, period, 1)
+	require.NoError(t, err)
+	require.Equal(t, original.Root, set.Root)
+	record, err := f.keeper.GetIntegrityRecordForVersion(ctx, tenant, integrityType, period, 1, original.SortedTags[2])
+	require.NoError(t, err)
+	require.Equal(t, original.SortedRecords[2], record)
+	records, err := f.keeper.ListIntegrityRecordsForVersion(ctx, tenant, integrityType, period, 1)
+	require.NoError(t, err)
+	require.Equal(t, original.SortedRecords, records)
+
+	iter, err := legacyVersions.Iterate(ctx, nil)
+	require.NoError(t, err)
+	defer iter.Close()
+	require.False(t, iter.Valid())
+}
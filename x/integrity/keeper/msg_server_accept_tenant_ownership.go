@@ -29,14 +29,32 @@ func (k msgServer) AcceptTenantOwnership(ctx context.Context, msg *types.MsgAcce
 		return nil, types.ErrUnauthorizedPendingOwner.Wrapf("tenant %s pending owner is %s", tenant, tenantRecord.PendingOwner)
 	}
 
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// A lapsed offer stays visible until the end of the block but can no longer be accepted.
+	if tenantRecord.PendingOwnerExpired(sdkCtx.BlockTime(), uint64(sdkCtx.BlockHeight())) {
+		return nil, types.ErrTenantTransferExpired.Wrapf("ownership offer of tenant %s has expired", tenant)
+	}
+
 	previousOwner := tenantRecord.Owner
 	tenantRecord.Owner = creator
-	tenantRecord.PendingOwner = ""
+	if err := k.clearPendingOwner(ctx, &tenantRecord); err != nil {
+		return nil, err
+	}
+	// A single new owner replaces the signers of the tenant and their open proposals.
+	tenantRecord.Signers = nil
+	tenantRecord.Threshold = 0
+	if err := k.clearTenantProposals(ctx, tenant); err != nil {
+		return nil, err
+	}
 	if err := k.Tenants.Set(ctx, tenant, tenantRecord); err != nil {
 		return nil, err
 	}
+	// Writer grants were issued by the previous owner and do not carry over to the new one.
+	if err := k.clearTenantWriters(ctx, tenant); err != nil {
+		return nil, err
+	}
 
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTenantOwnershipTransferred,
 			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
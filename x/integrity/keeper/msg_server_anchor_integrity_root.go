@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) AnchorIntegrityRoot(ctx context.Context, msg *types.MsgAnchorIntegrityRoot) (*types.MsgAnchorIntegrityRootResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := params.NormalizeTenant(msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	integrityType, err := params.NormalizeIntegrityType(msg.Type)
+	if err != nil {
+		return nil, err
+	}
+	period, err := k.normalizePeriod(ctx, params, tenant, integrityType, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.ValidateHashAlgorithm(msg.HashAlgorithm); err != nil {
+		return nil, err
+	}
+	root, err := types.NormalizeRoot(msg.Root)
+	if err != nil {
+		return nil, err
+	}
+	storageURI, err := types.NormalizeStorageURI(msg.StorageUri)
+	if err != nil {
+		return nil, err
+	}
+	storageDigest, err := types.NormalizeStorageDigest(msg.StorageDigest)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantRecord, err := k.GetTenant(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeCommit(ctx, tenantRecord, creator, integrityType); err != nil {
+		return nil, err
+	}
+	if err := k.checkIntegrityType(ctx, params, tenant, integrityType, msg.HashScheme, true); err != nil {
+		return nil, err
+	}
+
+	setExists, err := k.HasIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		return nil, err
+	}
+	if setExists {
+		return nil, types.ErrIntegritySetAlreadyExists.Wrapf("set %s/%s/%s already exists", tenant, integrityType, period)
+	}
+	chainLink, err := k.nextChainLink(ctx, tenant, integrityType, "")
+	if err != nil {
+		return nil, err
+	}
+
+	// No ciphertext is stored, so only the base fee applies.
+	storageFee, err := k.chargeStorageFee(ctx, params, creator, params.StorageFee(0))
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	integritySet := types.IntegritySet{
+		Tenant:              tenant,
+		Type:                integrityType,
+		Period:              period,
+		Root:                root,
+		Creator:             creator,
+		BlockHeight:         uint64(sdkCtx.BlockHeight()),
+		BlockTime:           sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+		RecordCount:         msg.RecordCount,
+		Version:             1,
+		StorageMode:         types.StorageMode_STORAGE_MODE_ROOT_ONLY,
+		StorageUri:          storageURI,
+		StorageDigest:       storageDigest,
+		HashScheme:          msg.HashScheme,
+		HashAlgorithm:       msg.HashAlgorithm,
+		ChainPreviousPeriod: chainLink.PreviousPeriod,
+		ChainPreviousRoot:   chainLink.PreviousRoot,
+		ChainLength:         chainLink.Length,
+	}
+	if err := k.storeIntegritySet(ctx, integritySet, nil); err != nil {
+		return nil, err
+	}
+	if err := k.setChainHead(ctx, integritySet); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegrityRootAnchored,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integrityType),
+			sdk.NewAttribute(types.AttributeKeyPeriod, period),
+			sdk.NewAttribute(types.AttributeKeyRoot, root),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.FormatUint(msg.RecordCount, 10)),
+			sdk.NewAttribute(types.AttributeKeyStorageURI, storageURI),
+			sdk.NewAttribute(types.AttributeKeyStorageDigest, storageDigest),
+			sdk.NewAttribute(types.AttributeKeyStorageFee, storageFee.String()),
+			sdk.NewAttribute(types.AttributeKeyHashScheme, msg.HashScheme.String()),
+			sdk.NewAttribute(types.AttributeKeyHashAlgorithm, msg.HashAlgorithm.String()),
+			sdk.NewAttribute(types.AttributeKeyChainPreviousPeriod, integritySet.ChainPreviousPeriod),
+			sdk.NewAttribute(types.AttributeKeyChainPreviousRoot, integritySet.ChainPreviousRoot),
+			sdk.NewAttribute(types.AttributeKeyChainLength, strconv.FormatUint(integritySet.ChainLength, 10)),
+		),
+	)
+
+	return &types.MsgAnchorIntegrityRootResponse{}, nil
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) AppendIntegrityRecords(ctx context.Context, msg *types.MsgAppendIntegrityRecords) (*types.MsgAppendIntegrityRecordsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	integritySet, err := k.openSetForUpdate(ctx, params, creator, msg.Tenant, msg.Type, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkIntegrityType(ctx, params, integritySet.Tenant, integritySet.Type, integritySet.HashScheme, false); err != nil {
+		return nil, err
+	}
+
+	batch, ciphertextBytes, err := params.PrepareIntegrityRecords(msg.Records)
+	if err != nil {
+		return nil, err
+	}
+	existing, err := k.ListIntegrityRecords(ctx, integritySet.Tenant, integritySet.Type, integritySet.Period)
+	if err != nil {
+		return nil, err
+	}
+	// Preparing the whole set applies the set-wide limits and rejects tags that were already appended.
+	records, _, err := params.PrepareIntegrityRecords(append(existing, batch...))
+	if err != nil {
+		return nil, err
+	}
+
+	storageFee, err := k.chargeStorageFee(ctx, params, creator, params.StorageByteFee(ciphertextBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	integritySet.Root = integritySet.MerkleHasher().CalculateMerkleRoot(records)
+	integritySet.RecordCount = uint64(len(records))
+	if err := k.storeIntegritySet(ctx, integritySet, batch); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegrityRecordsAppended,
+			sdk.NewAttribute(types.AttributeKeyTenant, integritySet.Tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integritySet.Type),
+			sdk.NewAttribute(types.AttributeKeyPeriod, integritySet.Period),
+			sdk.NewAttribute(types.AttributeKeyRoot, integritySet.Root),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyAppendedCount, strconv.Itoa(len(batch))),
+			sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.Itoa(len(records))),
+			sdk.NewAttribute(types.AttributeKeyStorageFee, storageFee.String()),
+		),
+	)
+
+	return &types.MsgAppendIntegrityRecordsResponse{
+		Root:        integritySet.Root,
+		RecordCount: integritySet.RecordCount,
+	}, nil
+}
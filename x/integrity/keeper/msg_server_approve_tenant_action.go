@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) ApproveTenantAction(ctx context.Context, msg *types.MsgApproveTenantAction) (*types.MsgApproveTenantActionResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeTenantSigner(tenantRecord, creator); err != nil {
+		return nil, err
+	}
+	proposal, err := k.GetTenantProposal(ctx, tenant, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(proposal.Approvals, creator) {
+		return nil, types.ErrTenantProposalApproved.Wrapf("%s already approved proposal %d", creator, proposal.Id)
+	}
+
+	proposal.Approvals = append(proposal.Approvals, creator)
+	if err := k.TenantProposals.Set(ctx, collections.Join(tenant, proposal.Id), proposal); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenantActionApproved,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyApprovalCount, strconv.FormatUint(uint64(proposal.ApprovalCount(tenantRecord)), 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+		),
+	)
+
+	return &types.MsgApproveTenantActionResponse{}, nil
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) ArchiveTenant(ctx context.Context, msg *types.MsgArchiveTenant) (*types.MsgArchiveTenantResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	_, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeStatusChange(tenantRecord, creator); err != nil {
+		return nil, err
+	}
+
+	// An archived tenant can never be transferred or written again, so pending transfers, writer grants
+	// and open proposals go with it.
+	if err := k.clearPendingOwner(ctx, &tenantRecord); err != nil {
+		return nil, err
+	}
+	if err := k.clearTenantWriters(ctx, tenantRecord.Tenant); err != nil {
+		return nil, err
+	}
+	if err := k.clearTenantProposals(ctx, tenantRecord.Tenant); err != nil {
+		return nil, err
+	}
+	if err := k.setTenantStatus(ctx, tenantRecord, types.TenantStatus_TENANT_STATUS_ARCHIVED, creator, types.EventTypeTenantArchived); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgArchiveTenantResponse{}, nil
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) CancelIntegritySet(ctx context.Context, msg *types.MsgCancelIntegritySet) (*types.MsgCancelIntegritySetResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	integrityType, err := params.NormalizeIntegrityType(msg.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := tenantRecord.CheckWritable(); err != nil {
+		return nil, err
+	}
+	if tenantRecord.Owner != creator {
+		return nil, types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenant, tenantRecord.Owner)
+	}
+	period, err := k.normalizePeriod(ctx, params, tenant, integrityType, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+
+	integritySet, err := k.GetIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		return nil, err
+	}
+	// Only open sets can be discarded; sealed sets are corrected by superseding them.
+	if !integritySet.Open {
+		return nil, types.ErrIntegritySetSealed.Wrapf("set %s/%s/%s is sealed", tenant, integrityType, period)
+	}
+	if err := k.removeIntegritySet(ctx, integritySet); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegritySetCanceled,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integrityType),
+			sdk.NewAttribute(types.AttributeKeyPeriod, period),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.FormatUint(integritySet.RecordCount, 10)),
+		),
+	)
+
+	return &types.MsgCancelIntegritySetResponse{}, nil
+}
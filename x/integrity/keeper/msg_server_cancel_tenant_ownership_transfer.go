@@ -30,7 +30,9 @@ func (k msgServer) CancelTenantOwnershipTransfer(ctx context.Context, msg *types
 	}
 
 	pendingOwner := tenantRecord.PendingOwner
-	tenantRecord.PendingOwner = ""
+	if err := k.clearPendingOwner(ctx, &tenantRecord); err != nil {
+		return nil, err
+	}
 	if err := k.Tenants.Set(ctx, tenant, tenantRecord); err != nil {
 		return nil, err
 	}
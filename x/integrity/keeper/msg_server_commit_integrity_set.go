@@ -8,8 +8,6 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
-	"cosmossdk.io/collections"
-
 	"github.com/Kudora-Labs/kudora/x/integrity/types"
 )
 
@@ -25,66 +23,107 @@ func (k msgServer) CommitIntegritySet(ctx context.Context, msg *types.MsgCommitI
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
 
-	tenant, err := types.NormalizeTenant(msg.Tenant)
+	params, err := k.Params.Get(ctx)
 	if err != nil {
 		return nil, err
 	}
-	integrityType, err := types.NormalizeIntegrityType(msg.Type)
-	if err != nil {
+
+	if _, _, err := k.commitIntegritySet(ctx, params, creator, msg); err != nil {
 		return nil, err
 	}
-	period, err := types.NormalizePeriod(msg.Period)
+
+	return &types.MsgCommitIntegritySetResponse{}, nil
+}
+
+// commitIntegritySet stores a new integrity set on behalf of an already validated creator and emits its
+// commit event. It returns the stored set and the storage fee it charged.
+func (k msgServer) commitIntegritySet(ctx context.Context, params types.Params, creator string, msg *types.MsgCommitIntegritySet) (types.IntegritySet, sdk.Coins, error) {
+	tenant, err := params.NormalizeTenant(msg.Tenant)
 	if err != nil {
-		return nil, err
+		return types.IntegritySet{}, nil, err
+	}
+	integrityType, err := params.NormalizeIntegrityType(msg.Type)
+	if err != nil {
+		return types.IntegritySet{}, nil, err
+	}
+	period, err := k.normalizePeriod(ctx, params, tenant, integrityType, msg.Period)
+	if err != nil {
+		return types.IntegritySet{}, nil, err
+	}
+	if err := params.ValidateHashAlgorithm(msg.HashAlgorithm); err != nil {
+		return types.IntegritySet{}, nil, err
 	}
 	submittedRoot, err := types.NormalizeRoot(msg.Root)
 	if err != nil {
-		return nil, err
+		return types.IntegritySet{}, nil, err
+	}
+	var previousRoot string
+	if msg.PreviousRoot != "" {
+		previousRoot, err = types.NormalizeRoot(msg.PreviousRoot)
+		if err != nil {
+			return types.IntegritySet{}, nil, err
+		}
 	}
 
 	tenantRecord, err := k.GetTenant(ctx, tenant)
 	if err != nil {
-		return nil, err
+		return types.IntegritySet{}, nil, err
 	}
-	if tenantRecord.Owner != creator {
-		return nil, types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenant, tenantRecord.Owner)
+	if err := k.authorizeCommit(ctx, tenantRecord, creator, integrityType); err != nil {
+		return types.IntegritySet{}, nil, err
+	}
+	if err := k.checkIntegrityType(ctx, params, tenant, integrityType, msg.HashScheme, true); err != nil {
+		return types.IntegritySet{}, nil, err
 	}
 
 	setExists, err := k.HasIntegritySet(ctx, tenant, integrityType, period)
 	if err != nil {
-		return nil, err
+		return types.IntegritySet{}, nil, err
 	}
 	if setExists {
-		return nil, types.ErrIntegritySetAlreadyExists.Wrapf("set %s/%s/%s already exists", tenant, integrityType, period)
+		return types.IntegritySet{}, nil, types.ErrIntegritySetAlreadyExists.Wrapf("set %s/%s/%s already exists", tenant, integrityType, period)
 	}
 
-	calculatedRoot, records, err := types.CalculateMerkleRoot(msg.Records)
+	records, ciphertextBytes, err := params.PrepareIntegrityRecords(msg.Records)
 	if err != nil {
-		return nil, err
+		return types.IntegritySet{}, nil, err
 	}
+	calculatedRoot := types.MerkleHasher{Scheme: msg.HashScheme, Algorithm: msg.HashAlgorithm}.CalculateMerkleRoot(records)
 	if calculatedRoot != submittedRoot {
-		return nil, types.ErrRootMismatch.Wrapf("submitted root %s does not match calculated root %s", submittedRoot, calculatedRoot)
+		return types.IntegritySet{}, nil, types.ErrRootMismatch.Wrapf("submitted root %s does not match calculated root %s", submittedRoot, calculatedRoot)
+	}
+	chainLink, err := k.nextChainLink(ctx, tenant, integrityType, previousRoot)
+	if err != nil {
+		return types.IntegritySet{}, nil, err
 	}
 
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
-	integritySet := types.IntegritySet{
-		Tenant:      tenant,
-		Type:        integrityType,
-		Period:      period,
-		Root:        calculatedRoot,
-		Creator:     creator,
-		BlockHeight: uint64(sdkCtx.BlockHeight()),
-		BlockTime:   sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
-		RecordCount: uint64(len(records)),
-	}
-	if err := k.IntegritySets.Set(ctx, collections.Join3(tenant, integrityType, period), integritySet); err != nil {
-		return nil, err
+	storageFee, err := k.chargeStorageFee(ctx, params, creator, params.StorageFee(ciphertextBytes))
+	if err != nil {
+		return types.IntegritySet{}, nil, err
 	}
 
-	for _, record := range records {
-		if err := k.IntegrityRecords.Set(ctx, collections.Join4(tenant, integrityType, period, record.Tag), record); err != nil {
-			return nil, err
-		}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	integritySet := types.IntegritySet{
+		Tenant:              tenant,
+		Type:                integrityType,
+		Period:              period,
+		Root:                calculatedRoot,
+		Creator:             creator,
+		BlockHeight:         uint64(sdkCtx.BlockHeight()),
+		BlockTime:           sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+		RecordCount:         uint64(len(records)),
+		Version:             1,
+		HashScheme:          msg.HashScheme,
+		HashAlgorithm:       msg.HashAlgorithm,
+		ChainPreviousPeriod: chainLink.PreviousPeriod,
+		ChainPreviousRoot:   chainLink.PreviousRoot,
+		ChainLength:         chainLink.Length,
+	}
+	if err := k.storeIntegritySet(ctx, integritySet, records); err != nil {
+		return types.IntegritySet{}, nil, err
+	}
+	if err := k.setChainHead(ctx, integritySet); err != nil {
+		return types.IntegritySet{}, nil, err
 	}
 
 	sdkCtx.EventManager().EmitEvent(
@@ -96,8 +135,14 @@ func (k msgServer) CommitIntegritySet(ctx context.Context, msg *types.MsgCommitI
 			sdk.NewAttribute(types.AttributeKeyRoot, calculatedRoot),
 			sdk.NewAttribute(types.AttributeKeyCreator, creator),
 			sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.Itoa(len(records))),
+			sdk.NewAttribute(types.AttributeKeyStorageFee, storageFee.String()),
+			sdk.NewAttribute(types.AttributeKeyHashScheme, msg.HashScheme.String()),
+			sdk.NewAttribute(types.AttributeKeyHashAlgorithm, msg.HashAlgorithm.String()),
+			sdk.NewAttribute(types.AttributeKeyChainPreviousPeriod, integritySet.ChainPreviousPeriod),
+			sdk.NewAttribute(types.AttributeKeyChainPreviousRoot, integritySet.ChainPreviousRoot),
+			sdk.NewAttribute(types.AttributeKeyChainLength, strconv.FormatUint(integritySet.ChainLength, 10)),
 		),
 	)
 
-	return &types.MsgCommitIntegritySetResponse{}, nil
+	return integritySet, storageFee, nil
 }
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) CommitIntegritySets(ctx context.Context, msg *types.MsgCommitIntegritySets) (*types.MsgCommitIntegritySetsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batchCiphertextBytes := 0
+	for i, set := range msg.Sets {
+		_, ciphertextBytes, err := params.PrepareIntegrityRecords(set.Records)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "set %d", i)
+		}
+		batchCiphertextBytes += ciphertextBytes
+	}
+	if uint64(batchCiphertextBytes) > params.MaxBatchCiphertextBytes {
+		return nil, types.ErrBatchCiphertextTooLarge.Wrapf("batch carries %d ciphertext bytes, maximum is %d", batchCiphertextBytes, params.MaxBatchCiphertextBytes)
+	}
+
+	// Sets are written to a cached context that only reaches the store once every set has been
+	// committed, so a failing entry leaves no partial batch behind.
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, writeCache := sdkCtx.CacheContext()
+
+	recordCount := uint64(0)
+	storageFee := sdk.NewCoins()
+	for i, commit := range msg.CommitMsgs() {
+		integritySet, fee, err := k.commitIntegritySet(cacheCtx, params, creator, &commit)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "set %d", i)
+		}
+		recordCount += integritySet.RecordCount
+		storageFee = storageFee.Add(fee...)
+	}
+	writeCache()
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegritySetsCommitted,
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeySetCount, strconv.Itoa(len(msg.Sets))),
+			sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.FormatUint(recordCount, 10)),
+			sdk.NewAttribute(types.AttributeKeyStorageFee, storageFee.String()),
+		),
+	)
+
+	return &types.MsgCommitIntegritySetsResponse{SetCount: uint64(len(msg.Sets))}, nil
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) ExecuteTenantAction(ctx context.Context, msg *types.MsgExecuteTenantAction) (*types.MsgExecuteTenantActionResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeTenantSigner(tenantRecord, creator); err != nil {
+		return nil, err
+	}
+	proposal, err := k.GetTenantProposal(ctx, tenant, msg.ProposalId)
+	if err != nil {
+		return nil, err
+	}
+	if approvals := proposal.ApprovalCount(tenantRecord); approvals < tenantRecord.Threshold {
+		return nil, types.ErrTenantProposalThreshold.Wrapf("proposal %d has %d of %d approvals", proposal.Id, approvals, tenantRecord.Threshold)
+	}
+	action, err := proposal.Action.Msg(tenantRecord.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	// The proposal is closed before its action runs, since the action may itself discard the open
+	// proposals of the tenant. A failing action reverts the whole transaction, removal included.
+	if err := k.TenantProposals.Remove(ctx, collections.Join(tenant, proposal.Id)); err != nil {
+		return nil, err
+	}
+	if err := k.executeTenantAction(ctx, action); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenantActionExecuted,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(proposal.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAction, sdk.MsgTypeURL(action)),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+		),
+	)
+
+	return &types.MsgExecuteTenantActionResponse{}, nil
+}
@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) FreezeTenant(ctx context.Context, msg *types.MsgFreezeTenant) (*types.MsgFreezeTenantResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeStatusChange(tenantRecord, creator); err != nil {
+		return nil, err
+	}
+	if tenantRecord.Status != types.TenantStatus_TENANT_STATUS_ACTIVE {
+		return nil, types.ErrInvalidTenantStatus.Wrapf("tenant %s is already %s", tenant, tenantRecord.Status)
+	}
+
+	if err := k.setTenantStatus(ctx, tenantRecord, types.TenantStatus_TENANT_STATUS_FROZEN, creator, types.EventTypeTenantFrozen); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFreezeTenantResponse{}, nil
+}
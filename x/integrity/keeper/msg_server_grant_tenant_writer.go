@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) GrantTenantWriter(ctx context.Context, msg *types.MsgGrantTenantWriter) (*types.MsgGrantTenantWriterResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := k.validatedAddress(msg.Writer, "writer")
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	typePrefixes, err := types.NormalizeTypePrefixes(msg.TypePrefixes)
+	if err != nil {
+		return nil, err
+	}
+	for _, prefix := range typePrefixes {
+		if _, err := params.NormalizeIntegrityType(prefix); err != nil {
+			return nil, err
+		}
+	}
+	expiresAt, err := types.NormalizeExpiresAt(msg.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if tenantRecord.Owner != creator {
+		return nil, types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenant, tenantRecord.Owner)
+	}
+	if writer == tenantRecord.Owner {
+		return nil, types.ErrInvalidTenantWriter.Wrap("writer must differ from the tenant owner")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	grant := types.TenantWriter{
+		Tenant:        tenant,
+		Writer:        writer,
+		TypePrefixes:  typePrefixes,
+		ExpiresAt:     expiresAt,
+		GrantedBy:     creator,
+		GrantedHeight: uint64(sdkCtx.BlockHeight()),
+		GrantedTime:   sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+	}
+	if grant.IsExpired(sdkCtx.BlockTime()) {
+		return nil, types.ErrInvalidTenantWriter.Wrapf("expires at %s is not after the current block time", expiresAt)
+	}
+	if err := k.TenantWriters.Set(ctx, collections.Join(tenant, writer), grant); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenantWriterGranted,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyOwner, creator),
+			sdk.NewAttribute(types.AttributeKeyWriter, writer),
+			sdk.NewAttribute(types.AttributeKeyTypePrefixes, strings.Join(typePrefixes, ",")),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, expiresAt),
+		),
+	)
+
+	return &types.MsgGrantTenantWriterResponse{}, nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) OpenIntegritySet(ctx context.Context, msg *types.MsgOpenIntegritySet) (*types.MsgOpenIntegritySetResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := params.NormalizeTenant(msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	integrityType, err := params.NormalizeIntegrityType(msg.Type)
+	if err != nil {
+		return nil, err
+	}
+	period, err := k.normalizePeriod(ctx, params, tenant, integrityType, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.ValidateHashAlgorithm(msg.HashAlgorithm); err != nil {
+		return nil, err
+	}
+
+	tenantRecord, err := k.GetTenant(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeCommit(ctx, tenantRecord, creator, integrityType); err != nil {
+		return nil, err
+	}
+	if err := k.checkIntegrityType(ctx, params, tenant, integrityType, msg.HashScheme, true); err != nil {
+		return nil, err
+	}
+
+	setExists, err := k.HasIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		return nil, err
+	}
+	if setExists {
+		return nil, types.ErrIntegritySetAlreadyExists.Wrapf("set %s/%s/%s already exists", tenant, integrityType, period)
+	}
+
+	// The base fee is paid once when the set is opened; appends only pay for their bytes.
+	storageFee, err := k.chargeStorageFee(ctx, params, creator, params.StorageFee(0))
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	integritySet := types.IntegritySet{
+		Tenant:        tenant,
+		Type:          integrityType,
+		Period:        period,
+		Creator:       creator,
+		BlockHeight:   uint64(sdkCtx.BlockHeight()),
+		BlockTime:     sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+		Version:       1,
+		Open:          true,
+		HashScheme:    msg.HashScheme,
+		HashAlgorithm: msg.HashAlgorithm,
+	}
+	if err := k.storeIntegritySet(ctx, integritySet, nil); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegritySetOpened,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integrityType),
+			sdk.NewAttribute(types.AttributeKeyPeriod, period),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyStorageFee, storageFee.String()),
+			sdk.NewAttribute(types.AttributeKeyHashScheme, msg.HashScheme.String()),
+			sdk.NewAttribute(types.AttributeKeyHashAlgorithm, msg.HashAlgorithm.String()),
+		),
+	)
+
+	return &types.MsgOpenIntegritySetResponse{}, nil
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) ProposeTenantAction(ctx context.Context, msg *types.MsgProposeTenantAction) (*types.MsgProposeTenantActionResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeTenantSigner(tenantRecord, creator); err != nil {
+		return nil, err
+	}
+	action, err := types.ValidateTenantAction(tenant, tenantRecord.Owner, msg.Action)
+	if err != nil {
+		return nil, err
+	}
+
+	lastID, err := k.TenantProposalSeq.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+	id := lastID + 1
+	if err := k.TenantProposalSeq.Set(ctx, id); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	proposal := types.TenantProposal{
+		Tenant:        tenant,
+		Id:            id,
+		Action:        msg.Action,
+		Proposer:      creator,
+		Approvals:     []string{creator},
+		CreatedHeight: uint64(sdkCtx.BlockHeight()),
+		CreatedTime:   sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+	}
+	if err := k.TenantProposals.Set(ctx, collections.Join(tenant, id), proposal); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenantActionProposed,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyProposalID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyAction, sdk.MsgTypeURL(action)),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+		),
+	)
+
+	return &types.MsgProposeTenantActionResponse{ProposalId: id}, nil
+}
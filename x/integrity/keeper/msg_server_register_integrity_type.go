@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) RegisterIntegrityType(ctx context.Context, msg *types.MsgRegisterIntegrityType) (*types.MsgRegisterIntegrityTypeResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	integrityType, err := params.NormalizeIntegrityType(msg.Type)
+	if err != nil {
+		return nil, err
+	}
+	description, err := types.NormalizeTypeDescription(msg.Description)
+	if err != nil {
+		return nil, err
+	}
+	schemaHash, err := types.NormalizeSchemaHash(msg.SchemaHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if tenantRecord.Owner != creator {
+		return nil, types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenant, tenantRecord.Owner)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	integrityTypeRecord, err := k.GetIntegrityType(ctx, tenant, integrityType)
+	switch {
+	case errors.Is(err, types.ErrIntegrityTypeNotFound):
+		integrityTypeRecord = types.IntegrityType{
+			Tenant:           tenant,
+			Type:             integrityType,
+			RegisteredBy:     creator,
+			RegisteredHeight: uint64(sdkCtx.BlockHeight()),
+			RegisteredTime:   sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+		}
+	case err != nil:
+		return nil, err
+	case integrityTypeRecord.SchemaHash != schemaHash || integrityTypeRecord.HashScheme != msg.HashScheme:
+		// Existing sets were committed under the registered schema and hash scheme.
+		hasSets, err := k.hasIntegritySetsOfType(ctx, tenant, integrityType)
+		if err != nil {
+			return nil, err
+		}
+		if hasSets {
+			return nil, types.ErrIntegrityTypeLocked.Wrapf("%s/%s already has sets under schema %s and %s", tenant, integrityType, integrityTypeRecord.SchemaHash, integrityTypeRecord.HashScheme)
+		}
+	}
+
+	if err := k.setPeriodFormat(ctx, tenant, integrityType, msg.PeriodFormat, creator); err != nil {
+		return nil, err
+	}
+	integrityTypeRecord.Description = description
+	integrityTypeRecord.SchemaHash = schemaHash
+	integrityTypeRecord.PeriodFormat = msg.PeriodFormat
+	integrityTypeRecord.HashScheme = msg.HashScheme
+	integrityTypeRecord.Deprecated = msg.Deprecated
+	if err := k.IntegrityTypes.Set(ctx, collections.Join(tenant, integrityType), integrityTypeRecord); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegrityTypeRegistered,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integrityType),
+			sdk.NewAttribute(types.AttributeKeySchemaHash, schemaHash),
+			sdk.NewAttribute(types.AttributeKeyPeriodFormat, msg.PeriodFormat.String()),
+			sdk.NewAttribute(types.AttributeKeyHashScheme, msg.HashScheme.String()),
+			sdk.NewAttribute(types.AttributeKeyDeprecated, strconv.FormatBool(msg.Deprecated)),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+		),
+	)
+
+	return &types.MsgRegisterIntegrityTypeResponse{}, nil
+}
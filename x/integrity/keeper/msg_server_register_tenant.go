@@ -22,7 +22,11 @@ func (k msgServer) RegisterTenant(ctx context.Context, msg *types.MsgRegisterTen
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
 
-	tenant, err := types.NormalizeTenant(msg.Tenant)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tenant, err := params.NormalizeTenant(msg.Tenant)
 	if err != nil {
 		return nil, err
 	}
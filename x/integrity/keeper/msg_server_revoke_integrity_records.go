@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) RevokeIntegrityRecords(ctx context.Context, msg *types.MsgRevokeIntegrityRecords) (*types.MsgRevokeIntegrityRecordsResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := params.NormalizeTenant(msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	integrityType, err := params.NormalizeIntegrityType(msg.Type)
+	if err != nil {
+		return nil, err
+	}
+	period, err := k.normalizePeriod(ctx, params, tenant, integrityType, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+	tags, err := types.NormalizeRevocationTags(msg.Tags)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantRecord, err := k.GetTenant(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeCommit(ctx, tenantRecord, creator, integrityType); err != nil {
+		return nil, err
+	}
+
+	integritySet, err := k.GetIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		return nil, err
+	}
+	if !integritySet.RecordsOnChain() {
+		return nil, types.ErrRecordsNotOnChain.Wrapf("set %s/%s/%s is root-only", tenant, integrityType, period)
+	}
+	// The records of an open set are not committed yet and can still be discarded with the set.
+	if integritySet.Open {
+		return nil, types.ErrIntegritySetOpen.Wrapf("set %s/%s/%s must be sealed before its records can be revoked", tenant, integrityType, period)
+	}
+
+	for _, tag := range tags {
+		if _, err := k.GetIntegrityRecord(ctx, tenant, integrityType, period, tag); err != nil {
+			return nil, err
+		}
+		_, revoked, err := k.GetRecordRevocation(ctx, tenant, integrityType, period, tag)
+		if err != nil {
+			return nil, err
+		}
+		if revoked {
+			return nil, types.ErrRecordAlreadyRevoked.Wrapf("record %s of set %s/%s/%s", tag, tenant, integrityType, period)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, tag := range tags {
+		if err := k.RecordRevocations.Set(ctx, collections.Join4(tenant, integrityType, period, tag), types.IntegrityRecordRevocation{
+			Tenant:        tenant,
+			Type:          integrityType,
+			Period:        period,
+			Tag:           tag,
+			ReasonCode:    msg.ReasonCode,
+			RevokedBy:     creator,
+			RevokedHeight: uint64(sdkCtx.BlockHeight()),
+			RevokedTime:   sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegrityRecordsRevoked,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integrityType),
+			sdk.NewAttribute(types.AttributeKeyPeriod, period),
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(integritySet.Version, 10)),
+			sdk.NewAttribute(types.AttributeKeyTags, strings.Join(tags, ",")),
+			sdk.NewAttribute(types.AttributeKeyReasonCode, strconv.FormatUint(uint64(msg.ReasonCode), 10)),
+			sdk.NewAttribute(types.AttributeKeyRevokedCount, strconv.Itoa(len(tags))),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+		),
+	)
+
+	return &types.MsgRevokeIntegrityRecordsResponse{}, nil
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) RevokeTenantWriter(ctx context.Context, msg *types.MsgRevokeTenantWriter) (*types.MsgRevokeTenantWriterResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	writer, err := k.validatedAddress(msg.Writer, "writer")
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if tenantRecord.Owner != creator {
+		return nil, types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenant, tenantRecord.Owner)
+	}
+	if _, err := k.GetTenantWriter(ctx, tenant, writer); err != nil {
+		return nil, err
+	}
+	if err := k.TenantWriters.Remove(ctx, collections.Join(tenant, writer)); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenantWriterRevoked,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyOwner, creator),
+			sdk.NewAttribute(types.AttributeKeyWriter, writer),
+		),
+	)
+
+	return &types.MsgRevokeTenantWriterResponse{}, nil
+}
@@ -0,0 +1,88 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) SealIntegritySet(ctx context.Context, msg *types.MsgSealIntegritySet) (*types.MsgSealIntegritySetResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	submittedRoot, err := types.NormalizeRoot(msg.Root)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	integritySet, err := k.openSetForUpdate(ctx, params, creator, msg.Tenant, msg.Type, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.checkIntegrityType(ctx, params, integritySet.Tenant, integritySet.Type, integritySet.HashScheme, false); err != nil {
+		return nil, err
+	}
+
+	records, err := k.ListIntegrityRecords(ctx, integritySet.Tenant, integritySet.Type, integritySet.Period)
+	if err != nil {
+		return nil, err
+	}
+	if len(records) == 0 {
+		return nil, types.ErrEmptyRecords.Wrapf("set %s/%s/%s has no records", integritySet.Tenant, integritySet.Type, integritySet.Period)
+	}
+	calculatedRoot := integritySet.MerkleHasher().CalculateMerkleRoot(records)
+	if calculatedRoot != submittedRoot {
+		return nil, types.ErrRootMismatch.Wrapf("submitted root %s does not match calculated root %s", submittedRoot, calculatedRoot)
+	}
+	chainLink, err := k.nextChainLink(ctx, integritySet.Tenant, integritySet.Type, "")
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	integritySet.Root = calculatedRoot
+	integritySet.RecordCount = uint64(len(records))
+	integritySet.Creator = creator
+	integritySet.BlockHeight = uint64(sdkCtx.BlockHeight())
+	integritySet.BlockTime = sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano)
+	integritySet.Open = false
+	integritySet.ChainPreviousPeriod = chainLink.PreviousPeriod
+	integritySet.ChainPreviousRoot = chainLink.PreviousRoot
+	integritySet.ChainLength = chainLink.Length
+	if err := k.storeIntegritySet(ctx, integritySet, nil); err != nil {
+		return nil, err
+	}
+	if err := k.setChainHead(ctx, integritySet); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegritySetSealed,
+			sdk.NewAttribute(types.AttributeKeyTenant, integritySet.Tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integritySet.Type),
+			sdk.NewAttribute(types.AttributeKeyPeriod, integritySet.Period),
+			sdk.NewAttribute(types.AttributeKeyRoot, calculatedRoot),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.Itoa(len(records))),
+			sdk.NewAttribute(types.AttributeKeyChainPreviousPeriod, integritySet.ChainPreviousPeriod),
+			sdk.NewAttribute(types.AttributeKeyChainPreviousRoot, integritySet.ChainPreviousRoot),
+			sdk.NewAttribute(types.AttributeKeyChainLength, strconv.FormatUint(integritySet.ChainLength, 10)),
+		),
+	)
+
+	return &types.MsgSealIntegritySetResponse{}, nil
+}
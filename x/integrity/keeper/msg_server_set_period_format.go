@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) SetPeriodFormat(ctx context.Context, msg *types.MsgSetPeriodFormat) (*types.MsgSetPeriodFormatResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	integrityType, err := params.NormalizeIntegrityType(msg.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if tenantRecord.Owner != creator {
+		return nil, types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenant, tenantRecord.Owner)
+	}
+
+	if err := k.setPeriodFormat(ctx, tenant, integrityType, msg.Format, creator); err != nil {
+		return nil, err
+	}
+	// A registered type mirrors the declared format.
+	integrityTypeRecord, err := k.GetIntegrityType(ctx, tenant, integrityType)
+	switch {
+	case err == nil:
+		integrityTypeRecord.PeriodFormat = msg.Format
+		if err := k.IntegrityTypes.Set(ctx, collections.Join(tenant, integrityType), integrityTypeRecord); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, types.ErrIntegrityTypeNotFound):
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePeriodFormatSet,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integrityType),
+			sdk.NewAttribute(types.AttributeKeyPeriodFormat, msg.Format.String()),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+		),
+	)
+
+	return &types.MsgSetPeriodFormatResponse{}, nil
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) SetTenantSigners(ctx context.Context, msg *types.MsgSetTenantSigners) (*types.MsgSetTenantSignersResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	signers, err := types.NormalizeTenantSigners(msg.Signers, msg.Threshold)
+	if err != nil {
+		return nil, err
+	}
+	for _, signer := range signers {
+		if _, err := k.validatedAddress(signer, "signer"); err != nil {
+			return nil, err
+		}
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if tenantRecord.Owner != creator {
+		return nil, types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenant, tenantRecord.Owner)
+	}
+	policyAddress, err := k.TenantPolicyAddress(tenant)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(signers, policyAddress) {
+		return nil, types.ErrInvalidTenantSigners.Wrap("the tenant policy address cannot be a signer")
+	}
+
+	// Offers and proposals were made under the previous owner and do not carry over to the new signers.
+	if err := k.clearPendingOwner(ctx, &tenantRecord); err != nil {
+		return nil, err
+	}
+	if err := k.clearTenantProposals(ctx, tenant); err != nil {
+		return nil, err
+	}
+	tenantRecord.Owner = policyAddress
+	tenantRecord.Signers = signers
+	tenantRecord.Threshold = msg.Threshold
+	if err := k.Tenants.Set(ctx, tenant, tenantRecord); err != nil {
+		return nil, err
+	}
+	// Writer grants were issued by the previous owner and do not carry over to the new signers.
+	if err := k.clearTenantWriters(ctx, tenant); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTenantSignersSet,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyOwner, policyAddress),
+			sdk.NewAttribute(types.AttributeKeySigners, strings.Join(signers, ",")),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(msg.Threshold), 10)),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+		),
+	)
+
+	return &types.MsgSetTenantSignersResponse{}, nil
+}
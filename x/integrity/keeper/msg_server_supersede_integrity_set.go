@@ -0,0 +1,143 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) SupersedeIntegritySet(ctx context.Context, msg *types.MsgSupersedeIntegritySet) (*types.MsgSupersedeIntegritySetResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	creator, err := types.NormalizeCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := k.addressCodec.StringToBytes(creator); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := params.NormalizeTenant(msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	integrityType, err := params.NormalizeIntegrityType(msg.Type)
+	if err != nil {
+		return nil, err
+	}
+	period, err := k.normalizePeriod(ctx, params, tenant, integrityType, msg.Period)
+	if err != nil {
+		return nil, err
+	}
+	if err := params.ValidateHashAlgorithm(msg.HashAlgorithm); err != nil {
+		return nil, err
+	}
+	submittedRoot, err := types.NormalizeRoot(msg.Root)
+	if err != nil {
+		return nil, err
+	}
+	reasonHash, err := types.NormalizeReasonHash(msg.ReasonHash)
+	if err != nil {
+		return nil, err
+	}
+
+	tenantRecord, err := k.GetTenant(ctx, tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeCommit(ctx, tenantRecord, creator, integrityType); err != nil {
+		return nil, err
+	}
+	if err := k.checkIntegrityType(ctx, params, tenant, integrityType, msg.HashScheme, false); err != nil {
+		return nil, err
+	}
+
+	current, err := k.GetIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		return nil, err
+	}
+	if current.Open {
+		return nil, types.ErrIntegritySetOpen.Wrapf("set %s/%s/%s must be sealed before it can be superseded", tenant, integrityType, period)
+	}
+
+	records, ciphertextBytes, err := params.PrepareIntegrityRecords(msg.Records)
+	if err != nil {
+		return nil, err
+	}
+	calculatedRoot := types.MerkleHasher{Scheme: msg.HashScheme, Algorithm: msg.HashAlgorithm}.CalculateMerkleRoot(records)
+	if calculatedRoot != submittedRoot {
+		return nil, types.ErrRootMismatch.Wrapf("submitted root %s does not match calculated root %s", submittedRoot, calculatedRoot)
+	}
+	if calculatedRoot == current.Root {
+		return nil, types.ErrIntegritySetUnchanged.Wrapf("set %s/%s/%s version %d already has root %s", tenant, integrityType, period, current.Version, calculatedRoot)
+	}
+
+	storageFee, err := k.chargeStorageFee(ctx, params, creator, params.StorageFee(ciphertextBytes))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.archiveIntegritySet(ctx, current); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	integritySet := types.IntegritySet{
+		Tenant:        tenant,
+		Type:          integrityType,
+		Period:        period,
+		Root:          calculatedRoot,
+		Creator:       creator,
+		BlockHeight:   uint64(sdkCtx.BlockHeight()),
+		BlockTime:     sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+		RecordCount:   uint64(len(records)),
+		Version:       current.Version + 1,
+		PreviousRoot:  current.Root,
+		ReasonHash:    reasonHash,
+		HashScheme:    msg.HashScheme,
+		HashAlgorithm: msg.HashAlgorithm,
+		// A correction carries its records, so it is stored on chain even when it replaces a root-only set.
+		StorageMode: types.StorageMode_STORAGE_MODE_ON_CHAIN,
+		// A correction keeps the place of the set in its chain.
+		ChainPreviousPeriod: current.ChainPreviousPeriod,
+		ChainPreviousRoot:   current.ChainPreviousRoot,
+		ChainLength:         current.ChainLength,
+	}
+	if err := k.storeIntegritySet(ctx, integritySet, records); err != nil {
+		return nil, err
+	}
+	if err := k.moveChainHead(ctx, current, integritySet); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIntegritySetSuperseded,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+			sdk.NewAttribute(types.AttributeKeyType, integrityType),
+			sdk.NewAttribute(types.AttributeKeyPeriod, period),
+			sdk.NewAttribute(types.AttributeKeyVersion, strconv.FormatUint(integritySet.Version, 10)),
+			sdk.NewAttribute(types.AttributeKeyRoot, calculatedRoot),
+			sdk.NewAttribute(types.AttributeKeyPreviousRoot, current.Root),
+			sdk.NewAttribute(types.AttributeKeyReasonHash, reasonHash),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+			sdk.NewAttribute(types.AttributeKeyRecordCount, strconv.Itoa(len(records))),
+			sdk.NewAttribute(types.AttributeKeyStorageFee, storageFee.String()),
+			sdk.NewAttribute(types.AttributeKeyHashScheme, msg.HashScheme.String()),
+			sdk.NewAttribute(types.AttributeKeyHashAlgorithm, msg.HashAlgorithm.String()),
+		),
+	)
+
+	return &types.MsgSupersedeIntegritySetResponse{Version: integritySet.Version}, nil
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"strconv"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -33,17 +34,31 @@ func (k msgServer) TransferTenantOwnership(ctx context.Context, msg *types.MsgTr
 		return nil, types.ErrTenantOwnershipUnchanged.Wrap("new owner must differ from the current owner")
 	}
 
-	tenantRecord.PendingOwner = newOwner
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	expiresAt, err := types.NormalizeTransferExpiresAt(msg.ExpiresAt)
+	if err != nil {
+		return nil, err
+	}
+	offer := types.Tenant{PendingOwnerExpiresAt: expiresAt, PendingOwnerExpiresHeight: msg.ExpiresHeight}
+	if offer.PendingOwnerExpired(sdkCtx.BlockTime(), uint64(sdkCtx.BlockHeight())) {
+		return nil, types.ErrInvalidTransferExpiry.Wrapf("offer of tenant %s would expire before it can be accepted", tenant)
+	}
+
+	if err := k.setPendingOwner(ctx, &tenantRecord, newOwner, expiresAt, msg.ExpiresHeight); err != nil {
+		return nil, err
+	}
 	if err := k.Tenants.Set(ctx, tenant, tenantRecord); err != nil {
 		return nil, err
 	}
 
-	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeTenantOwnershipTransferStarted,
 			sdk.NewAttribute(types.AttributeKeyTenant, tenant),
 			sdk.NewAttribute(types.AttributeKeyOwner, creator),
 			sdk.NewAttribute(types.AttributeKeyPendingOwner, newOwner),
+			sdk.NewAttribute(types.AttributeKeyExpiresAt, expiresAt),
+			sdk.NewAttribute(types.AttributeKeyExpiresHeight, strconv.FormatUint(msg.ExpiresHeight, 10)),
 		),
 	)
 
@@ -0,0 +1,39 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k msgServer) UnfreezeTenant(ctx context.Context, msg *types.MsgUnfreezeTenant) (*types.MsgUnfreezeTenantResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	creator, err := k.validatedCreator(msg.Creator)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, tenantRecord, err := k.tenantForUpdate(ctx, msg.Tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.authorizeStatusChange(tenantRecord, creator); err != nil {
+		return nil, err
+	}
+	if tenantRecord.Status != types.TenantStatus_TENANT_STATUS_FROZEN {
+		return nil, types.ErrInvalidTenantStatus.Wrapf("tenant %s is not frozen", tenant)
+	}
+	// The owner cannot lift a freeze imposed by governance.
+	if k.isAuthority(tenantRecord.StatusChangedBy) && !k.isAuthority(creator) {
+		return nil, types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s was frozen by the module authority", tenant)
+	}
+
+	if err := k.setTenantStatus(ctx, tenantRecord, types.TenantStatus_TENANT_STATUS_ACTIVE, creator, types.EventTypeTenantUnfrozen); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnfreezeTenantResponse{}, nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	"github.com/Kudora-Labs/kudora/x/integrity/keeper"
@@ -36,12 +37,65 @@ func TestMsgUpdateParams(t *testing.T) {
 			expErrMsg: "invalid authority",
 		},
 		{
-			name: "send enabled param",
+			name: "zero limits",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params:    types.Params{},
 			},
-			expErr: false,
+			expErr:    true,
+			expErrMsg: "must be positive",
+		},
+		{
+			name: "ciphertext limit above total",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := types.DefaultParams()
+					p.MaxTotalCiphertextBytes = p.MaxCiphertextBytes - 1
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "exceeds max total ciphertext bytes",
+		},
+		{
+			name: "limit above absolute maximum",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := types.DefaultParams()
+					p.MaxNonceBytes = types.AbsoluteMaxNonceBytes + 1
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "exceeds the absolute maximum",
+		},
+		{
+			name: "negative storage fee",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := types.DefaultParams()
+					p.StorageFeePerByte = math.NewInt(-1)
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "storage fee per byte must not be negative",
+		},
+		{
+			name: "duplicate hash algorithm",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: func() types.Params {
+					p := types.DefaultParams()
+					p.AllowedHashAlgorithms = append(p.AllowedHashAlgorithms, types.HashAlgorithm_HASH_ALGORITHM_SHA256)
+					return p
+				}(),
+			},
+			expErr:    true,
+			expErrMsg: "duplicate allowed hash algorithm",
 		},
 		{
 			name: "all good",
@@ -0,0 +1,42 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// openSetForUpdate returns the open set targeted by an append or seal after checking that creator may
+// write sets of its type for the tenant.
+func (k msgServer) openSetForUpdate(ctx context.Context, params types.Params, creator, tenant, integrityType, period string) (types.IntegritySet, error) {
+	tenant, err := params.NormalizeTenant(tenant)
+	if err != nil {
+		return types.IntegritySet{}, err
+	}
+	integrityType, err = params.NormalizeIntegrityType(integrityType)
+	if err != nil {
+		return types.IntegritySet{}, err
+	}
+	period, err = k.normalizePeriod(ctx, params, tenant, integrityType, period)
+	if err != nil {
+		return types.IntegritySet{}, err
+	}
+
+	tenantRecord, err := k.GetTenant(ctx, tenant)
+	if err != nil {
+		return types.IntegritySet{}, err
+	}
+	if err := k.authorizeCommit(ctx, tenantRecord, creator, integrityType); err != nil {
+		return types.IntegritySet{}, err
+	}
+
+	set, err := k.GetIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		return types.IntegritySet{}, err
+	}
+	if !set.Open {
+		return types.IntegritySet{}, types.ErrIntegritySetSealed.Wrapf("set %s/%s/%s is sealed", tenant, integrityType, period)
+	}
+
+	return set, nil
+}
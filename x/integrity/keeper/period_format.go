@@ -0,0 +1,134 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (k Keeper) GetPeriodFormat(ctx context.Context, tenant, integrityType string) (types.TypePeriodFormat, error) {
+	periodFormat, err := k.PeriodFormats.Get(ctx, collections.Join(tenant, integrityType))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TypePeriodFormat{}, types.ErrPeriodFormatNotFound.Wrapf("%s/%s has no declared period format", tenant, integrityType)
+		}
+		return types.TypePeriodFormat{}, err
+	}
+
+	return periodFormat, nil
+}
+
+// periodFormat returns the period format of a type, PERIOD_FORMAT_UNSPECIFIED when none was declared.
+func (k Keeper) periodFormat(ctx context.Context, tenant, integrityType string) (types.PeriodFormat, error) {
+	periodFormat, err := k.GetPeriodFormat(ctx, tenant, integrityType)
+	if err != nil {
+		if errors.Is(err, types.ErrPeriodFormatNotFound) {
+			return types.PeriodFormat_PERIOD_FORMAT_UNSPECIFIED, nil
+		}
+		return types.PeriodFormat_PERIOD_FORMAT_UNSPECIFIED, err
+	}
+
+	return periodFormat.Format, nil
+}
+
+// NormalizePeriod parses a period of an already normalized tenant and type under the period format
+// declared for the type and returns its canonical form.
+func (k Keeper) NormalizePeriod(ctx context.Context, tenant, integrityType, period string) (string, error) {
+	format, err := k.periodFormat(ctx, tenant, integrityType)
+	if err != nil {
+		return "", err
+	}
+
+	return format.NormalizePeriod(period)
+}
+
+// normalizePeriod is NormalizePeriod followed by the period length limit from params.
+func (k Keeper) normalizePeriod(ctx context.Context, params types.Params, tenant, integrityType, period string) (string, error) {
+	period, err := k.NormalizePeriod(ctx, tenant, integrityType, period)
+	if err != nil {
+		return "", err
+	}
+
+	return params.NormalizePeriod(period)
+}
+
+// setPeriodFormat declares the period format of a type on behalf of creator, or removes the declaration for
+// PERIOD_FORMAT_UNSPECIFIED. The format can only change while the type has no sets.
+func (k Keeper) setPeriodFormat(ctx context.Context, tenant, integrityType string, format types.PeriodFormat, creator string) error {
+	current, err := k.periodFormat(ctx, tenant, integrityType)
+	if err != nil {
+		return err
+	}
+	if current != format {
+		// Stored periods and their sort keys were derived from the current format.
+		hasSets, err := k.hasIntegritySetsOfType(ctx, tenant, integrityType)
+		if err != nil {
+			return err
+		}
+		if hasSets {
+			return types.ErrPeriodFormatLocked.Wrapf("%s/%s already has sets under %s", tenant, integrityType, current)
+		}
+	}
+
+	key := collections.Join(tenant, integrityType)
+	if format == types.PeriodFormat_PERIOD_FORMAT_UNSPECIFIED {
+		return k.PeriodFormats.Remove(ctx, key)
+	}
+	if current == format {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return k.PeriodFormats.Set(ctx, key, types.TypePeriodFormat{
+		Tenant:         tenant,
+		Type:           integrityType,
+		Format:         format,
+		DeclaredBy:     creator,
+		DeclaredHeight: uint64(sdkCtx.BlockHeight()),
+		DeclaredTime:   sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano),
+	})
+}
+
+// indexIntegritySetPeriod records the period sort key of a set in IntegritySetPeriodIndex.
+func (k Keeper) indexIntegritySetPeriod(ctx context.Context, set types.IntegritySet) error {
+	format, err := k.periodFormat(ctx, set.Tenant, set.Type)
+	if err != nil {
+		return err
+	}
+	sortKey, err := format.PeriodSortKey(set.Period)
+	if err != nil {
+		return err
+	}
+
+	return k.IntegritySetPeriodIndex.Set(ctx, collections.Join3(set.Tenant, set.Type, sortKey), set.Period)
+}
+
+// unindexIntegritySetPeriod removes the period sort key of a set from IntegritySetPeriodIndex.
+func (k Keeper) unindexIntegritySetPeriod(ctx context.Context, set types.IntegritySet) error {
+	format, err := k.periodFormat(ctx, set.Tenant, set.Type)
+	if err != nil {
+		return err
+	}
+	sortKey, err := format.PeriodSortKey(set.Period)
+	if err != nil {
+		return err
+	}
+
+	return k.IntegritySetPeriodIndex.Remove(ctx, collections.Join3(set.Tenant, set.Type, sortKey))
+}
+
+// hasIntegritySetsOfType reports whether any set of the tenant and type exists.
+func (k Keeper) hasIntegritySetsOfType(ctx context.Context, tenant, integrityType string) (bool, error) {
+	iter, err := k.IntegritySets.Iterate(ctx, collections.NewSuperPrefixedTripleRange[string, string, string](tenant, integrityType))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+
+	return iter.Valid(), nil
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (q queryServer) ChainHead(ctx context.Context, req *types.QueryChainHeadRequest) (*types.QueryChainHeadResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	integrityType, err := types.NormalizeIntegrityType(req.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	head, err := q.k.GetChainHead(ctx, tenant, integrityType)
+	if err != nil {
+		if errors.Is(err, types.ErrChainHeadNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryChainHeadResponse{Head: head}, nil
+}
+
+func (q queryServer) VerifyChain(ctx context.Context, req *types.QueryVerifyChainRequest) (*types.QueryVerifyChainResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	integrityType, err := types.NormalizeIntegrityType(req.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	limit := req.Limit
+	if limit == 0 {
+		limit = types.DefaultChainVerifyLimit
+	}
+	if limit > types.MaxChainVerifyLimit {
+		return nil, status.Errorf(codes.InvalidArgument, "limit must not exceed %d", types.MaxChainVerifyLimit)
+	}
+
+	head, err := q.k.GetChainHead(ctx, tenant, integrityType)
+	if err != nil {
+		if errors.Is(err, types.ErrChainHeadNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	period, root := head.Period, head.Root
+	if req.FromPeriod != "" || req.FromRoot != "" {
+		period, err = q.k.NormalizePeriod(ctx, tenant, integrityType, req.FromPeriod)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		root, err = types.NormalizeRoot(req.FromRoot)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	links, nextPeriod, nextRoot, err := q.k.VerifyChain(ctx, tenant, integrityType, period, root, int(limit))
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrIntegritySetNotFound):
+			return nil, status.Error(codes.NotFound, err.Error())
+		case errors.Is(err, types.ErrChainBroken):
+			return nil, status.Error(codes.DataLoss, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.QueryVerifyChainResponse{
+		Head:       head,
+		Links:      links,
+		Complete:   nextRoot == "",
+		NextPeriod: nextPeriod,
+		NextRoot:   nextRoot,
+	}, nil
+}
@@ -22,7 +22,7 @@ func (q queryServer) IntegrityRecord(ctx context.Context, req *types.QueryIntegr
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	period, err := types.NormalizePeriod(req.Period)
+	period, err := q.k.NormalizePeriod(ctx, tenant, integrityType, req.Period)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
@@ -31,24 +31,33 @@ func (q queryServer) IntegrityRecord(ctx context.Context, req *types.QueryIntegr
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	integritySet, err := q.k.GetIntegritySet(ctx, tenant, integrityType, period)
+	integritySet, err := q.k.GetIntegritySetVersion(ctx, tenant, integrityType, period, req.Version)
 	if err != nil {
 		if errors.Is(err, types.ErrIntegritySetNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if !integritySet.RecordsOnChain() {
+		return nil, status.Error(codes.FailedPrecondition, types.ErrRecordsNotOnChain.Wrapf("set %s/%s/%s is root-only", tenant, integrityType, period).Error())
+	}
 
-	record, err := q.k.GetIntegrityRecord(ctx, tenant, integrityType, period, tag)
+	record, err := q.k.GetIntegrityRecordForVersion(ctx, tenant, integrityType, period, integritySet.Version, tag)
 	if err != nil {
 		if errors.Is(err, types.ErrIntegrityRecordNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	revocation, revoked, err := q.k.GetRecordRevocation(ctx, tenant, integrityType, period, tag)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
 
 	return &types.QueryIntegrityRecordResponse{
-		Set:    integritySet,
-		Record: record,
+		Set:        integritySet,
+		Record:     record,
+		Revoked:    revoked,
+		Revocation: revocation,
 	}, nil
 }
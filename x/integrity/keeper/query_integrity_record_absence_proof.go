@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) IntegrityRecordAbsenceProof(ctx context.Context, req *types.QueryIntegrityRecordAbsenceProofRequest) (*types.QueryIntegrityRecordAbsenceProofResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	integrityType, err := types.NormalizeIntegrityType(req.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	period, err := q.k.NormalizePeriod(ctx, tenant, integrityType, req.Period)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	tag, err := types.NormalizeTag(req.Tag)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	integritySet, err := q.k.GetIntegritySetVersion(ctx, tenant, integrityType, period, req.Version)
+	if err != nil {
+		if errors.Is(err, types.ErrIntegritySetNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !integritySet.RecordsOnChain() {
+		return nil, status.Error(codes.FailedPrecondition, types.ErrRecordsNotOnChain.Wrapf("set %s/%s/%s is root-only", tenant, integrityType, period).Error())
+	}
+
+	proof, err := q.k.GetIntegrityRecordAbsenceProof(ctx, tenant, integrityType, period, integritySet.Version, tag)
+	if err != nil {
+		switch {
+		case errors.Is(err, types.ErrIntegrityRecordPresent), errors.Is(err, types.ErrEmptyRecords):
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		default:
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	return &types.QueryIntegrityRecordAbsenceProofResponse{
+		Set:   integritySet,
+		Proof: proof,
+	}, nil
+}
@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (q queryServer) IntegrityRecords(ctx context.Context, req *types.QueryIntegrityRecordsRequest) (*types.QueryIntegrityRecordsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	integrityType, err := types.NormalizeIntegrityType(req.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	period, err := q.k.NormalizePeriod(ctx, tenant, integrityType, req.Period)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	integritySet, err := q.k.GetIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		if errors.Is(err, types.ErrIntegritySetNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !integritySet.RecordsOnChain() {
+		return nil, status.Error(codes.FailedPrecondition, types.ErrRecordsNotOnChain.Wrapf("set %s/%s/%s is root-only", tenant, integrityType, period).Error())
+	}
+
+	prefix := collections.QuadSuperPrefix3[string, string, string, string](tenant, integrityType, period)
+	records, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.IntegrityRecords,
+		req.Pagination,
+		func(_ collections.Quad[string, string, string, string], record types.IntegrityRecord) (types.IntegrityRecord, error) {
+			return record, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Quad[string, string, string, string]]) {
+			o.Prefix = &prefix
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIntegrityRecordsResponse{
+		Records:    records,
+		Pagination: pageRes,
+	}, nil
+}
@@ -22,12 +22,12 @@ func (q queryServer) IntegritySet(ctx context.Context, req *types.QueryIntegrity
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
-	period, err := types.NormalizePeriod(req.Period)
+	period, err := q.k.NormalizePeriod(ctx, tenant, integrityType, req.Period)
 	if err != nil {
 		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	integritySet, err := q.k.GetIntegritySet(ctx, tenant, integrityType, period)
+	integritySet, err := q.k.GetIntegritySetVersion(ctx, tenant, integrityType, period, req.Version)
 	if err != nil {
 		if errors.Is(err, types.ErrIntegritySetNotFound) {
 			return nil, status.Error(codes.NotFound, err.Error())
@@ -35,13 +35,27 @@ func (q queryServer) IntegritySet(ctx context.Context, req *types.QueryIntegrity
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	records, err := q.k.ListIntegrityRecords(ctx, tenant, integrityType, period)
+	// Root-only sets have no records to include; storage_mode tells clients where to find them.
+	includeRecords := !req.ExcludeRecords && integritySet.RecordsOnChain()
+	var records []types.IntegrityRecord
+	if includeRecords {
+		records, err = q.k.ListIntegrityRecordsForVersion(ctx, tenant, integrityType, period, integritySet.Version)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	revocations, err := q.k.ListRecordRevocationsForVersion(ctx, tenant, integrityType, period, integritySet.Version)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
+	if !includeRecords {
+		return &types.QueryIntegritySetResponse{Set: integritySet, Revocations: revocations}, nil
+	}
 
 	return &types.QueryIntegritySetResponse{
-		Set:     integritySet,
-		Records: records,
+		Set:             integritySet,
+		Records:         records,
+		RecordsIncluded: true,
+		Revocations:     revocations,
 	}, nil
 }
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (q queryServer) IntegritySetsByTenant(ctx context.Context, req *types.QueryIntegritySetsByTenantRequest) (*types.QueryIntegritySetsByTenantResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sets, pageRes, err := q.k.paginateIntegritySets(ctx, collections.TriplePrefix[string, string, string](tenant), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIntegritySetsByTenantResponse{
+		Sets:       sets,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) IntegritySetsByTenantAndType(ctx context.Context, req *types.QueryIntegritySetsByTenantAndTypeRequest) (*types.QueryIntegritySetsByTenantAndTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	integrityType, err := types.NormalizeIntegrityType(req.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	sets, pageRes, err := q.k.paginateIntegritySets(ctx, collections.TripleSuperPrefix[string, string, string](tenant, integrityType), req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIntegritySetsByTenantAndTypeResponse{
+		Sets:       sets,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateIntegritySets pages through set headers under a partial tenant/type/period key without loading records.
+func (k Keeper) paginateIntegritySets(
+	ctx context.Context,
+	prefix collections.Triple[string, string, string],
+	pageReq *query.PageRequest,
+) ([]types.IntegritySet, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		k.IntegritySets,
+		pageReq,
+		func(_ collections.Triple[string, string, string], set types.IntegritySet) (types.IntegritySet, error) {
+			return set, nil
+		},
+		func(o *query.CollectionsPaginateOptions[collections.Triple[string, string, string]]) {
+			o.Prefix = &prefix
+		},
+	)
+}
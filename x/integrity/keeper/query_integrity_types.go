@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (q queryServer) IntegrityType(ctx context.Context, req *types.QueryIntegrityTypeRequest) (*types.QueryIntegrityTypeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	integrityType, err := types.NormalizeIntegrityType(req.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	integrityTypeRecord, err := q.k.GetIntegrityType(ctx, tenant, integrityType)
+	if err != nil {
+		if errors.Is(err, types.ErrIntegrityTypeNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIntegrityTypeResponse{IntegrityType: integrityTypeRecord}, nil
+}
+
+func (q queryServer) IntegrityTypes(ctx context.Context, req *types.QueryIntegrityTypesRequest) (*types.QueryIntegrityTypesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	integrityTypes, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.IntegrityTypes,
+		req.Pagination,
+		func(_ collections.Pair[string, string], integrityType types.IntegrityType) (types.IntegrityType, error) {
+			return integrityType, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](tenant),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIntegrityTypesResponse{
+		IntegrityTypes: integrityTypes,
+		Pagination:     pageRes,
+	}, nil
+}
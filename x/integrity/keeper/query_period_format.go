@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (q queryServer) PeriodFormat(ctx context.Context, req *types.QueryPeriodFormatRequest) (*types.QueryPeriodFormatResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	integrityType, err := types.NormalizeIntegrityType(req.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	periodFormat, err := q.k.GetPeriodFormat(ctx, tenant, integrityType)
+	if err != nil {
+		if errors.Is(err, types.ErrPeriodFormatNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPeriodFormatResponse{PeriodFormat: periodFormat}, nil
+}
+
+func (q queryServer) IntegritySetsByPeriodRange(ctx context.Context, req *types.QueryIntegritySetsByPeriodRangeRequest) (*types.QueryIntegritySetsByPeriodRangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	integrityType, err := types.NormalizeIntegrityType(req.Type)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	format, err := q.k.periodFormat(ctx, tenant, integrityType)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	start, end, err := format.PeriodRange(req.From, req.To)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	limit := uint64(query.DefaultLimit)
+	if req.Pagination != nil {
+		if req.Pagination.Offset != 0 || req.Pagination.Reverse {
+			return nil, status.Error(codes.InvalidArgument, "period range pagination only supports key and limit")
+		}
+		if req.Pagination.Limit != 0 {
+			limit = req.Pagination.Limit
+		}
+		// The next key of a page is the sort key to resume from.
+		if resume := string(req.Pagination.Key); resume > start {
+			start = resume
+		}
+	}
+
+	sets, nextKey, err := q.k.listIntegritySetsByPeriodRange(ctx, tenant, integrityType, start, end, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryIntegritySetsByPeriodRangeResponse{
+		Sets:       sets,
+		Pagination: &query.PageResponse{NextKey: nextKey},
+	}, nil
+}
+
+// listIntegritySetsByPeriodRange returns up to limit set headers of a tenant and type whose period sort key
+// lies in [start, end), together with the sort key of the next set when more remain. An empty end is open.
+func (k Keeper) listIntegritySetsByPeriodRange(ctx context.Context, tenant, integrityType, start, end string, limit uint64) ([]types.IntegritySet, []byte, error) {
+	iter, err := k.IntegritySetPeriodIndex.Iterate(ctx, periodSortKeyRange{tenant: tenant, integrityType: integrityType, start: start, end: end})
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	sets := make([]types.IntegritySet, 0)
+	for ; iter.Valid(); iter.Next() {
+		entry, err := iter.KeyValue()
+		if err != nil {
+			return nil, nil, err
+		}
+		if uint64(len(sets)) == limit {
+			return sets, []byte(entry.Key.K3()), nil
+		}
+		set, err := k.GetIntegritySet(ctx, tenant, integrityType, entry.Value)
+		if err != nil {
+			return nil, nil, err
+		}
+		sets = append(sets, set)
+	}
+
+	return sets, nil, nil
+}
+
+// periodSortKeyRange ranges over the period sort keys of one tenant and type from start, inclusive, to end,
+// exclusive. An empty end runs to the last key of the type.
+type periodSortKeyRange struct {
+	tenant        string
+	integrityType string
+	start         string
+	end           string
+}
+
+func (r periodSortKeyRange) RangeValues() (start, end *collections.RangeKey[collections.Triple[string, string, string]], order collections.Order, err error) {
+	start = collections.RangeKeyExact(collections.Join3(r.tenant, r.integrityType, r.start))
+	if r.end == "" {
+		end = collections.RangeKeyPrefixEnd(collections.TripleSuperPrefix[string, string, string](r.tenant, r.integrityType))
+	} else {
+		end = collections.RangeKeyExact(collections.Join3(r.tenant, r.integrityType, r.end))
+	}
+	return start, end, collections.OrderAscending, nil
+}
@@ -0,0 +1,63 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (q queryServer) TenantProposal(ctx context.Context, req *types.QueryTenantProposalRequest) (*types.QueryTenantProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	proposal, err := q.k.GetTenantProposal(ctx, tenant, req.ProposalId)
+	if err != nil {
+		if errors.Is(err, types.ErrTenantProposalNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTenantProposalResponse{Proposal: proposal}, nil
+}
+
+func (q queryServer) TenantProposals(ctx context.Context, req *types.QueryTenantProposalsRequest) (*types.QueryTenantProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.TenantProposals,
+		req.Pagination,
+		func(_ collections.Pair[string, uint64], proposal types.TenantProposal) (types.TenantProposal, error) {
+			return proposal, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](tenant),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTenantProposalsResponse{
+		Proposals:  proposals,
+		Pagination: pageRes,
+	}, nil
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (q queryServer) TenantWriter(ctx context.Context, req *types.QueryTenantWriterRequest) (*types.QueryTenantWriterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	writer, err := q.k.validatedAddress(req.Writer, "writer")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	grant, err := q.k.GetTenantWriter(ctx, tenant, writer)
+	if err != nil {
+		if errors.Is(err, types.ErrTenantWriterNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTenantWriterResponse{Writer: grant}, nil
+}
+
+func (q queryServer) TenantWriters(ctx context.Context, req *types.QueryTenantWritersRequest) (*types.QueryTenantWritersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenant, err := types.NormalizeTenant(req.Tenant)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	grants, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.TenantWriters,
+		req.Pagination,
+		func(_ collections.Pair[string, string], grant types.TenantWriter) (types.TenantWriter, error) {
+			return grant, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](tenant),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTenantWritersResponse{
+		Writers:    grants,
+		Pagination: pageRes,
+	}, nil
+}
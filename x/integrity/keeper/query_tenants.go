@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func (q queryServer) Tenants(ctx context.Context, req *types.QueryTenantsRequest) (*types.QueryTenantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tenants, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Tenants,
+		req.Pagination,
+		func(_ string, tenant types.Tenant) (types.Tenant, error) {
+			return tenant, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTenantsResponse{
+		Tenants:    tenants,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) TenantsByOwner(ctx context.Context, req *types.QueryTenantsByOwnerRequest) (*types.QueryTenantsByOwnerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	owner, err := q.k.validatedAddress(req.Owner, "owner")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tenants, pageRes, err := q.k.paginateTenantIndex(ctx, q.k.Tenants.Indexes.Owner, owner, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTenantsByOwnerResponse{
+		Tenants:    tenants,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) PendingTenantTransfersTo(ctx context.Context, req *types.QueryPendingTenantTransfersToRequest) (*types.QueryPendingTenantTransfersToResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pendingOwner, err := q.k.validatedAddress(req.PendingOwner, "pending owner")
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	tenants, pageRes, err := q.k.paginateTenantIndex(ctx, q.k.Tenants.Indexes.PendingOwner, pendingOwner, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingTenantTransfersToResponse{
+		Tenants:    tenants,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateTenantIndex pages through the tenants referenced by one address in an owner index.
+func (k Keeper) paginateTenantIndex(
+	ctx context.Context,
+	index *indexes.Multi[string, string, types.Tenant],
+	address string,
+	pageReq *query.PageRequest,
+) ([]types.Tenant, *query.PageResponse, error) {
+	return query.CollectionPaginate(
+		ctx,
+		index,
+		pageReq,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.Tenant, error) {
+			return k.Tenants.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](address),
+	)
+}
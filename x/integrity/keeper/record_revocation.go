@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// GetRecordRevocation returns the revocation of a record, with found false when the record is not revoked.
+func (k Keeper) GetRecordRevocation(ctx context.Context, tenant, integrityType, period, tag string) (types.IntegrityRecordRevocation, bool, error) {
+	revocation, err := k.RecordRevocations.Get(ctx, collections.Join4(tenant, integrityType, period, tag))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.IntegrityRecordRevocation{}, false, nil
+		}
+		return types.IntegrityRecordRevocation{}, false, err
+	}
+
+	return revocation, true, nil
+}
+
+// ListRecordRevocations returns the tag-sorted revocations recorded against a set. Revocations are not
+// versioned, so they apply to every version of the set that contains the tag.
+func (k Keeper) ListRecordRevocations(ctx context.Context, tenant, integrityType, period string) ([]types.IntegrityRecordRevocation, error) {
+	revocations := make([]types.IntegrityRecordRevocation, 0)
+	err := k.RecordRevocations.Walk(
+		ctx,
+		collections.NewSuperPrefixedQuadRange3[string, string, string, string](tenant, integrityType, period),
+		func(_ collections.Quad[string, string, string, string], revocation types.IntegrityRecordRevocation) (bool, error) {
+			revocations = append(revocations, revocation)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return revocations, nil
+}
+
+// ListRecordRevocationsForVersion returns the revocations of the records of one set version resolved by
+// GetIntegritySetVersion. Each revoked tag is looked up in the version by key, so the records themselves
+// are never listed.
+func (k Keeper) ListRecordRevocationsForVersion(ctx context.Context, tenant, integrityType, period string, version uint64) ([]types.IntegrityRecordRevocation, error) {
+	revocations, err := k.ListRecordRevocations(ctx, tenant, integrityType, period)
+	if err != nil || len(revocations) == 0 {
+		return revocations, err
+	}
+
+	filtered := make([]types.IntegrityRecordRevocation, 0, len(revocations))
+	for _, revocation := range revocations {
+		inVersion, err := k.hasIntegrityRecordForVersion(ctx, tenant, integrityType, period, version, revocation.Tag)
+		if err != nil {
+			return nil, err
+		}
+		if inVersion {
+			filtered = append(filtered, revocation)
+		}
+	}
+
+	return filtered, nil
+}
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// chargeStorageFee collects a storage fee computed from params from payer. The fee funds the community
+// pool unless the params ask for it to be burned.
+func (k Keeper) chargeStorageFee(ctx context.Context, params types.Params, payer string, fee sdk.Coins) (sdk.Coins, error) {
+	if fee.IsZero() {
+		return fee, nil
+	}
+
+	payerAddr, err := k.addressCodec.StringToBytes(payer)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.BurnStorageFee {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payerAddr, types.ModuleName, fee); err != nil {
+			return nil, types.ErrStorageFeePayment.Wrapf("storage fee %s: %s", fee, err)
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, fee); err != nil {
+			return nil, err
+		}
+		return fee, nil
+	}
+
+	if err := k.distrKeeper.FundCommunityPool(ctx, fee, payerAddr); err != nil {
+		return nil, types.ErrStorageFeePayment.Wrapf("storage fee %s: %s", fee, err)
+	}
+	return fee, nil
+}
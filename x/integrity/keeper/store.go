@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"slices"
 
 	"cosmossdk.io/collections"
 
@@ -25,6 +26,18 @@ func (k Keeper) HasTenant(ctx context.Context, tenant string) (bool, error) {
 	return k.Tenants.Has(ctx, tenant)
 }
 
+func (k Keeper) GetTenantWriter(ctx context.Context, tenant, writer string) (types.TenantWriter, error) {
+	grant, err := k.TenantWriters.Get(ctx, collections.Join(tenant, writer))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TenantWriter{}, types.ErrTenantWriterNotFound.Wrapf("%s is not a writer of tenant %s", writer, tenant)
+		}
+		return types.TenantWriter{}, err
+	}
+
+	return grant, nil
+}
+
 func (k Keeper) GetIntegritySet(ctx context.Context, tenant, integrityType, period string) (types.IntegritySet, error) {
 	set, err := k.IntegritySets.Get(ctx, collections.Join3(tenant, integrityType, period))
 	if err != nil {
@@ -41,6 +54,88 @@ func (k Keeper) HasIntegritySet(ctx context.Context, tenant, integrityType, peri
 	return k.IntegritySets.Has(ctx, collections.Join3(tenant, integrityType, period))
 }
 
+// GetIntegritySetVersion returns the header of one version of a set. Version 0 selects the latest version.
+func (k Keeper) GetIntegritySetVersion(ctx context.Context, tenant, integrityType, period string, version uint64) (types.IntegritySet, error) {
+	latest, err := k.GetIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		return types.IntegritySet{}, err
+	}
+	if version == 0 || version == latest.Version {
+		return latest, nil
+	}
+
+	set, found, err := k.getArchivedIntegritySet(ctx, tenant, integrityType, period, version)
+	if err != nil {
+		return types.IntegritySet{}, err
+	}
+	if !found {
+		return types.IntegritySet{}, types.ErrIntegritySetNotFound.Wrapf("set %s/%s/%s version %d was not found", tenant, integrityType, period, version)
+	}
+
+	return set, nil
+}
+
+// storeIntegritySet writes a set header and its prepared records as the latest version of the set.
+func (k Keeper) storeIntegritySet(ctx context.Context, set types.IntegritySet, records []types.IntegrityRecord) error {
+	if err := k.IntegritySets.Set(ctx, collections.Join3(set.Tenant, set.Type, set.Period), set); err != nil {
+		return err
+	}
+	if err := k.indexIntegritySetPeriod(ctx, set); err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := k.IntegrityRecords.Set(ctx, collections.Join4(set.Tenant, set.Type, set.Period, record.Tag), record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeIntegritySet deletes the latest version of a set together with its records and their revocations.
+func (k Keeper) removeIntegritySet(ctx context.Context, set types.IntegritySet) error {
+	if err := k.unindexIntegritySetPeriod(ctx, set); err != nil {
+		return err
+	}
+	if err := k.IntegritySets.Remove(ctx, collections.Join3(set.Tenant, set.Type, set.Period)); err != nil {
+		return err
+	}
+	records := collections.NewSuperPrefixedQuadRange3[string, string, string, string](set.Tenant, set.Type, set.Period)
+	if err := k.IntegrityRecords.Clear(ctx, records); err != nil {
+		return err
+	}
+
+	return k.RecordRevocations.Clear(ctx, records)
+}
+
+// archiveIntegritySet moves the latest version of a set and its records into the version history.
+func (k Keeper) archiveIntegritySet(ctx context.Context, set types.IntegritySet) error {
+	records, err := k.ListIntegrityRecords(ctx, set.Tenant, set.Type, set.Period)
+	if err != nil {
+		return err
+	}
+	if err := k.storeArchivedIntegritySet(ctx, set, records); err != nil {
+		return err
+	}
+
+	return k.IntegrityRecords.Clear(ctx, collections.NewSuperPrefixedQuadRange3[string, string, string, string](set.Tenant, set.Type, set.Period))
+}
+
+// storeArchivedIntegritySet writes a superseded version header and its records into the version history.
+func (k Keeper) storeArchivedIntegritySet(ctx context.Context, set types.IntegritySet, records []types.IntegrityRecord) error {
+	versionKey := collections.Join4(set.Tenant, set.Type, set.Period, set.Version)
+	if err := k.IntegritySetVersions.Set(ctx, versionKey, set); err != nil {
+		return err
+	}
+	for _, record := range records {
+		if err := k.IntegritySetVersionRecords.Set(ctx, collections.Join(versionKey, record.Tag), record); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (k Keeper) GetIntegrityRecord(ctx context.Context, tenant, integrityType, period, tag string) (types.IntegrityRecord, error) {
 	record, err := k.IntegrityRecords.Get(ctx, collections.Join4(tenant, integrityType, period, tag))
 	if err != nil {
@@ -53,6 +148,42 @@ func (k Keeper) GetIntegrityRecord(ctx context.Context, tenant, integrityType, p
 	return record, nil
 }
 
+// GetIntegrityRecordForVersion returns one record of a set version resolved by GetIntegritySetVersion.
+func (k Keeper) GetIntegrityRecordForVersion(ctx context.Context, tenant, integrityType, period string, version uint64, tag string) (types.IntegrityRecord, error) {
+	versionKey := collections.Join4(tenant, integrityType, period, version)
+	archived, err := k.IntegritySetVersions.Has(ctx, versionKey)
+	if err != nil {
+		return types.IntegrityRecord{}, err
+	}
+	if !archived {
+		return k.GetIntegrityRecord(ctx, tenant, integrityType, period, tag)
+	}
+
+	record, err := k.IntegritySetVersionRecords.Get(ctx, collections.Join(versionKey, tag))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.IntegrityRecord{}, types.ErrIntegrityRecordNotFound.Wrapf("record %s was not found", tag)
+		}
+		return types.IntegrityRecord{}, err
+	}
+
+	return record, nil
+}
+
+// hasIntegrityRecordForVersion reports whether a set version resolved by GetIntegritySetVersion holds tag.
+func (k Keeper) hasIntegrityRecordForVersion(ctx context.Context, tenant, integrityType, period string, version uint64, tag string) (bool, error) {
+	versionKey := collections.Join4(tenant, integrityType, period, version)
+	archived, err := k.IntegritySetVersions.Has(ctx, versionKey)
+	if err != nil {
+		return false, err
+	}
+	if !archived {
+		return k.IntegrityRecords.Has(ctx, collections.Join4(tenant, integrityType, period, tag))
+	}
+
+	return k.IntegritySetVersionRecords.Has(ctx, collections.Join(versionKey, tag))
+}
+
 func (k Keeper) ListIntegrityRecords(ctx context.Context, tenant, integrityType, period string) ([]types.IntegrityRecord, error) {
 	records := make([]types.IntegrityRecord, 0)
 	err := k.IntegrityRecords.Walk(
@@ -69,3 +200,87 @@ func (k Keeper) ListIntegrityRecords(ctx context.Context, tenant, integrityType,
 
 	return records, nil
 }
+
+// ListIntegrityRecordsForVersion returns the tag-sorted records of a set version resolved by
+// GetIntegritySetVersion. Superseded versions are read from the version history.
+func (k Keeper) ListIntegrityRecordsForVersion(ctx context.Context, tenant, integrityType, period string, version uint64) ([]types.IntegrityRecord, error) {
+	versionKey := collections.Join4(tenant, integrityType, period, version)
+	archived, err := k.IntegritySetVersions.Has(ctx, versionKey)
+	if err != nil {
+		return nil, err
+	}
+	if !archived {
+		return k.ListIntegrityRecords(ctx, tenant, integrityType, period)
+	}
+
+	return k.listArchivedIntegrityRecords(ctx, versionKey)
+}
+
+// listArchivedIntegrityRecords returns the tag-sorted records of one archived set version.
+func (k Keeper) listArchivedIntegrityRecords(ctx context.Context, versionKey collections.Quad[string, string, string, uint64]) ([]types.IntegrityRecord, error) {
+	records := make([]types.IntegrityRecord, 0)
+	err := k.IntegritySetVersionRecords.Walk(
+		ctx,
+		collections.NewPrefixedPairRange[collections.Quad[string, string, string, uint64], string](versionKey),
+		func(_ collections.Pair[collections.Quad[string, string, string, uint64], string], record types.IntegrityRecord) (bool, error) {
+			records = append(records, record)
+			return false, nil
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return records, nil
+}
+
+// getArchivedIntegritySet looks up the header of a superseded set version. The latest version is never
+// archived, so found is false for it.
+func (k Keeper) getArchivedIntegritySet(ctx context.Context, tenant, integrityType, period string, version uint64) (types.IntegritySet, bool, error) {
+	set, err := k.IntegritySetVersions.Get(ctx, collections.Join4(tenant, integrityType, period, version))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.IntegritySet{}, false, nil
+		}
+		return types.IntegritySet{}, false, err
+	}
+
+	return set, true, nil
+}
+
+// GetIntegrityRecordProof builds the inclusion proof of one record of a set version under the hash scheme
+// and hash algorithm recorded on that version.
+func (k Keeper) GetIntegrityRecordProof(ctx context.Context, tenant, integrityType, period string, version uint64, tag string) (types.IntegrityRecordProof, error) {
+	set, err := k.GetIntegritySetVersion(ctx, tenant, integrityType, period, version)
+	if err != nil {
+		return types.IntegrityRecordProof{}, err
+	}
+	records, err := k.ListIntegrityRecordsForVersion(ctx, tenant, integrityType, period, set.Version)
+	if err != nil {
+		return types.IntegrityRecordProof{}, err
+	}
+
+	index := slices.IndexFunc(records, func(record types.IntegrityRecord) bool {
+		return record.Tag == tag
+	})
+	if index < 0 {
+		return types.IntegrityRecordProof{}, types.ErrIntegrityRecordNotFound.Wrapf("record %s was not found", tag)
+	}
+
+	return set.MerkleHasher().CalculateMerkleProof(records, index)
+}
+
+// GetIntegrityRecordAbsenceProof builds the proof that a tag is not part of a set version, bracketing it
+// between its tag-sorted neighbours.
+func (k Keeper) GetIntegrityRecordAbsenceProof(ctx context.Context, tenant, integrityType, period string, version uint64, tag string) (types.IntegrityRecordAbsenceProof, error) {
+	set, err := k.GetIntegritySetVersion(ctx, tenant, integrityType, period, version)
+	if err != nil {
+		return types.IntegrityRecordAbsenceProof{}, err
+	}
+	records, err := k.ListIntegrityRecordsForVersion(ctx, tenant, integrityType, period, set.Version)
+	if err != nil {
+		return types.IntegrityRecordAbsenceProof{}, err
+	}
+
+	return set.MerkleHasher().CalculateAbsenceProof(records, tag)
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/Kudora-Labs/kudora/x/integrity/types"
 )
 
-func (k msgServer) validatedAddress(address string, field string) (string, error) {
+func (k Keeper) validatedAddress(address string, field string) (string, error) {
 	normalized, err := types.NormalizeOwnerAddress(address, field)
 	if err != nil {
 		return "", err
@@ -33,6 +33,10 @@ func (k msgServer) tenantForUpdate(ctx context.Context, tenant string) (string,
 	if err != nil {
 		return "", types.Tenant{}, err
 	}
+	// Archived tenants are read-only for good.
+	if tenantRecord.Status == types.TenantStatus_TENANT_STATUS_ARCHIVED {
+		return "", types.Tenant{}, types.ErrTenantArchived.Wrapf("tenant %s is archived", normalizedTenant)
+	}
 
 	return normalizedTenant, tenantRecord, nil
 }
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// TenantPolicyAddress returns the keyless owner address of tenant once it is handed to signers.
+func (k Keeper) TenantPolicyAddress(tenant string) (string, error) {
+	return k.addressCodec.BytesToString(types.TenantPolicyAddress(tenant))
+}
+
+func (k Keeper) GetTenantProposal(ctx context.Context, tenant string, id uint64) (types.TenantProposal, error) {
+	proposal, err := k.TenantProposals.Get(ctx, collections.Join(tenant, id))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.TenantProposal{}, types.ErrTenantProposalNotFound.Wrapf("tenant %s has no open proposal %d", tenant, id)
+		}
+		return types.TenantProposal{}, err
+	}
+
+	return proposal, nil
+}
+
+// clearTenantProposals discards every open proposal of tenant.
+func (k Keeper) clearTenantProposals(ctx context.Context, tenant string) error {
+	return k.TenantProposals.Clear(ctx, collections.NewPrefixedPairRange[string, uint64](tenant))
+}
+
+// authorizeTenantSigner checks that creator is one of the signers of tenantRecord.
+func (k Keeper) authorizeTenantSigner(tenantRecord types.Tenant, creator string) error {
+	if len(tenantRecord.Signers) == 0 {
+		return types.ErrUnauthorizedTenantSigner.Wrapf("tenant %s is not owned by signers", tenantRecord.Tenant)
+	}
+	if !tenantRecord.IsSigner(creator) {
+		return types.ErrUnauthorizedTenantSigner.Wrapf("%s is not a signer of tenant %s", creator, tenantRecord.Tenant)
+	}
+	return nil
+}
+
+// executeTenantAction runs msg on behalf of the tenant owner through the regular message handlers, so that
+// an executed proposal is checked exactly like a message signed by a single owner.
+func (k msgServer) executeTenantAction(ctx context.Context, msg types.TenantActionMsg) error {
+	var err error
+	switch msg := msg.(type) {
+	case *types.MsgCommitIntegritySet:
+		_, err = k.CommitIntegritySet(ctx, msg)
+	case *types.MsgSupersedeIntegritySet:
+		_, err = k.SupersedeIntegritySet(ctx, msg)
+	case *types.MsgRevokeIntegrityRecords:
+		_, err = k.RevokeIntegrityRecords(ctx, msg)
+	case *types.MsgTransferTenantOwnership:
+		_, err = k.TransferTenantOwnership(ctx, msg)
+	case *types.MsgCancelTenantOwnershipTransfer:
+		_, err = k.CancelTenantOwnershipTransfer(ctx, msg)
+	case *types.MsgSetTenantSigners:
+		_, err = k.SetTenantSigners(ctx, msg)
+	case *types.MsgGrantTenantWriter:
+		_, err = k.GrantTenantWriter(ctx, msg)
+	case *types.MsgRevokeTenantWriter:
+		_, err = k.RevokeTenantWriter(ctx, msg)
+	case *types.MsgFreezeTenant:
+		_, err = k.FreezeTenant(ctx, msg)
+	case *types.MsgUnfreezeTenant:
+		_, err = k.UnfreezeTenant(ctx, msg)
+	case *types.MsgArchiveTenant:
+		_, err = k.ArchiveTenant(ctx, msg)
+	case *types.MsgSetPeriodFormat:
+		_, err = k.SetPeriodFormat(ctx, msg)
+	case *types.MsgRegisterIntegrityType:
+		_, err = k.RegisterIntegrityType(ctx, msg)
+	case *types.MsgCancelIntegritySet:
+		_, err = k.CancelIntegritySet(ctx, msg)
+	default:
+		err = types.ErrInvalidTenantAction.Wrapf("unsupported tenant action %T", msg)
+	}
+	return err
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// isAuthority reports whether address is the module authority.
+func (k Keeper) isAuthority(address string) bool {
+	authority, err := k.addressCodec.StringToBytes(address)
+	return err == nil && bytes.Equal(authority, k.GetAuthority())
+}
+
+// authorizeStatusChange checks that creator is the owner of tenantRecord or the module authority.
+func (k Keeper) authorizeStatusChange(tenantRecord types.Tenant, creator string) error {
+	if tenantRecord.Owner == creator || k.isAuthority(creator) {
+		return nil
+	}
+	return types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenantRecord.Tenant, tenantRecord.Owner)
+}
+
+// setTenantStatus moves a tenant to status on behalf of creator and emits eventType.
+func (k Keeper) setTenantStatus(ctx context.Context, tenantRecord types.Tenant, status types.TenantStatus, creator, eventType string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	tenantRecord.Status = status
+	tenantRecord.StatusChangedBy = creator
+	tenantRecord.StatusChangedHeight = uint64(sdkCtx.BlockHeight())
+	tenantRecord.StatusChangedTime = sdkCtx.BlockTime().UTC().Format(time.RFC3339Nano)
+	if err := k.Tenants.Set(ctx, tenantRecord.Tenant, tenantRecord); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyTenant, tenantRecord.Tenant),
+			sdk.NewAttribute(types.AttributeKeyOwner, tenantRecord.Owner),
+			sdk.NewAttribute(types.AttributeKeyCreator, creator),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// setPendingOwner offers the tenant to pendingOwner until expiresAt or expiresHeight, replacing any earlier
+// offer. The tenant record is updated in place and left for the caller to store.
+func (k Keeper) setPendingOwner(ctx context.Context, tenantRecord *types.Tenant, pendingOwner, expiresAt string, expiresHeight uint64) error {
+	if err := k.clearPendingOwner(ctx, tenantRecord); err != nil {
+		return err
+	}
+
+	tenantRecord.PendingOwner = pendingOwner
+	tenantRecord.PendingOwnerExpiresAt = expiresAt
+	tenantRecord.PendingOwnerExpiresHeight = expiresHeight
+
+	return k.enqueuePendingOwner(ctx, *tenantRecord)
+}
+
+// clearPendingOwner withdraws the pending ownership offer of the tenant together with its expiry. The tenant
+// record is updated in place and left for the caller to store.
+func (k Keeper) clearPendingOwner(ctx context.Context, tenantRecord *types.Tenant) error {
+	if err := k.dequeuePendingOwner(ctx, *tenantRecord); err != nil {
+		return err
+	}
+
+	tenantRecord.PendingOwner = ""
+	tenantRecord.PendingOwnerExpiresAt = ""
+	tenantRecord.PendingOwnerExpiresHeight = 0
+	return nil
+}
+
+// enqueuePendingOwner adds the expiry of the pending ownership offer of the tenant to the expiry queues.
+func (k Keeper) enqueuePendingOwner(ctx context.Context, tenantRecord types.Tenant) error {
+	if tenantRecord.PendingOwner == "" {
+		return nil
+	}
+	if tenantRecord.PendingOwnerExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339Nano, tenantRecord.PendingOwnerExpiresAt)
+		if err != nil {
+			return types.ErrInvalidTransferExpiry.Wrapf("tenant %s: %s", tenantRecord.Tenant, err)
+		}
+		if err := k.TransferExpiryTimeQueue.Set(ctx, collections.Join(expiresAt, tenantRecord.Tenant)); err != nil {
+			return err
+		}
+	}
+	if tenantRecord.PendingOwnerExpiresHeight != 0 {
+		if err := k.TransferExpiryHeightQueue.Set(ctx, collections.Join(tenantRecord.PendingOwnerExpiresHeight, tenantRecord.Tenant)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// dequeuePendingOwner removes the expiry of the pending ownership offer of the tenant from the expiry queues.
+func (k Keeper) dequeuePendingOwner(ctx context.Context, tenantRecord types.Tenant) error {
+	if tenantRecord.PendingOwnerExpiresAt != "" {
+		expiresAt, err := time.Parse(time.RFC3339Nano, tenantRecord.PendingOwnerExpiresAt)
+		if err == nil {
+			if err := k.TransferExpiryTimeQueue.Remove(ctx, collections.Join(expiresAt, tenantRecord.Tenant)); err != nil {
+				return err
+			}
+		}
+	}
+	if tenantRecord.PendingOwnerExpiresHeight != 0 {
+		if err := k.TransferExpiryHeightQueue.Remove(ctx, collections.Join(tenantRecord.PendingOwnerExpiresHeight, tenantRecord.Tenant)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ExpireTenantTransfers withdraws every pending ownership offer that has lapsed at the current block time
+// or height. It runs at the end of every block.
+func (k Keeper) ExpireTenantTransfers(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	blockHeight := uint64(sdkCtx.BlockHeight())
+
+	// Queue entries are collected first since expiring an offer removes entries from both queues.
+	var (
+		tenants    []string
+		seen       = make(map[string]bool)
+		timeKeys   []collections.Pair[time.Time, string]
+		heightKeys []collections.Pair[uint64, string]
+	)
+	collect := func(tenant string) {
+		if !seen[tenant] {
+			seen[tenant] = true
+			tenants = append(tenants, tenant)
+		}
+	}
+	if err := k.TransferExpiryTimeQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, string]) (bool, error) {
+		if key.K1().After(blockTime) {
+			return true, nil
+		}
+		timeKeys = append(timeKeys, key)
+		collect(key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	if err := k.TransferExpiryHeightQueue.Walk(ctx, nil, func(key collections.Pair[uint64, string]) (bool, error) {
+		if key.K1() > blockHeight {
+			return true, nil
+		}
+		heightKeys = append(heightKeys, key)
+		collect(key.K2())
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	// Entries that no longer match the offer of their tenant are dropped along with the lapsed ones.
+	for _, key := range timeKeys {
+		if err := k.TransferExpiryTimeQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+	for _, key := range heightKeys {
+		if err := k.TransferExpiryHeightQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	for _, tenant := range tenants {
+		tenantRecord, err := k.GetTenant(ctx, tenant)
+		if err != nil {
+			return err
+		}
+		if tenantRecord.PendingOwner == "" || !tenantRecord.PendingOwnerExpired(blockTime, blockHeight) {
+			continue
+		}
+
+		pendingOwner := tenantRecord.PendingOwner
+		expiresAt := tenantRecord.PendingOwnerExpiresAt
+		expiresHeight := tenantRecord.PendingOwnerExpiresHeight
+		if err := k.clearPendingOwner(ctx, &tenantRecord); err != nil {
+			return err
+		}
+		if err := k.Tenants.Set(ctx, tenant, tenantRecord); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeTenantOwnershipTransferExpired,
+				sdk.NewAttribute(types.AttributeKeyTenant, tenant),
+				sdk.NewAttribute(types.AttributeKeyOwner, tenantRecord.Owner),
+				sdk.NewAttribute(types.AttributeKeyPendingOwner, pendingOwner),
+				sdk.NewAttribute(types.AttributeKeyExpiresAt, expiresAt),
+				sdk.NewAttribute(types.AttributeKeyExpiresHeight, strconv.FormatUint(expiresHeight, 10)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// authorizeCommit checks that creator may commit a set of integrityType for tenantRecord, either as
+// the tenant owner or through an unexpired writer grant whose type prefixes cover the type. Only active
+// tenants accept set writes.
+func (k Keeper) authorizeCommit(ctx context.Context, tenantRecord types.Tenant, creator, integrityType string) error {
+	if err := tenantRecord.CheckWritable(); err != nil {
+		return err
+	}
+	if tenantRecord.Owner == creator {
+		return nil
+	}
+
+	grant, err := k.GetTenantWriter(ctx, tenantRecord.Tenant, creator)
+	if err != nil {
+		if errors.Is(err, types.ErrTenantWriterNotFound) {
+			return types.ErrUnauthorizedTenantOwner.Wrapf("tenant %s is owned by %s", tenantRecord.Tenant, tenantRecord.Owner)
+		}
+		return err
+	}
+	if grant.IsExpired(sdk.UnwrapSDKContext(ctx).BlockTime()) {
+		return types.ErrUnauthorizedTenantWriter.Wrapf("writer grant of %s on tenant %s expired at %s", creator, tenantRecord.Tenant, grant.ExpiresAt)
+	}
+	if !grant.AllowsType(integrityType) {
+		return types.ErrUnauthorizedTenantWriter.Wrapf("writer grant of %s on tenant %s does not cover type %s", creator, tenantRecord.Tenant, integrityType)
+	}
+
+	return nil
+}
+
+// clearTenantWriters removes every writer grant of tenant.
+func (k Keeper) clearTenantWriters(ctx context.Context, tenant string) error {
+	return k.TenantWriters.Clear(ctx, collections.NewPrefixedPairRange[string, string](tenant))
+}
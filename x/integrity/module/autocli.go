@@ -35,6 +35,113 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query an integrity record",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}, {ProtoField: "tag"}},
 				},
+				{
+					RpcMethod:      "IntegrityRecordProof",
+					Use:            "record-proof [tenant] [type] [period] [tag]",
+					Short:          "Query an integrity record with its Merkle inclusion proof",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}, {ProtoField: "tag"}},
+				},
+				{
+					RpcMethod:      "IntegrityRecordAbsenceProof",
+					Use:            "absence-proof [tenant] [type] [period] [tag]",
+					Short:          "Prove that a tag is not part of an integrity set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}, {ProtoField: "tag"}},
+				},
+				{
+					RpcMethod:      "IntegritySetsByTenant",
+					Use:            "sets [tenant]",
+					Short:          "List the integrity sets of a tenant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}},
+				},
+				{
+					RpcMethod:      "IntegritySetsByTenantAndType",
+					Use:            "sets-by-type [tenant] [type]",
+					Short:          "List the integrity sets of a tenant for one type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}},
+				},
+				{
+					RpcMethod:      "IntegrityRecords",
+					Use:            "records [tenant] [type] [period]",
+					Short:          "Page through the records of an integrity set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}},
+				},
+				{
+					RpcMethod: "Tenants",
+					Use:       "tenants",
+					Short:     "List all registered tenants",
+				},
+				{
+					RpcMethod:      "TenantsByOwner",
+					Use:            "tenants-by-owner [owner]",
+					Short:          "List the tenants owned by an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "owner"}},
+				},
+				{
+					RpcMethod:      "PendingTenantTransfersTo",
+					Use:            "pending-tenant-transfers [pending-owner]",
+					Short:          "List the tenant ownership transfers pending for an address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "pending_owner"}},
+				},
+				{
+					RpcMethod:      "TenantWriter",
+					Use:            "tenant-writer [tenant] [writer]",
+					Short:          "Query the writer grant of an address on a tenant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "writer"}},
+				},
+				{
+					RpcMethod:      "TenantWriters",
+					Use:            "tenant-writers [tenant]",
+					Short:          "List the writer grants of a tenant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}},
+				},
+				{
+					RpcMethod:      "ChainHead",
+					Use:            "chain-head [tenant] [type]",
+					Short:          "Query the set chain head of a tenant and type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}},
+				},
+				{
+					RpcMethod:      "VerifyChain",
+					Use:            "verify-chain [tenant] [type]",
+					Short:          "Walk and check the set chain of a tenant and type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}},
+				},
+				{
+					RpcMethod:      "PeriodFormat",
+					Use:            "period-format [tenant] [type]",
+					Short:          "Query the period format declared for a tenant and type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}},
+				},
+				{
+					RpcMethod:      "IntegritySetsByPeriodRange",
+					Use:            "sets-by-period-range [tenant] [type]",
+					Short:          "List the integrity set headers of a tenant and type between two periods",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}},
+				},
+				{
+					RpcMethod:      "IntegrityType",
+					Use:            "type [tenant] [type]",
+					Short:          "Query one registered integrity type of a tenant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}},
+				},
+				{
+					RpcMethod:      "IntegrityTypes",
+					Use:            "types [tenant]",
+					Short:          "List the registered integrity types of a tenant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}},
+				},
+				{
+					RpcMethod:      "TenantProposal",
+					Use:            "tenant-proposal [tenant] [proposal-id]",
+					Short:          "Query one open proposal of a tenant owned by signers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "TenantProposals",
+					Use:            "tenant-proposals [tenant]",
+					Short:          "List the open proposals of a tenant owned by signers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}},
+				},
 			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
@@ -69,12 +176,124 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Cancel a pending tenant ownership transfer",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}},
 				},
+				{
+					RpcMethod:      "FreezeTenant",
+					Use:            "freeze-tenant [tenant]",
+					Short:          "Stop every set write of a tenant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}},
+				},
+				{
+					RpcMethod:      "UnfreezeTenant",
+					Use:            "unfreeze-tenant [tenant]",
+					Short:          "Make a frozen tenant active again",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}},
+				},
+				{
+					RpcMethod:      "ArchiveTenant",
+					Use:            "archive-tenant [tenant]",
+					Short:          "Retire a tenant for good",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}},
+				},
 				{
 					RpcMethod:      "CommitIntegritySet",
 					Use:            "commit-set [tenant] [type] [period] [root]",
 					Short:          "Commit an encrypted integrity set",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}, {ProtoField: "root"}},
 				},
+				{
+					RpcMethod: "CommitIntegritySets",
+					Use:       "commit-sets",
+					Short:     "Commit several encrypted integrity sets in one transaction",
+				},
+				{
+					RpcMethod:      "SupersedeIntegritySet",
+					Use:            "supersede-set [tenant] [type] [period] [root] [reason-hash]",
+					Short:          "Commit a corrected version of an existing integrity set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}, {ProtoField: "root"}, {ProtoField: "reason_hash"}},
+				},
+				{
+					RpcMethod:      "OpenIntegritySet",
+					Use:            "open-set [tenant] [type] [period]",
+					Short:          "Open an integrity set that accepts records in batches until it is sealed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}},
+				},
+				{
+					RpcMethod:      "AppendIntegrityRecords",
+					Use:            "append-records [tenant] [type] [period]",
+					Short:          "Append a batch of encrypted records to an open integrity set",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}},
+				},
+				{
+					RpcMethod:      "SealIntegritySet",
+					Use:            "seal-set [tenant] [type] [period] [root]",
+					Short:          "Seal an open integrity set against its expected final root",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}, {ProtoField: "root"}},
+				},
+				{
+					RpcMethod:      "CancelIntegritySet",
+					Use:            "cancel-set [tenant] [type] [period]",
+					Short:          "Discard an open integrity set and the records appended to it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}},
+				},
+				{
+					RpcMethod:      "AnchorIntegrityRoot",
+					Use:            "anchor-root [tenant] [type] [period] [root] [record-count]",
+					Short:          "Anchor the root of an integrity set whose records are kept off chain",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}, {ProtoField: "root"}, {ProtoField: "record_count"}},
+				},
+				{
+					RpcMethod:      "GrantTenantWriter",
+					Use:            "grant-tenant-writer [tenant] [writer]",
+					Short:          "Allow an address to commit integrity sets for a tenant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "writer"}},
+				},
+				{
+					RpcMethod:      "RevokeTenantWriter",
+					Use:            "revoke-tenant-writer [tenant] [writer]",
+					Short:          "Revoke a tenant writer grant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "writer"}},
+				},
+				{
+					RpcMethod:      "SetPeriodFormat",
+					Use:            "set-period-format [tenant] [type] [format]",
+					Short:          "Declare the period format of an integrity type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "format"}},
+				},
+				{
+					RpcMethod:      "RegisterIntegrityType",
+					Use:            "register-type [tenant] [type] [schema-hash]",
+					Short:          "Register or update an integrity type of a tenant",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "schema_hash"}},
+				},
+				{
+					RpcMethod:      "RevokeIntegrityRecords",
+					Use:            "revoke-records [tenant] [type] [period] [reason-code] [tag]...",
+					Short:          "Mark records of an integrity set as revoked",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "type"}, {ProtoField: "period"}, {ProtoField: "reason_code"}, {ProtoField: "tags", Varargs: true}},
+				},
+				{
+					RpcMethod:      "SetTenantSigners",
+					Use:            "set-tenant-signers [tenant] [threshold] [signer]...",
+					Short:          "Hand ownership of a tenant to signers that act through threshold-approved proposals",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "threshold"}, {ProtoField: "signers", Varargs: true}},
+				},
+				{
+					RpcMethod: "ProposeTenantAction",
+					Use:       "propose-tenant-action [tenant] [action-file]",
+					Short:     "Propose an owner action for a tenant owned by signers",
+				},
+				{
+					RpcMethod:      "ApproveTenantAction",
+					Use:            "approve-tenant-action [tenant] [proposal-id]",
+					Short:          "Approve an open proposal of a tenant owned by signers",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "proposal_id"}},
+				},
+				{
+					RpcMethod:      "ExecuteTenantAction",
+					Use:            "execute-tenant-action [tenant] [proposal-id]",
+					Short:          "Execute a proposal of a tenant owned by signers once it reached its threshold",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "tenant"}, {ProtoField: "proposal_id"}},
+				},
 			},
 		},
 	}
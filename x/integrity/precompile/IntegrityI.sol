@@ -0,0 +1,136 @@
+// SPDX-License-Identifier: MIT
+pragma solidity >=0.8.17;
+
+/// @dev The IntegrityI contract's address.
+address constant INTEGRITY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000900;
+
+/// @dev The IntegrityI contract's instance.
+IntegrityI constant INTEGRITY_CONTRACT = IntegrityI(INTEGRITY_PRECOMPILE_ADDRESS);
+
+/// @dev A record of an integrity set. Nonce and ciphertext are the encrypted payload as committed.
+struct Record {
+    bytes32 tag;
+    bytes nonce;
+    bytes ciphertext;
+}
+
+/// @title Integrity Precompiled Contract
+/// @dev The interface through which solidity contracts register tenants, commit x/integrity sets, read
+/// committed sets and verify record inclusion proofs against them. Writes act as msg.sender, mapped to the
+/// Cosmos account with the same address bytes. Every read uses the latest version of a set.
+/// @custom:address 0x0000000000000000000000000000000000000900
+interface IntegrityI {
+    /// @dev Emitted when a tenant is registered through the precompile.
+    /// @param owner The caller that owns the new tenant.
+    /// @param tenant The normalized tenant name.
+    event TenantRegistered(address indexed owner, string tenant);
+
+    /// @dev Emitted when a set is committed through the precompile.
+    /// @param creator The caller that committed the set.
+    /// @param tenant The tenant that owns the set.
+    /// @param integrityType The integrity type of the set.
+    /// @param period The period of the set.
+    /// @param root The committed Merkle root.
+    event IntegritySetCommitted(
+        address indexed creator,
+        string tenant,
+        string integrityType,
+        string period,
+        bytes32 root
+    );
+
+    /// @dev Registers a tenant owned by the caller.
+    /// @param tenant The tenant name.
+    /// @return success Whether the tenant was registered.
+    function registerTenant(string memory tenant) external returns (bool success);
+
+    /// @dev Commits a set on behalf of the caller, who must own the tenant or be one of its writers.
+    /// Storage fees are charged to the caller.
+    /// @param tenant The tenant that owns the set.
+    /// @param integrityType The integrity type of the set.
+    /// @param period The period of the set.
+    /// @param root The Merkle root of the records.
+    /// @param records The records of the set.
+    /// @param hashScheme The hash scheme of the root: 0 for v1, 1 for v2.
+    /// @param hashAlgorithm The hash algorithm of the root: 0 for sha256, 1 for keccak256, 2 for blake3.
+    /// @return success Whether the set was committed.
+    function commitIntegritySet(
+        string memory tenant,
+        string memory integrityType,
+        string memory period,
+        bytes32 root,
+        Record[] memory records,
+        uint8 hashScheme,
+        uint8 hashAlgorithm
+    ) external returns (bool success);
+
+    /// @dev Returns the header of the latest version of a set.
+    /// @param tenant The tenant that owns the set.
+    /// @param integrityType The integrity type of the set.
+    /// @param period The period of the set, in the period format of its type.
+    /// @return found Whether the set exists. All other values are zero when it does not.
+    /// @return root The Merkle root of the set.
+    /// @return version The version of the set.
+    /// @return recordCount The number of records committed under the root.
+    /// @return blockHeight The block height of the commit.
+    /// @return open Whether the set still accepts appended records.
+    /// @return recordsOnChain Whether the records are stored on chain or only the root is.
+    function getSet(
+        string memory tenant,
+        string memory integrityType,
+        string memory period
+    )
+        external
+        view
+        returns (
+            bool found,
+            bytes32 root,
+            uint64 version,
+            uint64 recordCount,
+            uint64 blockHeight,
+            bool open,
+            bool recordsOnChain
+        );
+
+    /// @dev Reports whether a record tag belongs to the latest version of a set. Reverts for
+    /// root-only sets, whose records are not on chain; use verifyProof for those.
+    /// @param tenant The tenant that owns the set.
+    /// @param integrityType The integrity type of the set.
+    /// @param period The period of the set.
+    /// @param tag The record tag.
+    /// @return found Whether the set exists and contains the tag.
+    /// @return revoked Whether the tag carries a revocation marker.
+    function hasRecord(
+        string memory tenant,
+        string memory integrityType,
+        string memory period,
+        bytes32 tag
+    ) external view returns (bool found, bool revoked);
+
+    /// @dev Verifies that a record is included under the root of the latest version of a set. The
+    /// proof is checked with the hash scheme and hash algorithm recorded on the set.
+    /// @param tenant The tenant that owns the set.
+    /// @param integrityType The integrity type of the set.
+    /// @param period The period of the set.
+    /// @param tag The record tag.
+    /// @param nonce The record nonce.
+    /// @param ciphertext The record ciphertext.
+    /// @param leafIndex The position of the record among the tag-sorted leaves.
+    /// @param leafCount The number of leaves of the set.
+    /// @param siblings The sibling hashes from the leaf up to the root.
+    /// @param siblingOnLeft For every sibling, whether it sits left of the running hash.
+    /// @return valid Whether the set exists and the proof resolves to its root.
+    /// @return revoked Whether the tag carries a revocation marker.
+    function verifyProof(
+        string memory tenant,
+        string memory integrityType,
+        string memory period,
+        bytes32 tag,
+        bytes memory nonce,
+        bytes memory ciphertext,
+        uint64 leafIndex,
+        uint64 leafCount,
+        bytes32[] memory siblings,
+        bool[] memory siblingOnLeft
+    ) external view returns (bool valid, bool revoked);
+}
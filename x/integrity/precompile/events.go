@@ -0,0 +1,77 @@
+package precompile
+
+import (
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+const (
+	// EventTypeTenantRegistered defines the event type for tenant registration.
+	EventTypeTenantRegistered = "TenantRegistered"
+	// EventTypeIntegritySetCommitted defines the event type for set commits.
+	EventTypeIntegritySetCommitted = "IntegritySetCommitted"
+)
+
+// EmitTenantRegisteredEvent emits the TenantRegistered event.
+func (p Precompile) EmitTenantRegisteredEvent(ctx sdk.Context, stateDB vm.StateDB, owner common.Address, tenant string) error {
+	event := p.Events[EventTypeTenantRegistered]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(owner)
+	if err != nil {
+		return err
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(tenant)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
+
+// EmitIntegritySetCommittedEvent emits the IntegritySetCommitted event with the normalized key of the set.
+func (p Precompile) EmitIntegritySetCommittedEvent(ctx sdk.Context, stateDB vm.StateDB, creator common.Address, integritySet types.IntegritySet, root [32]byte) error {
+	event := p.Events[EventTypeIntegritySetCommitted]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(creator)
+	if err != nil {
+		return err
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(integritySet.Tenant, integritySet.Type, integritySet.Period, root)
+	if err != nil {
+		return err
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115
+	})
+
+	return nil
+}
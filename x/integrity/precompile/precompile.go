@@ -0,0 +1,162 @@
+package precompile
+
+import (
+	"bytes"
+	"fmt"
+
+	_ "embed"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/keeper"
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// PrecompileAddress is the fixed address of the integrity precompile. It is registered with the EVM keeper
+// but stays inactive until governance adds it to the active static precompiles of the EVM params.
+const PrecompileAddress = "0x0000000000000000000000000000000000000900"
+
+// Base gas of every method, charged on top of the flat read or write cost and the per-byte input cost. Store
+// access made while serving a call is metered at the SDK KV gas rates.
+const (
+	GetSetGas             uint64 = 1_000
+	HasRecordGas          uint64 = 2_000
+	VerifyProofGas        uint64 = 3_000
+	RegisterTenantGas     uint64 = 10_000
+	CommitIntegritySetGas uint64 = 20_000
+
+	// ProofSiblingGas is charged for every sibling hashed by verifyProof.
+	ProofSiblingGas uint64 = 300
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+var (
+	// Embed abi json file to the executable binary. Needed when importing as dependency.
+	//
+	//go:embed abi.json
+	f   []byte
+	ABI abi.ABI
+)
+
+func init() {
+	var err error
+	ABI, err = abi.JSON(bytes.NewReader(f))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// Precompile exposes x/integrity to EVM contracts: tenant registration and set commits on behalf of the
+// caller, reads of committed sets and record proof verification.
+type Precompile struct {
+	cmn.Precompile
+
+	abi.ABI
+	keeper    keeper.Keeper
+	msgServer types.MsgServer
+}
+
+// NewPrecompile creates the integrity precompile on top of the given keeper. Writes go through the module
+// msg server; the bank keeper keeps EVM balances in sync with the storage fees they charge.
+func NewPrecompile(k keeper.Keeper, bankKeeper cmn.BankKeeper) *Precompile {
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			KvGasConfig:           storetypes.KVGasConfig(),
+			TransientKVGasConfig:  storetypes.TransientGasConfig(),
+			ContractAddress:       common.HexToAddress(PrecompileAddress),
+			BalanceHandlerFactory: cmn.NewBalanceHandlerFactory(bankKeeper),
+		},
+		ABI:       ABI,
+		keeper:    k,
+		msgServer: keeper.NewMsgServerImpl(k),
+	}
+}
+
+func (Precompile) Name() string {
+	return "integrity"
+}
+
+// RequiredGas returns the base gas of the called method plus the flat read or write cost and the per-byte
+// cost of the input.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	// NOTE: This check avoid panicking when trying to decode the method ID
+	if len(input) < 4 {
+		return 0
+	}
+
+	method, err := p.MethodById(input[:4])
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return methodGas(method.Name) + p.Precompile.RequiredGas(input, p.IsTransaction(method))
+}
+
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	return p.RunNativeAction(evm, contract, func(ctx sdk.Context) ([]byte, error) {
+		return p.Execute(ctx, evm.StateDB, contract, readonly)
+	})
+}
+
+func (p Precompile) Execute(ctx sdk.Context, stateDB vm.StateDB, contract *vm.Contract, readOnly bool) ([]byte, error) {
+	method, args, err := cmn.SetupABI(p.ABI, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	switch method.Name {
+	// integrity transactions
+	case RegisterTenantMethod:
+		return p.RegisterTenant(ctx, stateDB, contract, method, args)
+	case CommitIntegritySetMethod:
+		return p.CommitIntegritySet(ctx, stateDB, contract, method, args)
+	// integrity queries
+	case GetSetMethod:
+		return p.GetSet(ctx, method, args)
+	case HasRecordMethod:
+		return p.HasRecord(ctx, method, args)
+	case VerifyProofMethod:
+		return p.VerifyProof(ctx, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+}
+
+// IsTransaction reports whether the method writes state.
+//
+// Available integrity transactions are:
+// - RegisterTenant
+// - CommitIntegritySet
+func (Precompile) IsTransaction(method *abi.Method) bool {
+	switch method.Name {
+	case RegisterTenantMethod, CommitIntegritySetMethod:
+		return true
+	default:
+		return false
+	}
+}
+
+func methodGas(method string) uint64 {
+	switch method {
+	case GetSetMethod:
+		return GetSetGas
+	case HasRecordMethod:
+		return HasRecordGas
+	case VerifyProofMethod:
+		return VerifyProofGas
+	case RegisterTenantMethod:
+		return RegisterTenantGas
+	case CommitIntegritySetMethod:
+		return CommitIntegritySetGas
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,322 @@
+package precompile_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Kudora-Labs/kudora/testutil/integritymock"
+	"github.com/Kudora-Labs/kudora/x/integrity/keeper"
+	module "github.com/Kudora-Labs/kudora/x/integrity/module"
+	"github.com/Kudora-Labs/kudora/x/integrity/precompile"
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+const (
+	testTenant = "acme"
+	testType   = "acme.daily.v1"
+	testPeriod = "2026-06-25"
+)
+
+// noopBankKeeper and noopDistributionKeeper satisfy the keeper under the default zero storage fees.
+type noopBankKeeper struct{}
+
+func (noopBankKeeper) SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return nil
+}
+
+func (noopBankKeeper) BurnCoins(context.Context, string, sdk.Coins) error {
+	return nil
+}
+
+type noopDistributionKeeper struct{}
+
+func (noopDistributionKeeper) FundCommunityPool(context.Context, sdk.Coins, sdk.AccAddress) error {
+	return nil
+}
+
+// setupPrecompile commits a two-record set for acme and returns the precompile reading it.
+func setupPrecompile(t *testing.T) (sdk.Context, keeper.Keeper, *precompile.Precompile, integritymock.MockSet) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		noopBankKeeper{},
+		noopDistributionKeeper{},
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: testTenant})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(3, testTenant, testType, testPeriod)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(ctx, &types.MsgCommitIntegritySet{
+		Creator: owner,
+		Tenant:  testTenant,
+		Type:    testType,
+		Period:  testPeriod,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.RevokeIntegrityRecords(ctx, &types.MsgRevokeIntegrityRecords{
+		Creator:    owner,
+		Tenant:     testTenant,
+		Type:       testType,
+		Period:     testPeriod,
+		Tags:       []string{mockSet.SortedTags[2]},
+		ReasonCode: 1,
+	})
+	require.NoError(t, err)
+
+	return ctx, k, precompile.NewPrecompile(k, nil), mockSet
+}
+
+// call packs args for the method, runs it and unpacks its outputs.
+func call(t *testing.T, ctx sdk.Context, p *precompile.Precompile, name string, args ...interface{}) ([]interface{}, error) {
+	t.Helper()
+
+	method := p.Methods[name]
+	input, err := method.Inputs.Pack(args...)
+	require.NoError(t, err)
+	unpacked, err := method.Inputs.Unpack(input)
+	require.NoError(t, err)
+
+	var bz []byte
+	switch name {
+	case precompile.GetSetMethod:
+		bz, err = p.GetSet(ctx, &method, unpacked)
+	case precompile.HasRecordMethod:
+		bz, err = p.HasRecord(ctx, &method, unpacked)
+	case precompile.VerifyProofMethod:
+		bz, err = p.VerifyProof(ctx, &method, unpacked)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	out, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	return out, nil
+}
+
+func TestPrecompileGetSet(t *testing.T) {
+	ctx, _, p, mockSet := setupPrecompile(t)
+
+	out, err := call(t, ctx, p, precompile.GetSetMethod, testTenant, testType, testPeriod)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true, [32]byte(common.HexToHash(mockSet.Root)), uint64(1), uint64(3), uint64(ctx.BlockHeight()), false, true}, out)
+
+	out, err = call(t, ctx, p, precompile.GetSetMethod, testTenant, testType, "2026-06-26")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{false, [32]byte{}, uint64(0), uint64(0), uint64(0), false, false}, out)
+
+	_, err = call(t, ctx, p, precompile.GetSetMethod, "", testType, testPeriod)
+	require.ErrorIs(t, err, types.ErrInvalidTenant)
+}
+
+func TestPrecompileHasRecord(t *testing.T) {
+	ctx, _, p, mockSet := setupPrecompile(t)
+
+	out, err := call(t, ctx, p, precompile.HasRecordMethod, testTenant, testType, testPeriod, [32]byte(common.HexToHash(mockSet.SortedTags[0])))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true, false}, out)
+
+	out, err = call(t, ctx, p, precompile.HasRecordMethod, testTenant, testType, testPeriod, [32]byte(common.HexToHash(mockSet.SortedTags[2])))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true, true}, out)
+
+	out, err = call(t, ctx, p, precompile.HasRecordMethod, testTenant, testType, testPeriod, [32]byte{0x01})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{false, false}, out)
+
+	out, err = call(t, ctx, p, precompile.HasRecordMethod, testTenant, testType, "2026-06-26", [32]byte(common.HexToHash(mockSet.SortedTags[0])))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{false, false}, out)
+}
+
+func TestPrecompileVerifyProof(t *testing.T) {
+	ctx, k, p, mockSet := setupPrecompile(t)
+
+	proofArgs := func(index int) []interface{} {
+		record := mockSet.SortedRecords[index]
+		proof, err := k.GetIntegrityRecordProof(ctx, testTenant, testType, testPeriod, 0, record.Tag)
+		require.NoError(t, err)
+
+		siblings := make([][32]byte, len(proof.Siblings))
+		for i, sibling := range proof.Siblings {
+			siblings[i] = common.HexToHash(sibling)
+		}
+		return []interface{}{
+			testTenant, testType, testPeriod,
+			[32]byte(common.HexToHash(record.Tag)),
+			common.FromHex(record.Nonce),
+			common.FromHex(record.Ciphertext),
+			proof.LeafIndex, proof.LeafCount, siblings, proof.SiblingOnLeft,
+		}
+	}
+
+	out, err := call(t, ctx, p, precompile.VerifyProofMethod, proofArgs(0)...)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true, false}, out)
+
+	out, err = call(t, ctx, p, precompile.VerifyProofMethod, proofArgs(2)...)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true, true}, out)
+
+	tampered := proofArgs(1)
+	tampered[5] = append([]byte{0xff}, tampered[5].([]byte)...)
+	out, err = call(t, ctx, p, precompile.VerifyProofMethod, tampered...)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{false, false}, out)
+
+	misplaced := proofArgs(1)
+	misplaced[6] = uint64(0)
+	out, err = call(t, ctx, p, precompile.VerifyProofMethod, misplaced...)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{false, false}, out)
+
+	missing := proofArgs(0)
+	missing[2] = "2026-06-26"
+	out, err = call(t, ctx, p, precompile.VerifyProofMethod, missing...)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{false, false}, out)
+
+	empty := proofArgs(0)
+	empty[4] = []byte{}
+	_, err = call(t, ctx, p, precompile.VerifyProofMethod, empty...)
+	require.ErrorIs(t, err, types.ErrInvalidRecord)
+
+	consumed := ctx.GasMeter().GasConsumed()
+	_, err = call(t, ctx, p, precompile.VerifyProofMethod, proofArgs(0)...)
+	require.NoError(t, err)
+	require.Greater(t, ctx.GasMeter().GasConsumed()-consumed, precompile.ProofSiblingGas*2)
+}
+
+func TestPrecompileRequiredGas(t *testing.T) {
+	p := precompile.NewPrecompile(keeper.Keeper{}, nil)
+	kvGas := storetypes.KVGasConfig()
+
+	input, err := p.Pack(precompile.GetSetMethod, testTenant, testType, testPeriod)
+	require.NoError(t, err)
+	require.Equal(t, precompile.GetSetGas+kvGas.ReadCostFlat+kvGas.ReadCostPerByte*uint64(len(input)), p.RequiredGas(input))
+
+	input, err = p.Pack(precompile.HasRecordMethod, testTenant, testType, testPeriod, [32]byte{})
+	require.NoError(t, err)
+	require.Equal(t, precompile.HasRecordGas+kvGas.ReadCostFlat+kvGas.ReadCostPerByte*uint64(len(input)), p.RequiredGas(input))
+
+	input, err = p.Pack(precompile.RegisterTenantMethod, testTenant)
+	require.NoError(t, err)
+	require.Equal(t, precompile.RegisterTenantGas+kvGas.WriteCostFlat+kvGas.WriteCostPerByte*uint64(len(input)), p.RequiredGas(input))
+
+	require.Zero(t, p.RequiredGas([]byte{0x01, 0x02}))
+	require.Zero(t, p.RequiredGas([]byte{0x01, 0x02, 0x03, 0x04}))
+	require.Equal(t, common.HexToAddress(precompile.PrecompileAddress), p.Address())
+}
+
+// logStateDB records the logs added by the precompile. Every other StateDB method is left unimplemented.
+type logStateDB struct {
+	vm.StateDB
+	logs []*ethtypes.Log
+}
+
+func (s *logStateDB) AddLog(log *ethtypes.Log) {
+	s.logs = append(s.logs, log)
+}
+
+// execute runs a packed call from caller through the ABI dispatch of the precompile.
+func execute(t *testing.T, ctx sdk.Context, p *precompile.Precompile, stateDB vm.StateDB, caller common.Address, readOnly bool, name string, args ...interface{}) ([]byte, error) {
+	t.Helper()
+
+	input, err := p.Pack(name, args...)
+	require.NoError(t, err)
+	contract := vm.NewContract(caller, p.Address(), nil, 1_000_000, nil)
+	contract.Input = input
+	return p.Execute(ctx, stateDB, contract, readOnly)
+}
+
+func TestPrecompileRegisterTenantAndCommitAsCaller(t *testing.T) {
+	ctx, k, p, _ := setupPrecompile(t)
+	stateDB := &logStateDB{}
+	dao := common.HexToAddress("0x00000000000000000000000000000000000da0da")
+
+	_, err := execute(t, ctx, p, stateDB, dao, true, precompile.RegisterTenantMethod, "DAO")
+	require.ErrorIs(t, err, vm.ErrWriteProtection)
+
+	bz, err := execute(t, ctx, p, stateDB, dao, false, precompile.RegisterTenantMethod, "DAO")
+	require.NoError(t, err)
+	out, err := p.Unpack(precompile.RegisterTenantMethod, bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, out)
+
+	tenant, err := k.GetTenant(ctx, "dao")
+	require.NoError(t, err)
+	require.Equal(t, sdk.AccAddress(dao.Bytes()).String(), tenant.Owner)
+	require.Len(t, stateDB.logs, 1)
+	require.Equal(t, p.Events[precompile.EventTypeTenantRegistered].ID, stateDB.logs[0].Topics[0])
+	require.Equal(t, common.BytesToHash(dao.Bytes()), stateDB.logs[0].Topics[1])
+
+	mockSet, err := integritymock.BuildMockSet(2, "dao", "dao.daily.v1", testPeriod)
+	require.NoError(t, err)
+	records := make([]precompile.Record, len(mockSet.Records))
+	for i, record := range mockSet.Records {
+		records[i] = precompile.Record{
+			Tag:        common.HexToHash(record.Tag),
+			Nonce:      common.FromHex(record.Nonce),
+			Ciphertext: common.FromHex(record.Ciphertext),
+		}
+	}
+	root := [32]byte(common.HexToHash(mockSet.Root))
+
+	intruder := common.HexToAddress("0x0000000000000000000000000000000000000bad")
+	_, err = execute(t, ctx, p, stateDB, intruder, false, precompile.CommitIntegritySetMethod, "dao", "dao.daily.v1", testPeriod, root, records, uint8(0), uint8(0))
+	require.ErrorIs(t, err, types.ErrUnauthorizedTenantOwner)
+
+	bz, err = execute(t, ctx, p, stateDB, dao, false, precompile.CommitIntegritySetMethod, "dao", "dao.daily.v1", testPeriod, root, records, uint8(0), uint8(0))
+	require.NoError(t, err)
+	out, err = p.Unpack(precompile.CommitIntegritySetMethod, bz)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{true}, out)
+
+	integritySet, err := k.GetIntegritySet(ctx, "dao", "dao.daily.v1", testPeriod)
+	require.NoError(t, err)
+	require.Equal(t, mockSet.Root, integritySet.Root)
+	require.Equal(t, sdk.AccAddress(dao.Bytes()).String(), integritySet.Creator)
+	require.EqualValues(t, 2, integritySet.RecordCount)
+	require.Len(t, stateDB.logs, 2)
+	require.Equal(t, p.Events[precompile.EventTypeIntegritySetCommitted].ID, stateDB.logs[1].Topics[0])
+	logData, err := p.Events[precompile.EventTypeIntegritySetCommitted].Inputs.NonIndexed().Unpack(stateDB.logs[1].Data)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"dao", "dao.daily.v1", testPeriod, root}, logData)
+
+	bz, err = execute(t, ctx, p, stateDB, dao, true, precompile.GetSetMethod, "dao", "dao.daily.v1", testPeriod)
+	require.NoError(t, err)
+	out, err = p.Unpack(precompile.GetSetMethod, bz)
+	require.NoError(t, err)
+	require.Equal(t, true, out[0])
+	require.Equal(t, root, out[1])
+}
@@ -0,0 +1,216 @@
+package precompile
+
+import (
+	"errors"
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+const (
+	// GetSetMethod defines the ABI method name for reading the header of a set.
+	GetSetMethod = "getSet"
+	// HasRecordMethod defines the ABI method name for checking that a tag belongs to a set.
+	HasRecordMethod = "hasRecord"
+	// VerifyProofMethod defines the ABI method name for verifying a record inclusion proof.
+	VerifyProofMethod = "verifyProof"
+)
+
+// GetSet returns the header of the latest version of a set. A missing set is reported through the found
+// output rather than a revert.
+func (p Precompile) GetSet(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	integritySet, found, err := p.latestSet(ctx, args[0], args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return method.Outputs.Pack(false, common.Hash{}, uint64(0), uint64(0), uint64(0), false, false)
+	}
+
+	return method.Outputs.Pack(
+		true,
+		common.HexToHash(integritySet.Root),
+		integritySet.Version,
+		integritySet.RecordCount,
+		integritySet.BlockHeight,
+		integritySet.Open,
+		integritySet.RecordsOnChain(),
+	)
+}
+
+// HasRecord reports whether a tag belongs to the latest version of a set and whether it is revoked. Root-only
+// sets revert since their records are not on chain.
+func (p Precompile) HasRecord(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+	tag, ok := args[3].([32]byte)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "tag", [32]byte{}, args[3])
+	}
+
+	integritySet, found, err := p.latestSet(ctx, args[0], args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return method.Outputs.Pack(false, false)
+	}
+	if !integritySet.RecordsOnChain() {
+		return nil, types.ErrRecordsNotOnChain.Wrapf("set %s/%s/%s is root-only", integritySet.Tenant, integritySet.Type, integritySet.Period)
+	}
+
+	tagHex := hexutil.Encode(tag[:])
+	if _, err := p.keeper.GetIntegrityRecordForVersion(ctx, integritySet.Tenant, integritySet.Type, integritySet.Period, integritySet.Version, tagHex); err != nil {
+		if errors.Is(err, types.ErrIntegrityRecordNotFound) {
+			return method.Outputs.Pack(false, false)
+		}
+		return nil, err
+	}
+	_, revoked, err := p.keeper.GetRecordRevocation(ctx, integritySet.Tenant, integritySet.Type, integritySet.Period, tagHex)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true, revoked)
+}
+
+// VerifyProof checks a record inclusion proof against the root of the latest version of a set, using the
+// hash scheme and hash algorithm recorded on that version. It serves root-only sets as well. A proof that
+// does not resolve to the root is reported through the valid output; malformed records revert.
+func (p Precompile) VerifyProof(ctx sdk.Context, method *abi.Method, args []interface{}) ([]byte, error) {
+	if len(args) != 10 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 10, len(args))
+	}
+	record, proof, err := parseProofArgs(args[3:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Hashing happens outside the store, so it is metered here rather than through the KV gas config.
+	ctx.GasMeter().ConsumeGas(ProofSiblingGas*uint64(len(proof.Siblings)), "integrity proof verification")
+
+	integritySet, found, err := p.latestSet(ctx, args[0], args[1], args[2])
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return method.Outputs.Pack(false, false)
+	}
+
+	proof.HashScheme = integritySet.HashScheme
+	proof.HashAlgorithm = integritySet.HashAlgorithm
+	valid := true
+	if err := types.VerifyRecordProof(integritySet.Root, record, proof); err != nil {
+		if !errors.Is(err, types.ErrInvalidProof) && !errors.Is(err, types.ErrRootMismatch) {
+			return nil, err
+		}
+		valid = false
+	}
+	_, revoked, err := p.keeper.GetRecordRevocation(ctx, integritySet.Tenant, integritySet.Type, integritySet.Period, record.Tag)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(valid, revoked)
+}
+
+// latestSet normalizes the tenant, type and period arguments and loads the latest version of the set.
+func (p Precompile) latestSet(ctx sdk.Context, tenantArg, typeArg, periodArg interface{}) (types.IntegritySet, bool, error) {
+	tenant, ok := tenantArg.(string)
+	if !ok {
+		return types.IntegritySet{}, false, fmt.Errorf(cmn.ErrInvalidType, "tenant", "", tenantArg)
+	}
+	integrityType, ok := typeArg.(string)
+	if !ok {
+		return types.IntegritySet{}, false, fmt.Errorf(cmn.ErrInvalidType, "integrityType", "", typeArg)
+	}
+	period, ok := periodArg.(string)
+	if !ok {
+		return types.IntegritySet{}, false, fmt.Errorf(cmn.ErrInvalidType, "period", "", periodArg)
+	}
+
+	tenant, err := types.NormalizeTenant(tenant)
+	if err != nil {
+		return types.IntegritySet{}, false, err
+	}
+	integrityType, err = types.NormalizeIntegrityType(integrityType)
+	if err != nil {
+		return types.IntegritySet{}, false, err
+	}
+	period, err = p.keeper.NormalizePeriod(ctx, tenant, integrityType, period)
+	if err != nil {
+		return types.IntegritySet{}, false, err
+	}
+
+	integritySet, err := p.keeper.GetIntegritySet(ctx, tenant, integrityType, period)
+	if err != nil {
+		if errors.Is(err, types.ErrIntegritySetNotFound) {
+			return types.IntegritySet{}, false, nil
+		}
+		return types.IntegritySet{}, false, err
+	}
+
+	return integritySet, true, nil
+}
+
+// parseProofArgs builds the record and the proof from the tag, nonce, ciphertext, leafIndex, leafCount,
+// siblings and siblingOnLeft arguments of verifyProof.
+func parseProofArgs(args []interface{}) (types.IntegrityRecord, types.IntegrityRecordProof, error) {
+	tag, ok := args[0].([32]byte)
+	if !ok {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, fmt.Errorf(cmn.ErrInvalidType, "tag", [32]byte{}, args[0])
+	}
+	nonce, ok := args[1].([]byte)
+	if !ok {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, fmt.Errorf(cmn.ErrInvalidType, "nonce", []byte{}, args[1])
+	}
+	ciphertext, ok := args[2].([]byte)
+	if !ok {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, fmt.Errorf(cmn.ErrInvalidType, "ciphertext", []byte{}, args[2])
+	}
+	leafIndex, ok := args[3].(uint64)
+	if !ok {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, fmt.Errorf(cmn.ErrInvalidType, "leafIndex", uint64(0), args[3])
+	}
+	leafCount, ok := args[4].(uint64)
+	if !ok {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, fmt.Errorf(cmn.ErrInvalidType, "leafCount", uint64(0), args[4])
+	}
+	siblings, ok := args[5].([][32]byte)
+	if !ok {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, fmt.Errorf(cmn.ErrInvalidType, "siblings", [][32]byte{}, args[5])
+	}
+	siblingOnLeft, ok := args[6].([]bool)
+	if !ok {
+		return types.IntegrityRecord{}, types.IntegrityRecordProof{}, fmt.Errorf(cmn.ErrInvalidType, "siblingOnLeft", []bool{}, args[6])
+	}
+
+	record := types.IntegrityRecord{
+		Tag:        hexutil.Encode(tag[:]),
+		Nonce:      hexutil.Encode(nonce),
+		Ciphertext: hexutil.Encode(ciphertext),
+	}
+	proof := types.IntegrityRecordProof{
+		LeafIndex:     leafIndex,
+		LeafCount:     leafCount,
+		Siblings:      make([]string, len(siblings)),
+		SiblingOnLeft: siblingOnLeft,
+	}
+	for i, sibling := range siblings {
+		proof.Siblings[i] = hexutil.Encode(sibling[:])
+	}
+
+	return record, proof, nil
+}
@@ -0,0 +1,128 @@
+package precompile
+
+import (
+	"fmt"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+const (
+	// RegisterTenantMethod defines the ABI method name for registering a tenant owned by the caller.
+	RegisterTenantMethod = "registerTenant"
+	// CommitIntegritySetMethod defines the ABI method name for committing a set on behalf of the caller.
+	CommitIntegritySetMethod = "commitIntegritySet"
+)
+
+// Record mirrors the Record struct of the ABI.
+type Record struct {
+	Tag        [32]byte
+	Nonce      []byte
+	Ciphertext []byte
+}
+
+// CommitIntegritySetArgs mirrors the inputs of commitIntegritySet.
+type CommitIntegritySetArgs struct {
+	Tenant        string
+	IntegrityType string
+	Period        string
+	Root          [32]byte
+	Records       []Record
+	HashScheme    uint8
+	HashAlgorithm uint8
+}
+
+// RegisterTenant registers a tenant owned by the caller through the module msg server.
+func (p Precompile) RegisterTenant(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+	tenant, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(cmn.ErrInvalidType, "tenant", "", args[0])
+	}
+
+	msgSender := contract.Caller()
+	msg := &types.MsgRegisterTenant{
+		Creator: sdk.AccAddress(msgSender.Bytes()).String(),
+		Tenant:  tenant,
+	}
+	if _, err := p.msgServer.RegisterTenant(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	normalizedTenant, err := types.NormalizeTenant(tenant)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.EmitTenantRegisteredEvent(ctx, stateDB, msgSender, normalizedTenant); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// CommitIntegritySet commits a set on behalf of the caller through the module msg server. The caller must own
+// the tenant or be one of its writers, and pays the storage fee.
+func (p Precompile) CommitIntegritySet(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 7 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 7, len(args))
+	}
+	var input CommitIntegritySetArgs
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to CommitIntegritySetArgs struct: %w", err)
+	}
+
+	records := make([]types.IntegrityRecord, len(input.Records))
+	for i, record := range input.Records {
+		records[i] = types.IntegrityRecord{
+			Tag:        hexutil.Encode(record.Tag[:]),
+			Nonce:      hexutil.Encode(record.Nonce),
+			Ciphertext: hexutil.Encode(record.Ciphertext),
+		}
+	}
+
+	msgSender := contract.Caller()
+	msg := &types.MsgCommitIntegritySet{
+		Creator:       sdk.AccAddress(msgSender.Bytes()).String(),
+		Tenant:        input.Tenant,
+		Type:          input.IntegrityType,
+		Period:        input.Period,
+		Root:          hexutil.Encode(input.Root[:]),
+		Records:       records,
+		HashScheme:    types.HashScheme(input.HashScheme),
+		HashAlgorithm: types.HashAlgorithm(input.HashAlgorithm),
+	}
+	if _, err := p.msgServer.CommitIntegritySet(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	// The commit succeeded, so the set now exists under its normalized key.
+	integritySet, _, err := p.latestSet(ctx, input.Tenant, input.IntegrityType, input.Period)
+	if err != nil {
+		return nil, err
+	}
+	if err := p.EmitIntegritySetCommittedEvent(ctx, stateDB, msgSender, integritySet, input.Root); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
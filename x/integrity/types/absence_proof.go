@@ -0,0 +1,124 @@
+package types
+
+import (
+	"slices"
+	"strings"
+)
+
+// CalculateAbsenceProof builds the proof that tag is not among the prepared, tag-sorted records. It fails
+// with ErrIntegrityRecordPresent when the set holds a record with that tag.
+func (h MerkleHasher) CalculateAbsenceProof(records []IntegrityRecord, tag string) (IntegrityRecordAbsenceProof, error) {
+	if err := h.Validate(); err != nil {
+		return IntegrityRecordAbsenceProof{}, err
+	}
+	normalizedTag, err := normalizeTag(tag)
+	if err != nil {
+		return IntegrityRecordAbsenceProof{}, err
+	}
+	if len(records) == 0 {
+		return IntegrityRecordAbsenceProof{}, ErrEmptyRecords
+	}
+
+	index, found := slices.BinarySearchFunc(records, normalizedTag, func(record IntegrityRecord, target string) int {
+		return strings.Compare(record.Tag, target)
+	})
+	if found {
+		return IntegrityRecordAbsenceProof{}, ErrIntegrityRecordPresent.Wrapf("record %s is part of the set", normalizedTag)
+	}
+
+	proof := IntegrityRecordAbsenceProof{Tag: normalizedTag}
+	if index > 0 {
+		leftProof, err := h.CalculateMerkleProof(records, index-1)
+		if err != nil {
+			return IntegrityRecordAbsenceProof{}, err
+		}
+		left := records[index-1]
+		proof.Left, proof.LeftProof = &left, &leftProof
+	}
+	if index < len(records) {
+		rightProof, err := h.CalculateMerkleProof(records, index)
+		if err != nil {
+			return IntegrityRecordAbsenceProof{}, err
+		}
+		right := records[index]
+		proof.Right, proof.RightProof = &right, &rightProof
+	}
+
+	return proof, nil
+}
+
+// VerifyRecordAbsenceProof checks that tag is not part of the set committed under root. Both neighbours
+// must verify as included under the same tree, bracket tag strictly in tag order and sit at adjacent leaf
+// indexes. A missing neighbour is only accepted when the other one is the first or last leaf.
+func VerifyRecordAbsenceProof(root string, tag string, proof IntegrityRecordAbsenceProof) error {
+	normalizedTag, err := normalizeTag(tag)
+	if err != nil {
+		return err
+	}
+	if proof.Tag != "" {
+		proofTag, err := normalizeTag(proof.Tag)
+		if err != nil {
+			return err
+		}
+		if proofTag != normalizedTag {
+			return ErrInvalidProof.Wrapf("proof was built for tag %s, not %s", proofTag, normalizedTag)
+		}
+	}
+
+	leftTag, left, err := verifyAbsenceNeighbour(root, proof.Left, proof.LeftProof, "left")
+	if err != nil {
+		return err
+	}
+	rightTag, right, err := verifyAbsenceNeighbour(root, proof.Right, proof.RightProof, "right")
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case left == nil && right == nil:
+		return ErrInvalidProof.Wrap("absence proof has no neighbouring record")
+	case left == nil:
+		if right.LeafIndex != 0 {
+			return ErrInvalidProof.Wrap("right neighbour must be the first leaf when there is no left neighbour")
+		}
+	case right == nil:
+		if left.LeafIndex+1 != left.LeafCount {
+			return ErrInvalidProof.Wrap("left neighbour must be the last leaf when there is no right neighbour")
+		}
+	default:
+		if left.LeafCount != right.LeafCount || left.HashScheme != right.HashScheme || left.HashAlgorithm != right.HashAlgorithm {
+			return ErrInvalidProof.Wrap("neighbour proofs were built for different trees")
+		}
+		if left.LeafIndex+1 != right.LeafIndex {
+			return ErrInvalidProof.Wrapf("neighbours at leaf indexes %d and %d are not adjacent", left.LeafIndex, right.LeafIndex)
+		}
+	}
+
+	if left != nil && leftTag >= normalizedTag {
+		return ErrInvalidProof.Wrapf("left neighbour %s does not sort before %s", leftTag, normalizedTag)
+	}
+	if right != nil && rightTag <= normalizedTag {
+		return ErrInvalidProof.Wrapf("right neighbour %s does not sort after %s", rightTag, normalizedTag)
+	}
+
+	return nil
+}
+
+// verifyAbsenceNeighbour checks the inclusion of one neighbour of an absence proof and returns its
+// normalized tag and proof. The proof is nil when the neighbour is absent.
+func verifyAbsenceNeighbour(root string, record *IntegrityRecord, proof *IntegrityRecordProof, side string) (string, *IntegrityRecordProof, error) {
+	if record == nil && proof == nil {
+		return "", nil, nil
+	}
+	if record == nil || proof == nil {
+		return "", nil, ErrInvalidProof.Wrapf("%s neighbour must carry both its record and its inclusion proof", side)
+	}
+	if err := VerifyRecordProof(root, *record, *proof); err != nil {
+		return "", nil, err
+	}
+	tag, err := normalizeTag(record.Tag)
+	if err != nil {
+		return "", nil, err
+	}
+	return tag, proof, nil
+}
@@ -0,0 +1,94 @@
+package types_test
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func TestVerifyRecordAbsenceProof(t *testing.T) {
+	// Tags 0x20.., 0x40.., 0x60.., 0x80.., 0xa0.. leave gaps on both sides of every record.
+	records := make([]types.IntegrityRecord, 0, 5)
+	for i := 0; i < 5; i++ {
+		records = append(records, types.IntegrityRecord{
+			Tag:        "0x" + strings.Repeat(hex.EncodeToString([]byte{byte(0x20 * (i + 1))}), 32),
+			Nonce:      "0x0102",
+			Ciphertext: "0x" + hex.EncodeToString([]byte{byte(i), 0xab}),
+		})
+	}
+	missingTag := func(b byte) string {
+		return "0x" + strings.Repeat(hex.EncodeToString([]byte{b}), 32)
+	}
+
+	for _, hasher := range []types.MerkleHasher{
+		{},
+		{Scheme: types.HashScheme_HASH_SCHEME_V2, Algorithm: types.HashAlgorithm_HASH_ALGORITHM_BLAKE3},
+	} {
+		prepared, _, err := types.PrepareIntegrityRecords(records)
+		require.NoError(t, err)
+		root := hasher.CalculateMerkleRoot(prepared)
+
+		for _, b := range []byte{0x10, 0x30, 0x50, 0x70, 0x90, 0xb0} {
+			proof, err := hasher.CalculateAbsenceProof(prepared, missingTag(b))
+			require.NoError(t, err)
+			require.NoError(t, types.VerifyRecordAbsenceProof(root, missingTag(b), proof))
+		}
+
+		_, err = hasher.CalculateAbsenceProof(prepared, prepared[2].Tag)
+		require.ErrorIs(t, err, types.ErrIntegrityRecordPresent)
+	}
+
+	prepared, _, err := types.PrepareIntegrityRecords(records)
+	require.NoError(t, err)
+	root := types.CalculateMerkleRootFromPreparedRecords(prepared)
+	hasher := types.MerkleHasher{}
+	inner, err := hasher.CalculateAbsenceProof(prepared, missingTag(0x50))
+	require.NoError(t, err)
+	first, err := hasher.CalculateAbsenceProof(prepared, missingTag(0x10))
+	require.NoError(t, err)
+	require.Nil(t, first.Left)
+	last, err := hasher.CalculateAbsenceProof(prepared, missingTag(0xb0))
+	require.NoError(t, err)
+	require.Nil(t, last.Right)
+
+	t.Run("rejects another tag", func(t *testing.T) {
+		require.ErrorIs(t, types.VerifyRecordAbsenceProof(root, missingTag(0x70), inner), types.ErrInvalidProof)
+		relabelled := inner
+		relabelled.Tag = ""
+		require.ErrorIs(t, types.VerifyRecordAbsenceProof(root, missingTag(0x70), relabelled), types.ErrInvalidProof)
+	})
+
+	t.Run("rejects a present tag", func(t *testing.T) {
+		relabelled := inner
+		relabelled.Tag = ""
+		require.ErrorIs(t, types.VerifyRecordAbsenceProof(root, prepared[1].Tag, relabelled), types.ErrInvalidProof)
+	})
+
+	t.Run("rejects neighbours that are not adjacent", func(t *testing.T) {
+		skipped, err := hasher.CalculateAbsenceProof(prepared, missingTag(0x70))
+		require.NoError(t, err)
+		gapped := inner
+		gapped.Right, gapped.RightProof = skipped.Right, skipped.RightProof
+		require.ErrorIs(t, types.VerifyRecordAbsenceProof(root, missingTag(0x50), gapped), types.ErrInvalidProof)
+	})
+
+	t.Run("rejects a dropped neighbour", func(t *testing.T) {
+		dropped := inner
+		dropped.Left, dropped.LeftProof = nil, nil
+		require.ErrorIs(t, types.VerifyRecordAbsenceProof(root, missingTag(0x50), dropped), types.ErrInvalidProof)
+		dropped = inner
+		dropped.Right, dropped.RightProof = nil, nil
+		require.ErrorIs(t, types.VerifyRecordAbsenceProof(root, missingTag(0x50), dropped), types.ErrInvalidProof)
+		dropped.LeftProof = nil
+		require.ErrorIs(t, types.VerifyRecordAbsenceProof(root, missingTag(0x50), dropped), types.ErrInvalidProof)
+	})
+
+	t.Run("rejects a different root", func(t *testing.T) {
+		otherRoot := types.CalculateMerkleRootFromPreparedRecords(prepared[:4])
+		require.ErrorIs(t, types.VerifyRecordAbsenceProof(otherRoot, missingTag(0x50), inner), types.ErrRootMismatch)
+	})
+}
@@ -9,6 +9,14 @@ import (
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCommitIntegritySet{},
+		&MsgCommitIntegritySets{},
+		&MsgSupersedeIntegritySet{},
+		&MsgOpenIntegritySet{},
+		&MsgAppendIntegrityRecords{},
+		&MsgSealIntegritySet{},
+		&MsgCancelIntegritySet{},
+		&MsgAnchorIntegrityRoot{},
+		&MsgRevokeIntegrityRecords{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
@@ -21,6 +29,29 @@ func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 		&MsgCancelTenantOwnershipTransfer{},
 	)
 
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgFreezeTenant{},
+		&MsgUnfreezeTenant{},
+		&MsgArchiveTenant{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetTenantSigners{},
+		&MsgProposeTenantAction{},
+		&MsgApproveTenantAction{},
+		&MsgExecuteTenantAction{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgGrantTenantWriter{},
+		&MsgRevokeTenantWriter{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgSetPeriodFormat{},
+		&MsgRegisterIntegrityType{},
+	)
+
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 	)
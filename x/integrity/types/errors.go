@@ -29,4 +29,47 @@ var (
 	ErrTenantTransferNotPending  = errors.Register(ModuleName, 1118, "tenant ownership transfer is not pending")
 	ErrUnauthorizedPendingOwner  = errors.Register(ModuleName, 1119, "creator is not the pending tenant owner")
 	ErrTenantOwnershipUnchanged  = errors.Register(ModuleName, 1120, "tenant ownership would remain unchanged")
+	ErrInvalidProof              = errors.Register(ModuleName, 1121, "invalid integrity record proof")
+	ErrStorageFeePayment         = errors.Register(ModuleName, 1122, "failed to pay integrity storage fee")
+	ErrInvalidTenantWriter       = errors.Register(ModuleName, 1123, "invalid tenant writer grant")
+	ErrTenantWriterNotFound      = errors.Register(ModuleName, 1124, "tenant writer not found")
+	ErrUnauthorizedTenantWriter  = errors.Register(ModuleName, 1125, "tenant writer grant does not allow this commit")
+	ErrInvalidReasonHash         = errors.Register(ModuleName, 1126, "invalid supersede reason hash")
+	ErrIntegritySetUnchanged     = errors.Register(ModuleName, 1127, "superseding set root matches the current version")
+	ErrInvalidSetVersion         = errors.Register(ModuleName, 1128, "invalid integrity set version")
+	ErrIntegritySetOpen          = errors.Register(ModuleName, 1129, "integrity set is still open")
+	ErrIntegritySetSealed        = errors.Register(ModuleName, 1130, "integrity set is sealed")
+	ErrEmptyIntegritySetBatch    = errors.Register(ModuleName, 1131, "batch must contain at least one integrity set")
+	ErrDuplicateBatchSet         = errors.Register(ModuleName, 1132, "integrity set is listed more than once in the batch")
+	ErrBatchCiphertextTooLarge   = errors.Register(ModuleName, 1133, "batch ciphertext exceeds the configured limit")
+	ErrInvalidStorageReference   = errors.Register(ModuleName, 1134, "invalid off-chain storage reference")
+	ErrRecordsNotOnChain         = errors.Register(ModuleName, 1135, "integrity set records are not stored on chain")
+	ErrInvalidHashScheme         = errors.Register(ModuleName, 1136, "invalid merkle hash scheme")
+	ErrInvalidHashAlgorithm      = errors.Register(ModuleName, 1137, "invalid merkle hash algorithm")
+	ErrHashAlgorithmNotAllowed   = errors.Register(ModuleName, 1138, "hash algorithm is not allowed by the params")
+	ErrIntegrityRecordPresent    = errors.Register(ModuleName, 1139, "integrity record is present in the set")
+	ErrChainHeadNotFound         = errors.Register(ModuleName, 1140, "integrity set chain head not found")
+	ErrChainLinkMismatch         = errors.Register(ModuleName, 1141, "previous root does not match the chain head")
+	ErrChainBroken               = errors.Register(ModuleName, 1142, "integrity set chain is broken")
+	ErrInvalidPeriodFormat       = errors.Register(ModuleName, 1143, "invalid period format")
+	ErrPeriodFormatNotFound      = errors.Register(ModuleName, 1144, "period format not found")
+	ErrPeriodFormatLocked        = errors.Register(ModuleName, 1145, "period format cannot change while the type has sets")
+	ErrIntegrityTypeNotFound     = errors.Register(ModuleName, 1146, "integrity type is not registered")
+	ErrIntegrityTypeDeprecated   = errors.Register(ModuleName, 1147, "integrity type is deprecated")
+	ErrInvalidSchemaHash         = errors.Register(ModuleName, 1148, "invalid integrity type schema hash")
+	ErrHashSchemeMismatch        = errors.Register(ModuleName, 1149, "hash scheme does not match the registered integrity type")
+	ErrInvalidRevocation         = errors.Register(ModuleName, 1150, "invalid integrity record revocation")
+	ErrRecordAlreadyRevoked      = errors.Register(ModuleName, 1151, "integrity record is already revoked")
+	ErrTenantFrozen              = errors.Register(ModuleName, 1152, "tenant is frozen")
+	ErrTenantArchived            = errors.Register(ModuleName, 1153, "tenant is archived")
+	ErrInvalidTenantStatus       = errors.Register(ModuleName, 1154, "invalid tenant status")
+	ErrInvalidTransferExpiry     = errors.Register(ModuleName, 1155, "invalid tenant ownership transfer expiry")
+	ErrTenantTransferExpired     = errors.Register(ModuleName, 1156, "tenant ownership transfer expired")
+	ErrInvalidTenantSigners      = errors.Register(ModuleName, 1157, "invalid tenant signers")
+	ErrUnauthorizedTenantSigner  = errors.Register(ModuleName, 1158, "unauthorized tenant signer")
+	ErrInvalidTenantAction       = errors.Register(ModuleName, 1159, "invalid tenant action")
+	ErrTenantProposalNotFound    = errors.Register(ModuleName, 1160, "tenant proposal not found")
+	ErrTenantProposalApproved    = errors.Register(ModuleName, 1161, "tenant proposal already approved by signer")
+	ErrTenantProposalThreshold   = errors.Register(ModuleName, 1162, "tenant proposal has not reached its threshold")
+	ErrIntegrityTypeLocked       = errors.Register(ModuleName, 1163, "integrity type schema cannot change while the type has sets")
 )
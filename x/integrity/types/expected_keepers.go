@@ -14,7 +14,15 @@ type AuthKeeper interface {
 }
 
 // BankKeeper defines the expected interface for the Bank module.
-type BankKeeper interface{}
+type BankKeeper interface {
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
+}
+
+// DistributionKeeper defines the expected interface for the Distribution module.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
 
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
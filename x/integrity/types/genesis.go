@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -14,16 +16,18 @@ func (gs GenesisState) Validate() error {
 		return err
 	}
 
-	tenants := make(map[string]struct{}, len(gs.Tenants))
+	tenants := make(map[string]string, len(gs.Tenants))
+	archived := make(map[string]struct{})
+	signerTenants := make(map[string]Tenant)
 	for _, tenant := range gs.Tenants {
-		normalizedTenant, err := NormalizeTenant(tenant.Tenant)
+		normalizedTenant, err := gs.Params.NormalizeTenant(tenant.Tenant)
 		if err != nil {
 			return err
 		}
 		if _, exists := tenants[normalizedTenant]; exists {
 			return ErrTenantAlreadyExists
 		}
-		tenants[normalizedTenant] = struct{}{}
+		tenants[normalizedTenant] = tenant.Owner
 		if _, err := NormalizeCreator(tenant.Owner); err != nil {
 			return err
 		}
@@ -35,52 +39,426 @@ func (gs GenesisState) Validate() error {
 			if pendingOwner == tenant.Owner {
 				return ErrTenantOwnershipUnchanged.Wrapf("tenant %s pending owner matches current owner", normalizedTenant)
 			}
+		} else if tenant.PendingOwnerExpiresAt != "" || tenant.PendingOwnerExpiresHeight != 0 {
+			return ErrInvalidTransferExpiry.Wrapf("tenant %s has a transfer expiry without a pending owner", normalizedTenant)
+		}
+		if _, err := NormalizeTransferExpiresAt(tenant.PendingOwnerExpiresAt); err != nil {
+			return err
+		}
+		if err := tenant.Status.Validate(); err != nil {
+			return err
+		}
+		if tenant.StatusChangedBy != "" {
+			if _, err := NormalizeOwnerAddress(tenant.StatusChangedBy, "status changed by"); err != nil {
+				return err
+			}
+		}
+		if tenant.Status == TenantStatus_TENANT_STATUS_ARCHIVED {
+			if tenant.PendingOwner != "" {
+				return ErrTenantArchived.Wrapf("archived tenant %s must not have a pending owner", normalizedTenant)
+			}
+			archived[normalizedTenant] = struct{}{}
+		}
+		if len(tenant.Signers) > 0 || tenant.Threshold != 0 {
+			if _, err := NormalizeTenantSigners(tenant.Signers, tenant.Threshold); err != nil {
+				return err
+			}
+			signerTenants[normalizedTenant] = tenant
 		}
 	}
 
-	setKeys := make(map[string]struct{}, len(gs.IntegritySetBundles))
-	for _, bundle := range gs.IntegritySetBundles {
-		set := bundle.Set
-		tenant, err := NormalizeTenant(set.Tenant)
+	periodFormats := make(map[string]PeriodFormat, len(gs.PeriodFormats))
+	for _, periodFormat := range gs.PeriodFormats {
+		tenant, err := gs.Params.NormalizeTenant(periodFormat.Tenant)
 		if err != nil {
 			return err
 		}
 		if _, ok := tenants[tenant]; !ok {
 			return ErrTenantNotFound
 		}
-		setType, err := NormalizeIntegrityType(set.Type)
+		formatType, err := gs.Params.NormalizeIntegrityType(periodFormat.Type)
 		if err != nil {
 			return err
 		}
-		period, err := NormalizePeriod(set.Period)
+		if err := periodFormat.Format.Validate(); err != nil {
+			return err
+		}
+		formatKey := tenant + "|" + formatType
+		if periodFormat.Format == PeriodFormat_PERIOD_FORMAT_UNSPECIFIED {
+			return ErrInvalidPeriodFormat.Wrapf("period format of %s must be declared", formatKey)
+		}
+		if _, exists := periodFormats[formatKey]; exists {
+			return ErrInvalidPeriodFormat.Wrapf("duplicate period format for %s", formatKey)
+		}
+		periodFormats[formatKey] = periodFormat.Format
+	}
+
+	integrityTypes := make(map[string]struct{}, len(gs.IntegrityTypes))
+	for _, integrityType := range gs.IntegrityTypes {
+		tenant, err := gs.Params.NormalizeTenant(integrityType.Tenant)
 		if err != nil {
 			return err
 		}
-		root, err := NormalizeRoot(set.Root)
+		if _, ok := tenants[tenant]; !ok {
+			return ErrTenantNotFound
+		}
+		registeredType, err := gs.Params.NormalizeIntegrityType(integrityType.Type)
 		if err != nil {
 			return err
 		}
-		if _, err := NormalizeCreator(set.Creator); err != nil {
+		if _, err := NormalizeTypeDescription(integrityType.Description); err != nil {
 			return err
 		}
-		records, _, err := PrepareIntegrityRecords(bundle.Records)
-		if err != nil {
+		if _, err := NormalizeSchemaHash(integrityType.SchemaHash); err != nil {
 			return err
 		}
-		if set.RecordCount != uint64(len(records)) {
-			return ErrInvalidRecord.Wrap("record count does not match bundle length")
+		if err := integrityType.HashScheme.Validate(); err != nil {
+			return err
+		}
+		if _, err := NormalizeCreator(integrityType.RegisteredBy); err != nil {
+			return err
 		}
-		calculatedRoot := CalculateMerkleRootFromPreparedRecords(records)
-		if calculatedRoot != root {
-			return ErrRootMismatch
+		typeKey := tenant + "|" + registeredType
+		if integrityType.PeriodFormat != periodFormats[typeKey] {
+			return ErrInvalidPeriodFormat.Wrapf("registered type %s does not match its declared period format", typeKey)
 		}
+		if _, exists := integrityTypes[typeKey]; exists {
+			return ErrInvalidType.Wrapf("duplicate registered type %s", typeKey)
+		}
+		integrityTypes[typeKey] = struct{}{}
+	}
 
-		compositeKey := tenant + "|" + setType + "|" + period
-		if _, exists := setKeys[compositeKey]; exists {
+	setKeys := make([]string, 0, len(gs.IntegritySetBundles))
+	latestVersions := make(map[string]uint64, len(gs.IntegritySetBundles))
+	versions := make(map[string]IntegritySet, len(gs.IntegritySetBundles)+len(gs.IntegritySetVersions))
+	for _, bundle := range gs.IntegritySetBundles {
+		setKey, err := gs.validateIntegritySetBundle(bundle, tenants, periodFormats)
+		if err != nil {
+			return err
+		}
+		if _, exists := latestVersions[setKey]; exists {
 			return ErrIntegritySetAlreadyExists
 		}
-		setKeys[compositeKey] = struct{}{}
+		setKeys = append(setKeys, setKey)
+		latestVersions[setKey] = bundle.Set.Version
+		versions[fmt.Sprintf("%s|%d", setKey, bundle.Set.Version)] = bundle.Set
+	}
+
+	for _, bundle := range gs.IntegritySetVersions {
+		setKey, err := gs.validateIntegritySetBundle(bundle, tenants, periodFormats)
+		if err != nil {
+			return err
+		}
+		if bundle.Set.Open {
+			return ErrInvalidSetVersion.Wrapf("superseded version %d of set %s must be sealed", bundle.Set.Version, setKey)
+		}
+		latestVersion, ok := latestVersions[setKey]
+		if !ok {
+			return ErrIntegritySetNotFound.Wrapf("set %s has versions but no latest set", setKey)
+		}
+		if bundle.Set.Version >= latestVersion {
+			return ErrInvalidSetVersion.Wrapf("set %s version %d is not older than latest version %d", setKey, bundle.Set.Version, latestVersion)
+		}
+		versionKey := fmt.Sprintf("%s|%d", setKey, bundle.Set.Version)
+		if _, exists := versions[versionKey]; exists {
+			return ErrInvalidSetVersion.Wrapf("duplicate version %d for set %s", bundle.Set.Version, setKey)
+		}
+		versions[versionKey] = bundle.Set
 	}
 
+	for _, setKey := range setKeys {
+		for version := uint64(2); version <= latestVersions[setKey]; version++ {
+			previous, ok := versions[fmt.Sprintf("%s|%d", setKey, version-1)]
+			if !ok {
+				return ErrInvalidSetVersion.Wrapf("set %s is missing version %d", setKey, version-1)
+			}
+			if versions[fmt.Sprintf("%s|%d", setKey, version)].PreviousRoot != previous.Root {
+				return ErrInvalidSetVersion.Wrapf("set %s version %d does not link to the root of version %d", setKey, version, version-1)
+			}
+		}
+	}
+
+	chainKeys := make(map[string]struct{}, len(gs.ChainHeads))
+	for _, head := range gs.ChainHeads {
+		tenant, err := gs.Params.NormalizeTenant(head.Tenant)
+		if err != nil {
+			return err
+		}
+		headType, err := gs.Params.NormalizeIntegrityType(head.Type)
+		if err != nil {
+			return err
+		}
+		chainKey := tenant + "|" + headType
+		if _, exists := chainKeys[chainKey]; exists {
+			return ErrChainBroken.Wrapf("duplicate chain head for %s", chainKey)
+		}
+		chainKeys[chainKey] = struct{}{}
+
+		headPeriod, err := gs.Params.NormalizePeriod(head.Period)
+		if err != nil {
+			return err
+		}
+		setKey := chainKey + "|" + headPeriod
+		latestVersion, ok := latestVersions[setKey]
+		if !ok {
+			return ErrIntegritySetNotFound.Wrapf("chain head set %s was not found", setKey)
+		}
+		set := versions[fmt.Sprintf("%s|%d", setKey, latestVersion)]
+		if head.Root != set.Root || head.Length == 0 || head.Length != set.ChainLength {
+			return ErrChainBroken.Wrapf("chain head of %s does not match set %s", chainKey, setKey)
+		}
+	}
+
+	revocationKeys := make(map[string]struct{}, len(gs.RecordRevocations))
+	for _, revocation := range gs.RecordRevocations {
+		tenant, err := gs.Params.NormalizeTenant(revocation.Tenant)
+		if err != nil {
+			return err
+		}
+		revocationType, err := gs.Params.NormalizeIntegrityType(revocation.Type)
+		if err != nil {
+			return err
+		}
+		period, err := gs.Params.NormalizePeriod(revocation.Period)
+		if err != nil {
+			return err
+		}
+		setKey := tenant + "|" + revocationType + "|" + period
+		if _, ok := latestVersions[setKey]; !ok {
+			return ErrIntegritySetNotFound.Wrapf("revoked record set %s was not found", setKey)
+		}
+		tag, err := NormalizeTag(revocation.Tag)
+		if err != nil {
+			return err
+		}
+		if err := ValidateRevocationReasonCode(revocation.ReasonCode); err != nil {
+			return err
+		}
+		if _, err := NormalizeCreator(revocation.RevokedBy); err != nil {
+			return err
+		}
+		revocationKey := setKey + "|" + tag
+		if _, exists := revocationKeys[revocationKey]; exists {
+			return ErrInvalidRevocation.Wrapf("duplicate revocation of record %s in set %s", tag, setKey)
+		}
+		revocationKeys[revocationKey] = struct{}{}
+	}
+
+	writerKeys := make(map[string]struct{}, len(gs.TenantWriters))
+	for _, grant := range gs.TenantWriters {
+		tenant, err := gs.Params.NormalizeTenant(grant.Tenant)
+		if err != nil {
+			return err
+		}
+		owner, ok := tenants[tenant]
+		if !ok {
+			return ErrTenantNotFound
+		}
+		if _, ok := archived[tenant]; ok {
+			return ErrTenantArchived.Wrapf("archived tenant %s must not have writers", tenant)
+		}
+		writer, err := NormalizeOwnerAddress(grant.Writer, "writer")
+		if err != nil {
+			return err
+		}
+		if writer == owner {
+			return ErrInvalidTenantWriter.Wrapf("tenant %s owner cannot be a writer", tenant)
+		}
+		if _, err := NormalizeTypePrefixes(grant.TypePrefixes); err != nil {
+			return err
+		}
+		if _, err := NormalizeExpiresAt(grant.ExpiresAt); err != nil {
+			return err
+		}
+
+		compositeKey := tenant + "|" + writer
+		if _, exists := writerKeys[compositeKey]; exists {
+			return ErrInvalidTenantWriter.Wrapf("duplicate writer %s for tenant %s", writer, tenant)
+		}
+		writerKeys[compositeKey] = struct{}{}
+	}
+
+	proposalIDs := make(map[uint64]struct{}, len(gs.TenantProposals))
+	for _, proposal := range gs.TenantProposals {
+		tenant, err := gs.Params.NormalizeTenant(proposal.Tenant)
+		if err != nil {
+			return err
+		}
+		if _, ok := tenants[tenant]; !ok {
+			return ErrTenantNotFound
+		}
+		tenantRecord, ok := signerTenants[tenant]
+		if !ok {
+			return ErrUnauthorizedTenantSigner.Wrapf("tenant %s of proposal %d has no signers", tenant, proposal.Id)
+		}
+		if _, ok := archived[tenant]; ok {
+			return ErrTenantArchived.Wrapf("archived tenant %s must not have proposals", tenant)
+		}
+		if proposal.Id == 0 || proposal.Id > gs.TenantProposalSeq {
+			return ErrInvalidTenantAction.Wrapf("proposal id %d is outside the proposal sequence %d", proposal.Id, gs.TenantProposalSeq)
+		}
+		if _, exists := proposalIDs[proposal.Id]; exists {
+			return ErrInvalidTenantAction.Wrapf("duplicate proposal id %d", proposal.Id)
+		}
+		proposalIDs[proposal.Id] = struct{}{}
+		if _, err := ValidateTenantAction(tenant, tenantRecord.Owner, proposal.Action); err != nil {
+			return err
+		}
+		if len(proposal.Approvals) == 0 || proposal.Approvals[0] != proposal.Proposer {
+			return ErrInvalidTenantAction.Wrapf("proposal %d must be approved by its proposer first", proposal.Id)
+		}
+		approvals := make(map[string]struct{}, len(proposal.Approvals))
+		for _, approval := range proposal.Approvals {
+			if !tenantRecord.IsSigner(approval) {
+				return ErrUnauthorizedTenantSigner.Wrapf("approval %s of proposal %d is not a signer of tenant %s", approval, proposal.Id, tenant)
+			}
+			if _, exists := approvals[approval]; exists {
+				return ErrTenantProposalApproved.Wrapf("duplicate approval %s of proposal %d", approval, proposal.Id)
+			}
+			approvals[approval] = struct{}{}
+		}
+	}
+
+	return nil
+}
+
+// validateIntegritySetBundle checks one set header against its records and the period format of its type,
+// and returns the tenant|type|period key of the set.
+func (gs GenesisState) validateIntegritySetBundle(bundle IntegritySetBundle, tenants map[string]string, periodFormats map[string]PeriodFormat) (string, error) {
+	set := bundle.Set
+	tenant, err := gs.Params.NormalizeTenant(set.Tenant)
+	if err != nil {
+		return "", err
+	}
+	if _, ok := tenants[tenant]; !ok {
+		return "", ErrTenantNotFound
+	}
+	setType, err := gs.Params.NormalizeIntegrityType(set.Type)
+	if err != nil {
+		return "", err
+	}
+	period, err := gs.Params.NormalizePeriod(set.Period)
+	if err != nil {
+		return "", err
+	}
+	if format, ok := periodFormats[tenant+"|"+setType]; ok {
+		canonical, err := format.NormalizePeriod(period)
+		if err != nil {
+			return "", err
+		}
+		if canonical != period {
+			return "", ErrInvalidPeriod.Wrapf("period %s of %s/%s is not in canonical %s form %s", period, tenant, setType, format, canonical)
+		}
+	}
+	setKey := tenant + "|" + setType + "|" + period
+	if _, err := NormalizeCreator(set.Creator); err != nil {
+		return "", err
+	}
+	switch {
+	case set.Version == 0:
+		return "", ErrInvalidSetVersion.Wrap("version must start at 1")
+	case set.Version == 1 && (set.PreviousRoot != "" || set.ReasonHash != ""):
+		return "", ErrInvalidSetVersion.Wrap("version 1 must not have a previous root or reason hash")
+	case set.Version > 1:
+		if _, err := NormalizeRoot(set.PreviousRoot); err != nil {
+			return "", err
+		}
+		if _, err := NormalizeReasonHash(set.ReasonHash); err != nil {
+			return "", err
+		}
+	}
+	if err := set.MerkleHasher().Validate(); err != nil {
+		return "", err
+	}
+	if err := validateSetChainLink(set, setKey); err != nil {
+		return "", err
+	}
+	switch set.StorageMode {
+	case StorageMode_STORAGE_MODE_ON_CHAIN:
+		if set.StorageUri != "" || set.StorageDigest != "" {
+			return "", ErrInvalidStorageReference.Wrapf("on-chain set %s must not have an off-chain storage reference", setKey)
+		}
+	case StorageMode_STORAGE_MODE_ROOT_ONLY:
+		if err := validateRootOnlyIntegritySet(bundle, setKey); err != nil {
+			return "", err
+		}
+		return setKey, nil
+	default:
+		return "", ErrInvalidRecord.Wrapf("set %s has unknown storage mode %d", setKey, set.StorageMode)
+	}
+	if set.Open {
+		if set.Version != 1 {
+			return "", ErrInvalidSetVersion.Wrapf("open set %s must be version 1", setKey)
+		}
+		// A set that was opened but has no appends yet carries neither records nor a root.
+		if len(bundle.Records) == 0 {
+			if set.Root != "" || set.RecordCount != 0 {
+				return "", ErrInvalidRecord.Wrapf("open set %s without records must not have a root", setKey)
+			}
+			return setKey, nil
+		}
+	}
+	root, err := NormalizeRoot(set.Root)
+	if err != nil {
+		return "", err
+	}
+	records, _, err := gs.Params.PrepareIntegrityRecords(bundle.Records)
+	if err != nil {
+		return "", err
+	}
+	if set.RecordCount != uint64(len(records)) {
+		return "", ErrInvalidRecord.Wrap("record count does not match bundle length")
+	}
+	calculatedRoot := set.MerkleHasher().CalculateMerkleRoot(records)
+	if calculatedRoot != root {
+		return "", ErrRootMismatch
+	}
+
+	return setKey, nil
+}
+
+// validateSetChainLink checks that a set either starts a chain, stays outside any chain, or carries a
+// complete link to the previous set of its chain.
+func validateSetChainLink(set IntegritySet, setKey string) error {
+	switch {
+	case set.ChainPreviousPeriod == "" && set.ChainPreviousRoot == "":
+		if set.ChainLength > 1 {
+			return ErrChainBroken.Wrapf("set %s has chain length %d but no previous link", setKey, set.ChainLength)
+		}
+	case set.ChainPreviousPeriod == "" || set.ChainPreviousRoot == "":
+		return ErrChainBroken.Wrapf("set %s must link to both the period and the root of the previous set", setKey)
+	default:
+		if _, err := NormalizePeriod(set.ChainPreviousPeriod); err != nil {
+			return err
+		}
+		if _, err := NormalizeRoot(set.ChainPreviousRoot); err != nil {
+			return err
+		}
+		if set.ChainLength < 2 {
+			return ErrChainBroken.Wrapf("linked set %s must have a chain length of at least 2", setKey)
+		}
+	}
 	return nil
 }
+
+// validateRootOnlyIntegritySet checks a set anchored without on-chain records.
+func validateRootOnlyIntegritySet(bundle IntegritySetBundle, setKey string) error {
+	set := bundle.Set
+	if set.Open {
+		return ErrInvalidRecord.Wrapf("root-only set %s must not be open", setKey)
+	}
+	if len(bundle.Records) > 0 {
+		return ErrInvalidRecord.Wrapf("root-only set %s must not carry records", setKey)
+	}
+	if set.RecordCount == 0 {
+		return ErrEmptyRecords.Wrapf("root-only set %s must have a positive record count", setKey)
+	}
+	if _, err := NormalizeRoot(set.Root); err != nil {
+		return err
+	}
+	if _, err := NormalizeStorageURI(set.StorageUri); err != nil {
+		return err
+	}
+	_, err := NormalizeStorageDigest(set.StorageDigest)
+	return err
+}
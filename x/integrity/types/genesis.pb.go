@@ -30,6 +30,16 @@ type GenesisState struct {
 	Params              Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	Tenants             []Tenant             `protobuf:"bytes,2,rep,name=tenants,proto3" json:"tenants"`
 	IntegritySetBundles []IntegritySetBundle `protobuf:"bytes,3,rep,name=integrity_set_bundles,json=integritySetBundles,proto3" json:"integrity_set_bundles"`
+	TenantWriters       []TenantWriter       `protobuf:"bytes,4,rep,name=tenant_writers,json=tenantWriters,proto3" json:"tenant_writers"`
+	// integrity_set_versions holds the superseded versions of sets listed in integrity_set_bundles.
+	IntegritySetVersions []IntegritySetBundle        `protobuf:"bytes,5,rep,name=integrity_set_versions,json=integritySetVersions,proto3" json:"integrity_set_versions"`
+	ChainHeads           []ChainHead                 `protobuf:"bytes,6,rep,name=chain_heads,json=chainHeads,proto3" json:"chain_heads"`
+	PeriodFormats        []TypePeriodFormat          `protobuf:"bytes,7,rep,name=period_formats,json=periodFormats,proto3" json:"period_formats"`
+	IntegrityTypes       []IntegrityType             `protobuf:"bytes,8,rep,name=integrity_types,json=integrityTypes,proto3" json:"integrity_types"`
+	RecordRevocations    []IntegrityRecordRevocation `protobuf:"bytes,9,rep,name=record_revocations,json=recordRevocations,proto3" json:"record_revocations"`
+	TenantProposals      []TenantProposal            `protobuf:"bytes,10,rep,name=tenant_proposals,json=tenantProposals,proto3" json:"tenant_proposals"`
+	// tenant_proposal_seq is the id of the last tenant proposal.
+	TenantProposalSeq uint64 `protobuf:"varint,11,opt,name=tenant_proposal_seq,json=tenantProposalSeq,proto3" json:"tenant_proposal_seq,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -86,6 +96,62 @@ func (m *GenesisState) GetIntegritySetBundles() []IntegritySetBundle {
 	return nil
 }
 
+func (m *GenesisState) GetTenantWriters() []TenantWriter {
+	if m != nil {
+		return m.TenantWriters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIntegritySetVersions() []IntegritySetBundle {
+	if m != nil {
+		return m.IntegritySetVersions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChainHeads() []ChainHead {
+	if m != nil {
+		return m.ChainHeads
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeriodFormats() []TypePeriodFormat {
+	if m != nil {
+		return m.PeriodFormats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIntegrityTypes() []IntegrityType {
+	if m != nil {
+		return m.IntegrityTypes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecordRevocations() []IntegrityRecordRevocation {
+	if m != nil {
+		return m.RecordRevocations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTenantProposals() []TenantProposal {
+	if m != nil {
+		return m.TenantProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTenantProposalSeq() uint64 {
+	if m != nil {
+		return m.TenantProposalSeq
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "kudora.integrity.v1.GenesisState")
 }
@@ -93,27 +159,42 @@ func init() {
 func init() { proto.RegisterFile("kudora/integrity/v1/genesis.proto", fileDescriptor_aefef638d6fdab06) }
 
 var fileDescriptor_aefef638d6fdab06 = []byte{
-	// 311 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcc, 0x2e, 0x4d, 0xc9,
-	0x2f, 0x4a, 0xd4, 0xcf, 0xcc, 0x2b, 0x49, 0x4d, 0x2f, 0xca, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x83, 0x2b, 0xd1, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07,
-	0x93, 0x10, 0x75, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x55,
-	0xc7, 0x66, 0x01, 0x9c, 0x13, 0x5f, 0x9c, 0x5a, 0x02, 0x55, 0xa8, 0x80, 0x4d, 0x61, 0x41, 0x62,
-	0x51, 0x62, 0x6e, 0x31, 0x3e, 0x15, 0x25, 0xa9, 0x79, 0x89, 0x79, 0x50, 0x33, 0x94, 0xde, 0x31,
-	0x72, 0xf1, 0xb8, 0x43, 0x1c, 0x1f, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xc7, 0xc5, 0x06, 0x31,
-	0x42, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x5a, 0x0f, 0x8b, 0x67, 0xf4, 0x02, 0xc0, 0x4a,
-	0x9c, 0x38, 0x4f, 0xdc, 0x93, 0x67, 0x58, 0xf1, 0x7c, 0x83, 0x16, 0x63, 0x10, 0x54, 0x97, 0x90,
-	0x35, 0x17, 0x3b, 0xc4, 0x82, 0x62, 0x09, 0x26, 0x05, 0x66, 0x9c, 0x06, 0x84, 0x80, 0xd5, 0x38,
-	0xb1, 0x80, 0x0c, 0x08, 0x82, 0xe9, 0x10, 0x4a, 0xe4, 0x12, 0x45, 0xf1, 0x68, 0x7c, 0x52, 0x69,
-	0x5e, 0x4a, 0x4e, 0x6a, 0xb1, 0x04, 0x33, 0xd8, 0x28, 0x75, 0xac, 0x46, 0x79, 0xc2, 0x38, 0xc1,
-	0xa9, 0x25, 0x4e, 0x60, 0xf5, 0x50, 0x63, 0x85, 0x33, 0x31, 0x64, 0x8a, 0x9d, 0x02, 0x4f, 0x3c,
-	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
-	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x3c, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49,
-	0x2f, 0x39, 0x3f, 0x57, 0x3f, 0x2c, 0x31, 0x27, 0x35, 0xaf, 0x24, 0x33, 0xcf, 0xa9, 0x28, 0x35,
-	0x25, 0x35, 0x37, 0xb5, 0xb8, 0xa4, 0x28, 0x55, 0x1f, 0x1a, 0x96, 0x15, 0x48, 0xa1, 0x59, 0x52,
-	0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x0e, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x28,
-	0x79, 0x86, 0x8a, 0x1a, 0x02, 0x00, 0x00,
+	// 552 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x80, 0x63, 0x5a, 0x52, 0xba, 0x81, 0x94, 0x6c, 0x0a, 0xb2, 0x8a, 0xe4, 0xa6, 0x45, 0xa8,
+	0xa1, 0x12, 0xb6, 0x0a, 0x47, 0x24, 0x0e, 0x41, 0xfc, 0x54, 0x20, 0x14, 0x9c, 0x0a, 0x24, 0x2e,
+	0xd6, 0xc6, 0x5e, 0x92, 0x15, 0x8d, 0xd7, 0xdd, 0xd9, 0x04, 0xf2, 0x16, 0x3c, 0x06, 0x47, 0x1e,
+	0xa3, 0xc7, 0x1e, 0x39, 0x21, 0x94, 0x1c, 0x78, 0x09, 0x0e, 0x28, 0xe3, 0x4d, 0x14, 0x07, 0xd7,
+	0x07, 0x2e, 0x91, 0x3d, 0xf9, 0xf6, 0x9b, 0xd9, 0xf1, 0x0c, 0xd9, 0xfb, 0x34, 0x8c, 0xa4, 0x62,
+	0x9e, 0x88, 0x35, 0xef, 0x29, 0xa1, 0xc7, 0xde, 0xe8, 0xc8, 0xeb, 0xf1, 0x98, 0x83, 0x00, 0x37,
+	0x51, 0x52, 0x4b, 0x5a, 0x4f, 0x11, 0x77, 0x81, 0xb8, 0xa3, 0xa3, 0x9d, 0x1a, 0x1b, 0x88, 0x58,
+	0x7a, 0xf8, 0x9b, 0x72, 0x3b, 0xdb, 0x3d, 0xd9, 0x93, 0xf8, 0xe8, 0xcd, 0x9e, 0x4c, 0x74, 0x37,
+	0x2f, 0x41, 0xd8, 0x67, 0x22, 0x36, 0xc0, 0x61, 0x1e, 0xb0, 0x78, 0x09, 0x14, 0x0f, 0xa5, 0x8a,
+	0x0c, 0x7b, 0x50, 0xcc, 0x02, 0xd7, 0x06, 0x6c, 0x16, 0x83, 0x7a, 0x9c, 0x70, 0x43, 0x36, 0xf2,
+	0xc8, 0x84, 0x29, 0x36, 0x80, 0xa2, 0xa4, 0x09, 0x57, 0x42, 0x46, 0xc1, 0x47, 0xa9, 0x06, 0x4c,
+	0x17, 0xa9, 0x34, 0x8f, 0x59, 0x3c, 0x27, 0xee, 0x5f, 0x4e, 0x04, 0x89, 0x92, 0x89, 0x04, 0x76,
+	0x9a, 0xa2, 0xfb, 0x7f, 0xca, 0xe4, 0xfa, 0x8b, 0xf4, 0x3b, 0x74, 0x34, 0xd3, 0x9c, 0x3e, 0x21,
+	0xe5, 0xb4, 0x2c, 0xdb, 0x6a, 0x58, 0xcd, 0xca, 0xc3, 0x3b, 0x6e, 0xce, 0x77, 0x71, 0xdb, 0x88,
+	0xb4, 0x36, 0xcf, 0x7f, 0xee, 0x96, 0xbe, 0xfd, 0xfe, 0x7e, 0x68, 0xf9, 0xe6, 0x14, 0x7d, 0x4c,
+	0x36, 0xd2, 0x4c, 0x60, 0x5f, 0x69, 0xac, 0x5d, 0x2a, 0x38, 0x41, 0xa6, 0xb5, 0x3e, 0x13, 0xf8,
+	0xf3, 0x13, 0x94, 0x91, 0x5b, 0x99, 0x36, 0x07, 0xdd, 0x61, 0x1c, 0x9d, 0x72, 0xb0, 0xd7, 0x50,
+	0x75, 0x90, 0xab, 0x3a, 0x9e, 0xbf, 0x74, 0xb8, 0x6e, 0x21, 0x6f, 0xb4, 0x75, 0xf1, 0xcf, 0x3f,
+	0x40, 0xdf, 0x90, 0xaa, 0xe9, 0xc4, 0x67, 0x25, 0x34, 0x57, 0x60, 0xaf, 0xa3, 0x7b, 0xaf, 0xa0,
+	0xcc, 0xf7, 0x48, 0x1a, 0xeb, 0x0d, 0xbd, 0x14, 0x03, 0x1a, 0x92, 0xdb, 0xd9, 0x92, 0x47, 0x5c,
+	0x81, 0x90, 0x31, 0xd8, 0x57, 0xff, 0xa7, 0xe6, 0xed, 0xe5, 0x9a, 0xdf, 0x19, 0x15, 0x7d, 0x46,
+	0x2a, 0x38, 0xcb, 0x41, 0x9f, 0xb3, 0x08, 0xec, 0x32, 0x9a, 0x9d, 0x5c, 0xf3, 0xd3, 0x19, 0xf7,
+	0x92, 0xb3, 0xc8, 0x08, 0x49, 0x38, 0x0f, 0x00, 0xf5, 0x49, 0x35, 0x33, 0x50, 0x60, 0x6f, 0xa0,
+	0xe9, 0x5e, 0xfe, 0xdd, 0xc7, 0x09, 0x6f, 0x23, 0xfe, 0x1c, 0xe9, 0xf9, 0xfd, 0x93, 0xa5, 0x18,
+	0xd0, 0xb7, 0x64, 0x2b, 0x3b, 0xf0, 0x60, 0x5f, 0x43, 0xe9, 0x7e, 0xf1, 0xc5, 0x67, 0x76, 0x63,
+	0xac, 0x8a, 0xe5, 0xe0, 0xac, 0xa5, 0x34, 0x5d, 0xc7, 0x40, 0xf1, 0x91, 0x0c, 0x99, 0xc6, 0x76,
+	0x6e, 0xa2, 0xd5, 0x2d, 0xb6, 0xfa, 0x78, 0xce, 0x5f, 0x1c, 0x33, 0x19, 0x6a, 0x6a, 0x25, 0x0e,
+	0xf4, 0x84, 0xdc, 0x5c, 0xd9, 0x08, 0xb0, 0x09, 0xa6, 0xb8, 0x5b, 0x30, 0x09, 0x6d, 0xc3, 0x1a,
+	0xef, 0x96, 0xce, 0x44, 0x81, 0xba, 0xa4, 0xbe, 0x62, 0x0d, 0x80, 0x9f, 0xd9, 0x95, 0x86, 0xd5,
+	0x5c, 0xf7, 0x6b, 0x59, 0xba, 0xc3, 0xcf, 0x5a, 0xc7, 0xe7, 0x13, 0xc7, 0xba, 0x98, 0x38, 0xd6,
+	0xaf, 0x89, 0x63, 0x7d, 0x9d, 0x3a, 0xa5, 0x8b, 0xa9, 0x53, 0xfa, 0x31, 0x75, 0x4a, 0x1f, 0xbc,
+	0x9e, 0xd0, 0xfd, 0x61, 0xd7, 0x0d, 0xe5, 0xc0, 0x7b, 0x85, 0xf5, 0x3c, 0x78, 0xcd, 0xba, 0xe0,
+	0x99, 0xd5, 0xfe, 0xb2, 0xb4, 0xdc, 0xd8, 0xf5, 0x6e, 0x19, 0x17, 0xfa, 0xd1, 0xdf, 0x01, 0x00,
+	0x7e, 0x8d, 0x32, 0xd7, 0x6b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -136,6 +217,109 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.TenantProposalSeq != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TenantProposalSeq))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TenantProposals) > 0 {
+		for iNdEx := len(m.TenantProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TenantProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RecordRevocations) > 0 {
+		for iNdEx := len(m.RecordRevocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecordRevocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.IntegrityTypes) > 0 {
+		for iNdEx := len(m.IntegrityTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IntegrityTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PeriodFormats) > 0 {
+		for iNdEx := len(m.PeriodFormats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodFormats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.ChainHeads) > 0 {
+		for iNdEx := len(m.ChainHeads) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChainHeads[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.IntegritySetVersions) > 0 {
+		for iNdEx := len(m.IntegritySetVersions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IntegritySetVersions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TenantWriters) > 0 {
+		for iNdEx := len(m.TenantWriters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TenantWriters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.IntegritySetBundles) > 0 {
 		for iNdEx := len(m.IntegritySetBundles) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -208,6 +392,51 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.TenantWriters) > 0 {
+		for _, e := range m.TenantWriters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IntegritySetVersions) > 0 {
+		for _, e := range m.IntegritySetVersions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChainHeads) > 0 {
+		for _, e := range m.ChainHeads {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PeriodFormats) > 0 {
+		for _, e := range m.PeriodFormats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IntegrityTypes) > 0 {
+		for _, e := range m.IntegrityTypes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecordRevocations) > 0 {
+		for _, e := range m.RecordRevocations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TenantProposals) > 0 {
+		for _, e := range m.TenantProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.TenantProposalSeq != 0 {
+		n += 1 + sovGenesis(uint64(m.TenantProposalSeq))
+	}
 	return n
 }
 
@@ -347,6 +576,263 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantWriters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantWriters = append(m.TenantWriters, TenantWriter{})
+			if err := m.TenantWriters[len(m.TenantWriters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegritySetVersions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegritySetVersions = append(m.IntegritySetVersions, IntegritySetBundle{})
+			if err := m.IntegritySetVersions[len(m.IntegritySetVersions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainHeads", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainHeads = append(m.ChainHeads, ChainHead{})
+			if err := m.ChainHeads[len(m.ChainHeads)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodFormats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodFormats = append(m.PeriodFormats, TypePeriodFormat{})
+			if err := m.PeriodFormats[len(m.PeriodFormats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegrityTypes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IntegrityTypes = append(m.IntegrityTypes, IntegrityType{})
+			if err := m.IntegrityTypes[len(m.IntegrityTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordRevocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordRevocations = append(m.RecordRevocations, IntegrityRecordRevocation{})
+			if err := m.RecordRevocations[len(m.RecordRevocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TenantProposals = append(m.TenantProposals, TenantProposal{})
+			if err := m.TenantProposals[len(m.TenantProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TenantProposalSeq", wireType)
+			}
+			m.TenantProposalSeq = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TenantProposalSeq |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
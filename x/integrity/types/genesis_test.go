@@ -20,9 +20,48 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{},
+			genState: &types.GenesisState{Params: types.DefaultParams()},
 			valid:    true,
 		},
+		{
+			desc:     "zero limits are invalid",
+			genState: &types.GenesisState{},
+			valid:    false,
+		},
+		{
+			desc: "period format of an unknown tenant is invalid",
+			genState: &types.GenesisState{
+				Params:        types.DefaultParams(),
+				PeriodFormats: []types.TypePeriodFormat{{Tenant: "acme", Type: "acme.daily.v1", Format: types.PeriodFormat_PERIOD_FORMAT_ISO_DATE}},
+			},
+			valid: false,
+		},
+		{
+			desc: "integrity type of an unknown tenant is invalid",
+			genState: &types.GenesisState{
+				Params:         types.DefaultParams(),
+				IntegrityTypes: []types.IntegrityType{{Tenant: "acme", Type: "acme.daily.v1", SchemaHash: "0x2222222222222222222222222222222222222222222222222222222222222222"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "revocation of an unknown set is invalid",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				RecordRevocations: []types.IntegrityRecordRevocation{{Tenant: "acme", Type: "acme.daily.v1", Period: "2026-06-25", Tag: "0x2222222222222222222222222222222222222222222222222222222222222222", ReasonCode: 1}},
+			},
+			valid: false,
+		},
+		{
+			desc: "proposal of a tenant without signers is invalid",
+			genState: &types.GenesisState{
+				Params:            types.DefaultParams(),
+				Tenants:           []types.Tenant{{Tenant: "acme", Owner: "owner"}},
+				TenantProposals:   []types.TenantProposal{{Tenant: "acme", Id: 1, Proposer: "owner", Approvals: []string{"owner"}, Action: types.TenantAction{Action: &types.TenantAction_FreezeTenant{FreezeTenant: &types.MsgFreezeTenant{Tenant: "acme"}}}}},
+				TenantProposalSeq: 1,
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
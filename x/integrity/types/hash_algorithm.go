@@ -0,0 +1,38 @@
+package types
+
+import (
+	"crypto/sha256"
+
+	"golang.org/x/crypto/sha3"
+	"lukechampine.com/blake3"
+)
+
+// hashFunctions is the registry of Merkle hash functions. Every entry returns a 32-byte digest so that
+// roots, leaf hashes and siblings keep one encoding whatever the algorithm.
+var hashFunctions = map[HashAlgorithm]func(data []byte) [32]byte{
+	HashAlgorithm_HASH_ALGORITHM_SHA256:    sha256.Sum256,
+	HashAlgorithm_HASH_ALGORITHM_KECCAK256: keccak256,
+	HashAlgorithm_HASH_ALGORITHM_BLAKE3:    blake3.Sum256,
+}
+
+// Validate rejects hash algorithms that have no registered hash function.
+func (a HashAlgorithm) Validate() error {
+	if _, ok := hashFunctions[a]; !ok {
+		return ErrInvalidHashAlgorithm.Wrapf("unknown hash algorithm %d", a)
+	}
+	return nil
+}
+
+// Sum hashes data with the algorithm. The algorithm must be valid.
+func (a HashAlgorithm) Sum(data []byte) []byte {
+	sum := hashFunctions[a](data)
+	return sum[:]
+}
+
+func keccak256(data []byte) [32]byte {
+	var sum [32]byte
+	hasher := sha3.NewLegacyKeccak256()
+	hasher.Write(data)
+	hasher.Sum(sum[:0])
+	return sum
+}
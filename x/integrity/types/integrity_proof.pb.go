@@ -0,0 +1,1050 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudora/integrity/v1/integrity_proof.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IntegrityRecordProof defines a Merkle inclusion proof for one record of an integrity set.
+type IntegrityRecordProof struct {
+	// leaf_index is the position of the record in the tag-sorted record list.
+	LeafIndex uint64 `protobuf:"varint,1,opt,name=leaf_index,json=leafIndex,proto3" json:"leaf_index,omitempty"`
+	// leaf_count is the number of records committed in the set.
+	LeafCount uint64 `protobuf:"varint,2,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	// leaf_hash is the hash of the canonical leaf JSON under hash_scheme.
+	LeafHash string `protobuf:"bytes,3,opt,name=leaf_hash,json=leafHash,proto3" json:"leaf_hash,omitempty"`
+	// siblings lists the sibling hashes from the leaf level up to the root.
+	Siblings []string `protobuf:"bytes,4,rep,name=siblings,proto3" json:"siblings,omitempty"`
+	// sibling_on_left holds one direction bit per sibling; true means the
+	// sibling is the left input of the parent hash.
+	SiblingOnLeft []bool `protobuf:"varint,5,rep,packed,name=sibling_on_left,json=siblingOnLeft,proto3" json:"sibling_on_left,omitempty"`
+	// hash_scheme is the Merkle hashing scheme of the set the proof was built for.
+	HashScheme HashScheme `protobuf:"varint,6,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	// hash_algorithm is the hash function of the set the proof was built for.
+	HashAlgorithm HashAlgorithm `protobuf:"varint,7,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=kudora.integrity.v1.HashAlgorithm" json:"hash_algorithm,omitempty"`
+}
+
+func (m *IntegrityRecordProof) Reset()         { *m = IntegrityRecordProof{} }
+func (m *IntegrityRecordProof) String() string { return proto.CompactTextString(m) }
+func (*IntegrityRecordProof) ProtoMessage()    {}
+func (*IntegrityRecordProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6650516802f378, []int{0}
+}
+func (m *IntegrityRecordProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntegrityRecordProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntegrityRecordProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntegrityRecordProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrityRecordProof.Merge(m, src)
+}
+func (m *IntegrityRecordProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntegrityRecordProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrityRecordProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrityRecordProof proto.InternalMessageInfo
+
+func (m *IntegrityRecordProof) GetLeafIndex() uint64 {
+	if m != nil {
+		return m.LeafIndex
+	}
+	return 0
+}
+
+func (m *IntegrityRecordProof) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *IntegrityRecordProof) GetLeafHash() string {
+	if m != nil {
+		return m.LeafHash
+	}
+	return ""
+}
+
+func (m *IntegrityRecordProof) GetSiblings() []string {
+	if m != nil {
+		return m.Siblings
+	}
+	return nil
+}
+
+func (m *IntegrityRecordProof) GetSiblingOnLeft() []bool {
+	if m != nil {
+		return m.SiblingOnLeft
+	}
+	return nil
+}
+
+func (m *IntegrityRecordProof) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_V1
+}
+
+func (m *IntegrityRecordProof) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_HASH_ALGORITHM_SHA256
+}
+
+// IntegrityRecordAbsenceProof shows that a tag is not part of an integrity set. Records are committed in
+// tag order, so the tag is absent when it falls strictly between two adjacent leaves. left is unset when
+// the tag sorts before the first record and right is unset when it sorts after the last one.
+type IntegrityRecordAbsenceProof struct {
+	// tag is the tag proven absent.
+	Tag string `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	// left is the record immediately before tag.
+	Left *IntegrityRecord `protobuf:"bytes,2,opt,name=left,proto3" json:"left,omitempty"`
+	// left_proof is the inclusion proof of left.
+	LeftProof *IntegrityRecordProof `protobuf:"bytes,3,opt,name=left_proof,json=leftProof,proto3" json:"left_proof,omitempty"`
+	// right is the record immediately after tag.
+	Right *IntegrityRecord `protobuf:"bytes,4,opt,name=right,proto3" json:"right,omitempty"`
+	// right_proof is the inclusion proof of right.
+	RightProof *IntegrityRecordProof `protobuf:"bytes,5,opt,name=right_proof,json=rightProof,proto3" json:"right_proof,omitempty"`
+}
+
+func (m *IntegrityRecordAbsenceProof) Reset()         { *m = IntegrityRecordAbsenceProof{} }
+func (m *IntegrityRecordAbsenceProof) String() string { return proto.CompactTextString(m) }
+func (*IntegrityRecordAbsenceProof) ProtoMessage()    {}
+func (*IntegrityRecordAbsenceProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_be6650516802f378, []int{1}
+}
+func (m *IntegrityRecordAbsenceProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntegrityRecordAbsenceProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntegrityRecordAbsenceProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntegrityRecordAbsenceProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrityRecordAbsenceProof.Merge(m, src)
+}
+func (m *IntegrityRecordAbsenceProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntegrityRecordAbsenceProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrityRecordAbsenceProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrityRecordAbsenceProof proto.InternalMessageInfo
+
+func (m *IntegrityRecordAbsenceProof) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *IntegrityRecordAbsenceProof) GetLeft() *IntegrityRecord {
+	if m != nil {
+		return m.Left
+	}
+	return nil
+}
+
+func (m *IntegrityRecordAbsenceProof) GetLeftProof() *IntegrityRecordProof {
+	if m != nil {
+		return m.LeftProof
+	}
+	return nil
+}
+
+func (m *IntegrityRecordAbsenceProof) GetRight() *IntegrityRecord {
+	if m != nil {
+		return m.Right
+	}
+	return nil
+}
+
+func (m *IntegrityRecordAbsenceProof) GetRightProof() *IntegrityRecordProof {
+	if m != nil {
+		return m.RightProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*IntegrityRecordProof)(nil), "kudora.integrity.v1.IntegrityRecordProof")
+	proto.RegisterType((*IntegrityRecordAbsenceProof)(nil), "kudora.integrity.v1.IntegrityRecordAbsenceProof")
+}
+
+func init() {
+	proto.RegisterFile("kudora/integrity/v1/integrity_proof.proto", fileDescriptor_be6650516802f378)
+}
+
+var fileDescriptor_be6650516802f378 = []byte{
+	// 439 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
+	0x10, 0x8d, 0xf3, 0x51, 0x92, 0xb1, 0x5a, 0xd0, 0xc2, 0x61, 0x95, 0x0a, 0x63, 0x45, 0x08, 0x5c,
+	0x24, 0x6c, 0x35, 0x5c, 0x10, 0x27, 0x0a, 0x97, 0x1a, 0x2a, 0x81, 0x96, 0x1b, 0x17, 0xcb, 0x76,
+	0xd6, 0xde, 0x15, 0x8e, 0x37, 0xda, 0xdd, 0x54, 0xed, 0xbf, 0xe0, 0xff, 0x70, 0xe3, 0xc4, 0xb1,
+	0x47, 0x8e, 0x28, 0xf9, 0x23, 0x68, 0xd7, 0x4e, 0x5a, 0x55, 0xa5, 0xa2, 0xb7, 0x99, 0x37, 0xf3,
+	0xde, 0x3e, 0x3f, 0x0f, 0x1c, 0x7c, 0x5b, 0xce, 0x84, 0x4c, 0x23, 0x5e, 0x6b, 0x5a, 0x4a, 0xae,
+	0xcf, 0xa3, 0xd3, 0xc3, 0xcb, 0x26, 0x59, 0x48, 0x21, 0x8a, 0x70, 0x21, 0x85, 0x16, 0xe8, 0x61,
+	0xb3, 0x1a, 0x6e, 0xa7, 0xe1, 0xe9, 0xe1, 0xf8, 0xc5, 0xed, 0x7c, 0x49, 0x73, 0x21, 0x67, 0x8d,
+	0xc0, 0xf8, 0xf9, 0xed, 0xbb, 0x8a, 0xea, 0x66, 0x71, 0xf2, 0xb3, 0x0b, 0x8f, 0xe2, 0x0d, 0x4e,
+	0xac, 0xc4, 0x67, 0x63, 0x04, 0x3d, 0x06, 0xa8, 0x68, 0x5a, 0x24, 0xbc, 0x9e, 0xd1, 0x33, 0xec,
+	0xf8, 0x4e, 0xd0, 0x27, 0x23, 0x83, 0xc4, 0x06, 0xd8, 0x8e, 0x73, 0xb1, 0xac, 0x35, 0xee, 0x5e,
+	0x8e, 0xdf, 0x1b, 0x00, 0xed, 0x83, 0x6d, 0x12, 0x96, 0x2a, 0x86, 0x7b, 0xbe, 0x13, 0x8c, 0xc8,
+	0xd0, 0x00, 0xc7, 0xa9, 0x62, 0x68, 0x0c, 0x43, 0xc5, 0xb3, 0x8a, 0xd7, 0xa5, 0xc2, 0x7d, 0xbf,
+	0x67, 0x66, 0x9b, 0x1e, 0x3d, 0x83, 0xfb, 0x6d, 0x9d, 0x88, 0x3a, 0xa9, 0x68, 0xa1, 0xf1, 0xc0,
+	0xef, 0x05, 0x43, 0xb2, 0xdb, 0xc2, 0x9f, 0xea, 0x13, 0x5a, 0x68, 0xf4, 0x16, 0x5c, 0xa3, 0x9d,
+	0xa8, 0x9c, 0xd1, 0x39, 0xc5, 0x3b, 0xbe, 0x13, 0xec, 0x4d, 0x9f, 0x84, 0x37, 0xe4, 0x16, 0x9a,
+	0x37, 0xbf, 0xd8, 0x35, 0x02, 0x6c, 0x5b, 0xa3, 0x18, 0xf6, 0xac, 0x42, 0x5a, 0x95, 0x42, 0x72,
+	0xcd, 0xe6, 0xf8, 0x9e, 0x15, 0x99, 0xfc, 0x53, 0xe4, 0x68, 0xb3, 0x49, 0x76, 0xd9, 0xd5, 0x76,
+	0xf2, 0xa3, 0x0b, 0xfb, 0xd7, 0x42, 0x3c, 0xca, 0x14, 0xad, 0x73, 0xda, 0x64, 0xf9, 0x00, 0x7a,
+	0x3a, 0x2d, 0x6d, 0x88, 0x23, 0x62, 0x4a, 0xf4, 0x1a, 0xfa, 0xf6, 0xdb, 0x4c, 0x70, 0xee, 0xf4,
+	0xe9, 0x8d, 0x4f, 0x5e, 0x53, 0x24, 0x96, 0x81, 0x8e, 0x4d, 0xf0, 0x85, 0x6e, 0xce, 0xc5, 0x46,
+	0xeb, 0x4e, 0x0f, 0xfe, 0x87, 0x6f, 0xad, 0x98, 0x7f, 0x54, 0xe8, 0xc6, 0xd5, 0x1b, 0x18, 0x48,
+	0x5e, 0x32, 0x8d, 0xfb, 0x77, 0x30, 0xd1, 0x50, 0xd0, 0x07, 0x70, 0x6d, 0xd1, 0xda, 0x18, 0xdc,
+	0xd5, 0x06, 0x58, 0xb6, 0xad, 0xdf, 0xc5, 0xbf, 0x56, 0x9e, 0x73, 0xb1, 0xf2, 0x9c, 0x3f, 0x2b,
+	0xcf, 0xf9, 0xbe, 0xf6, 0x3a, 0x17, 0x6b, 0xaf, 0xf3, 0x7b, 0xed, 0x75, 0xbe, 0x46, 0x25, 0xd7,
+	0x6c, 0x99, 0x85, 0xb9, 0x98, 0x47, 0x1f, 0xad, 0xf4, 0xcb, 0x93, 0x34, 0x53, 0x51, 0x7b, 0xdc,
+	0x67, 0x57, 0xce, 0x5b, 0x9f, 0x2f, 0xa8, 0xca, 0x76, 0xec, 0x51, 0xbf, 0xfa, 0x3b, 0x00, 0x0e,
+	0xa5, 0x87, 0xd5, 0x6b, 0x03, 0x00, 0x00,
+}
+
+func (m *IntegrityRecordProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntegrityRecordProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntegrityRecordProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintIntegrityProof(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintIntegrityProof(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SiblingOnLeft) > 0 {
+		for iNdEx := len(m.SiblingOnLeft) - 1; iNdEx >= 0; iNdEx-- {
+			i--
+			if m.SiblingOnLeft[iNdEx] {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+		}
+		i = encodeVarintIntegrityProof(dAtA, i, uint64(len(m.SiblingOnLeft)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Siblings) > 0 {
+		for iNdEx := len(m.Siblings) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Siblings[iNdEx])
+			copy(dAtA[i:], m.Siblings[iNdEx])
+			i = encodeVarintIntegrityProof(dAtA, i, uint64(len(m.Siblings[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.LeafHash) > 0 {
+		i -= len(m.LeafHash)
+		copy(dAtA[i:], m.LeafHash)
+		i = encodeVarintIntegrityProof(dAtA, i, uint64(len(m.LeafHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.LeafCount != 0 {
+		i = encodeVarintIntegrityProof(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.LeafIndex != 0 {
+		i = encodeVarintIntegrityProof(dAtA, i, uint64(m.LeafIndex))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IntegrityRecordAbsenceProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntegrityRecordAbsenceProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntegrityRecordAbsenceProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RightProof != nil {
+		{
+			size, err := m.RightProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIntegrityProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Right != nil {
+		{
+			size, err := m.Right.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIntegrityProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.LeftProof != nil {
+		{
+			size, err := m.LeftProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIntegrityProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Left != nil {
+		{
+			size, err := m.Left.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintIntegrityProof(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintIntegrityProof(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIntegrityProof(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIntegrityProof(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IntegrityRecordProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.LeafIndex != 0 {
+		n += 1 + sovIntegrityProof(uint64(m.LeafIndex))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovIntegrityProof(uint64(m.LeafCount))
+	}
+	l = len(m.LeafHash)
+	if l > 0 {
+		n += 1 + l + sovIntegrityProof(uint64(l))
+	}
+	if len(m.Siblings) > 0 {
+		for _, s := range m.Siblings {
+			l = len(s)
+			n += 1 + l + sovIntegrityProof(uint64(l))
+		}
+	}
+	if len(m.SiblingOnLeft) > 0 {
+		n += 1 + sovIntegrityProof(uint64(len(m.SiblingOnLeft))) + len(m.SiblingOnLeft)*1
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovIntegrityProof(uint64(m.HashScheme))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovIntegrityProof(uint64(m.HashAlgorithm))
+	}
+	return n
+}
+
+func (m *IntegrityRecordAbsenceProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovIntegrityProof(uint64(l))
+	}
+	if m.Left != nil {
+		l = m.Left.Size()
+		n += 1 + l + sovIntegrityProof(uint64(l))
+	}
+	if m.LeftProof != nil {
+		l = m.LeftProof.Size()
+		n += 1 + l + sovIntegrityProof(uint64(l))
+	}
+	if m.Right != nil {
+		l = m.Right.Size()
+		n += 1 + l + sovIntegrityProof(uint64(l))
+	}
+	if m.RightProof != nil {
+		l = m.RightProof.Size()
+		n += 1 + l + sovIntegrityProof(uint64(l))
+	}
+	return n
+}
+
+func sovIntegrityProof(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIntegrityProof(x uint64) (n int) {
+	return sovIntegrityProof(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IntegrityRecordProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntegrityProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntegrityRecordProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntegrityRecordProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafIndex", wireType)
+			}
+			m.LeafIndex = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafIndex |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LeafHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Siblings", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Siblings = append(m.Siblings, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIntegrityProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.SiblingOnLeft = append(m.SiblingOnLeft, bool(v != 0))
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowIntegrityProof
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthIntegrityProof
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthIntegrityProof
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				elementCount = packedLen
+				if elementCount != 0 && len(m.SiblingOnLeft) == 0 {
+					m.SiblingOnLeft = make([]bool, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowIntegrityProof
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.SiblingOnLeft = append(m.SiblingOnLeft, bool(v != 0))
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field SiblingOnLeft", wireType)
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntegrityProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntegrityRecordAbsenceProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntegrityProof
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntegrityRecordAbsenceProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntegrityRecordAbsenceProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Left", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Left == nil {
+				m.Left = &IntegrityRecord{}
+			}
+			if err := m.Left.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeftProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LeftProof == nil {
+				m.LeftProof = &IntegrityRecordProof{}
+			}
+			if err := m.LeftProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Right", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Right == nil {
+				m.Right = &IntegrityRecord{}
+			}
+			if err := m.Right.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RightProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RightProof == nil {
+				m.RightProof = &IntegrityRecordProof{}
+			}
+			if err := m.RightProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntegrityProof(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntegrityProof
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIntegrityProof(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIntegrityProof
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntegrityProof
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIntegrityProof
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIntegrityProof
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIntegrityProof
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIntegrityProof        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIntegrityProof          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIntegrityProof = fmt.Errorf("proto: unexpected end of group")
+)
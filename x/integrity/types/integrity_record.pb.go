@@ -83,8 +83,112 @@ func (m *IntegrityRecord) GetCiphertext() string {
 	return ""
 }
 
+// IntegrityRecordRevocation marks a record whose underlying business fact was retracted. The committed
+// set and its root stay unchanged; the marker is stored next to the record under the same key.
+type IntegrityRecordRevocation struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Period string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Tag    string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// reason_code is an application defined, non-zero code for the retraction.
+	ReasonCode    uint32 `protobuf:"varint,5,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+	RevokedBy     string `protobuf:"bytes,6,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	RevokedHeight uint64 `protobuf:"varint,7,opt,name=revoked_height,json=revokedHeight,proto3" json:"revoked_height,omitempty"`
+	RevokedTime   string `protobuf:"bytes,8,opt,name=revoked_time,json=revokedTime,proto3" json:"revoked_time,omitempty"`
+}
+
+func (m *IntegrityRecordRevocation) Reset()         { *m = IntegrityRecordRevocation{} }
+func (m *IntegrityRecordRevocation) String() string { return proto.CompactTextString(m) }
+func (*IntegrityRecordRevocation) ProtoMessage()    {}
+func (*IntegrityRecordRevocation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19b0e68132b65075, []int{1}
+}
+func (m *IntegrityRecordRevocation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntegrityRecordRevocation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntegrityRecordRevocation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntegrityRecordRevocation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrityRecordRevocation.Merge(m, src)
+}
+func (m *IntegrityRecordRevocation) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntegrityRecordRevocation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrityRecordRevocation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrityRecordRevocation proto.InternalMessageInfo
+
+func (m *IntegrityRecordRevocation) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *IntegrityRecordRevocation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *IntegrityRecordRevocation) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *IntegrityRecordRevocation) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
+}
+
+func (m *IntegrityRecordRevocation) GetReasonCode() uint32 {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return 0
+}
+
+func (m *IntegrityRecordRevocation) GetRevokedBy() string {
+	if m != nil {
+		return m.RevokedBy
+	}
+	return ""
+}
+
+func (m *IntegrityRecordRevocation) GetRevokedHeight() uint64 {
+	if m != nil {
+		return m.RevokedHeight
+	}
+	return 0
+}
+
+func (m *IntegrityRecordRevocation) GetRevokedTime() string {
+	if m != nil {
+		return m.RevokedTime
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*IntegrityRecord)(nil), "kudora.integrity.v1.IntegrityRecord")
+	proto.RegisterType((*IntegrityRecordRevocation)(nil), "kudora.integrity.v1.IntegrityRecordRevocation")
 }
 
 func init() {
@@ -92,20 +196,28 @@ func init() {
 }
 
 var fileDescriptor_19b0e68132b65075 = []byte{
-	// 206 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xca, 0x2e, 0x4d, 0xc9,
-	0x2f, 0x4a, 0xd4, 0xcf, 0xcc, 0x2b, 0x49, 0x4d, 0x2f, 0xca, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0x44,
-	0x70, 0xe2, 0x8b, 0x52, 0x93, 0xf3, 0x8b, 0x52, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x84,
-	0x21, 0x6a, 0xf5, 0xe0, 0xd2, 0x7a, 0x65, 0x86, 0x4a, 0x91, 0x5c, 0xfc, 0x9e, 0x30, 0x7e, 0x10,
-	0x58, 0xb5, 0x90, 0x00, 0x17, 0x73, 0x49, 0x62, 0xba, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10,
-	0x88, 0x29, 0x24, 0xc2, 0xc5, 0x9a, 0x97, 0x9f, 0x97, 0x9c, 0x2a, 0xc1, 0x04, 0x16, 0x83, 0x70,
-	0x84, 0xe4, 0xb8, 0xb8, 0x92, 0x33, 0x0b, 0x32, 0x52, 0x8b, 0x4a, 0x52, 0x2b, 0x4a, 0x24, 0x98,
-	0xc1, 0x52, 0x48, 0x22, 0x4e, 0x81, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0,
-	0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10,
-	0x65, 0x9e, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0x1f, 0x96, 0x98, 0x93,
-	0x9a, 0x57, 0x92, 0x99, 0xe7, 0x54, 0x94, 0x9a, 0x92, 0x9a, 0x9b, 0x5a, 0x5c, 0x52, 0x94, 0xaa,
-	0x0f, 0xf5, 0x54, 0x05, 0x92, 0xb7, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x3e, 0x31,
-	0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xa5, 0x14, 0x52, 0x89, 0xf7, 0x00, 0x00, 0x00,
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4e, 0x2a, 0x31,
+	0x14, 0xc6, 0xe9, 0xe5, 0xcf, 0xbd, 0x1c, 0x2e, 0xf7, 0x9a, 0x6a, 0x4c, 0x5d, 0x58, 0x91, 0xc4,
+	0x84, 0x98, 0x38, 0x13, 0xe2, 0x1b, 0xe0, 0x46, 0xa2, 0xab, 0x89, 0x1b, 0xdd, 0x90, 0xf9, 0x73,
+	0x32, 0xd3, 0x10, 0xda, 0x49, 0x29, 0x13, 0xe6, 0x2d, 0x7c, 0x2c, 0x97, 0x2c, 0x5d, 0x1a, 0x78,
+	0x0c, 0x37, 0x86, 0xd2, 0x11, 0xe2, 0xee, 0x7c, 0xbf, 0xf3, 0xf5, 0x9c, 0xd3, 0x7c, 0x70, 0x3d,
+	0x5d, 0x24, 0x4a, 0x87, 0xbe, 0x90, 0x06, 0x53, 0x2d, 0x4c, 0xe9, 0x17, 0xc3, 0xbd, 0x98, 0x68,
+	0x8c, 0x95, 0x4e, 0xbc, 0x5c, 0x2b, 0xa3, 0xe8, 0xf1, 0xce, 0xeb, 0x7d, 0xb7, 0xbd, 0x62, 0xd8,
+	0x7f, 0x86, 0xff, 0xe3, 0x4a, 0x07, 0xd6, 0x4d, 0x8f, 0xa0, 0x6e, 0xc2, 0x94, 0x91, 0x1e, 0x19,
+	0xb4, 0x83, 0x6d, 0x49, 0x4f, 0xa0, 0x29, 0x95, 0x8c, 0x91, 0xfd, 0xb2, 0x6c, 0x27, 0x28, 0x07,
+	0x88, 0x45, 0x9e, 0xa1, 0x36, 0xb8, 0x34, 0xac, 0x6e, 0x5b, 0x07, 0xa4, 0xff, 0x49, 0xe0, 0xec,
+	0xc7, 0xec, 0x00, 0x0b, 0x15, 0x87, 0x46, 0x28, 0x49, 0x4f, 0xa1, 0x65, 0x50, 0x86, 0xd2, 0xb8,
+	0x45, 0x4e, 0x51, 0x0a, 0x0d, 0x53, 0xe6, 0xd5, 0x2a, 0x5b, 0x6f, 0xbd, 0x39, 0x6a, 0xa1, 0x12,
+	0xb7, 0xc5, 0xa9, 0xea, 0xd2, 0xc6, 0xfe, 0xd2, 0x0b, 0xe8, 0x68, 0x0c, 0xe7, 0x4a, 0x4e, 0x62,
+	0x95, 0x20, 0x6b, 0xf6, 0xc8, 0xa0, 0x1b, 0xc0, 0x0e, 0xdd, 0xa9, 0x04, 0xe9, 0x39, 0x80, 0xc6,
+	0x42, 0x4d, 0x31, 0x99, 0x44, 0x25, 0x6b, 0xd9, 0x97, 0x6d, 0x47, 0x46, 0x25, 0xbd, 0x82, 0x7f,
+	0x55, 0x3b, 0x43, 0x91, 0x66, 0x86, 0xfd, 0xee, 0x91, 0x41, 0x23, 0xe8, 0x3a, 0x7a, 0x6f, 0x21,
+	0xbd, 0x84, 0xbf, 0x95, 0xcd, 0x88, 0x19, 0xb2, 0x3f, 0x76, 0x4e, 0xc7, 0xb1, 0x27, 0x31, 0xc3,
+	0xd1, 0xf8, 0x6d, 0xcd, 0xc9, 0x6a, 0xcd, 0xc9, 0xc7, 0x9a, 0x93, 0xd7, 0x0d, 0xaf, 0xad, 0x36,
+	0xbc, 0xf6, 0xbe, 0xe1, 0xb5, 0x17, 0x3f, 0x15, 0x26, 0x5b, 0x44, 0x5e, 0xac, 0x66, 0xfe, 0x83,
+	0x8d, 0xe4, 0xe6, 0x31, 0x8c, 0xe6, 0xbe, 0x8b, 0x72, 0x79, 0x10, 0xe6, 0xf6, 0xf7, 0xf3, 0xa8,
+	0x65, 0xf3, 0xbb, 0xfd, 0x1a, 0x00, 0x05, 0x5d, 0x53, 0xce, 0xed, 0x01, 0x00, 0x00,
 }
 
 func (m *IntegrityRecord) Marshal() (dAtA []byte, err error) {
@@ -152,6 +264,81 @@ func (m *IntegrityRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *IntegrityRecordRevocation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntegrityRecordRevocation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntegrityRecordRevocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevokedTime) > 0 {
+		i -= len(m.RevokedTime)
+		copy(dAtA[i:], m.RevokedTime)
+		i = encodeVarintIntegrityRecord(dAtA, i, uint64(len(m.RevokedTime)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.RevokedHeight != 0 {
+		i = encodeVarintIntegrityRecord(dAtA, i, uint64(m.RevokedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RevokedBy) > 0 {
+		i -= len(m.RevokedBy)
+		copy(dAtA[i:], m.RevokedBy)
+		i = encodeVarintIntegrityRecord(dAtA, i, uint64(len(m.RevokedBy)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ReasonCode != 0 {
+		i = encodeVarintIntegrityRecord(dAtA, i, uint64(m.ReasonCode))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintIntegrityRecord(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintIntegrityRecord(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintIntegrityRecord(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintIntegrityRecord(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintIntegrityRecord(dAtA []byte, offset int, v uint64) int {
 	offset -= sovIntegrityRecord(v)
 	base := offset
@@ -184,6 +371,45 @@ func (m *IntegrityRecord) Size() (n int) {
 	return n
 }
 
+func (m *IntegrityRecordRevocation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovIntegrityRecord(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovIntegrityRecord(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovIntegrityRecord(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovIntegrityRecord(uint64(l))
+	}
+	if m.ReasonCode != 0 {
+		n += 1 + sovIntegrityRecord(uint64(m.ReasonCode))
+	}
+	l = len(m.RevokedBy)
+	if l > 0 {
+		n += 1 + l + sovIntegrityRecord(uint64(l))
+	}
+	if m.RevokedHeight != 0 {
+		n += 1 + sovIntegrityRecord(uint64(m.RevokedHeight))
+	}
+	l = len(m.RevokedTime)
+	if l > 0 {
+		n += 1 + l + sovIntegrityRecord(uint64(l))
+	}
+	return n
+}
+
 func sovIntegrityRecord(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -336,6 +562,286 @@ func (m *IntegrityRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *IntegrityRecordRevocation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntegrityRecord
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntegrityRecordRevocation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntegrityRecordRevocation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
+			}
+			m.ReasonCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReasonCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedHeight", wireType)
+			}
+			m.RevokedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntegrityRecord(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntegrityRecord
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipIntegrityRecord(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,35 @@
+package types
+
+import "slices"
+
+// NormalizeRevocationTags normalizes the tags of one revocation and returns them in tag order.
+func NormalizeRevocationTags(tags []string) ([]string, error) {
+	if len(tags) == 0 {
+		return nil, ErrInvalidRevocation.Wrap("at least one tag is required")
+	}
+
+	normalized := make([]string, len(tags))
+	for i, tag := range tags {
+		tag, err := NormalizeTag(tag)
+		if err != nil {
+			return nil, err
+		}
+		normalized[i] = tag
+	}
+	slices.Sort(normalized)
+	for i := 1; i < len(normalized); i++ {
+		if normalized[i] == normalized[i-1] {
+			return nil, ErrDuplicateTag.Wrapf("tag %s is revoked more than once", normalized[i])
+		}
+	}
+
+	return normalized, nil
+}
+
+// ValidateRevocationReasonCode rejects the zero reason code, which is reserved for "unspecified".
+func ValidateRevocationReasonCode(reasonCode uint32) error {
+	if reasonCode == 0 {
+		return ErrInvalidRevocation.Wrap("reason code must be non-zero")
+	}
+	return nil
+}
@@ -23,6 +23,100 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// HashScheme defines how leaves and inner nodes of the record Merkle tree are hashed.
+type HashScheme int32
+
+const (
+	// HASH_SCHEME_V1 hashes leaves as H(leaf) and inner nodes as H(left || right), with H the hash
+	// algorithm of the set, and duplicates the last node of an odd-sized level. It is the zero value so
+	// that roots committed before hash schemes existed keep verifying.
+	HashScheme_HASH_SCHEME_V1 HashScheme = 0
+	// HASH_SCHEME_V2 follows RFC 6962: leaves are hashed as H(0x00 || leaf) and inner nodes as
+	// H(0x01 || left || right), with H the hash algorithm of the set. The last node of an odd-sized level
+	// is promoted unchanged.
+	HashScheme_HASH_SCHEME_V2 HashScheme = 1
+)
+
+var HashScheme_name = map[int32]string{
+	0: "HASH_SCHEME_V1",
+	1: "HASH_SCHEME_V2",
+}
+
+var HashScheme_value = map[string]int32{
+	"HASH_SCHEME_V1": 0,
+	"HASH_SCHEME_V2": 1,
+}
+
+func (x HashScheme) String() string {
+	return proto.EnumName(HashScheme_name, int32(x))
+}
+
+func (HashScheme) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48d16ea7dcb2350a, []int{0}
+}
+
+// HashAlgorithm defines the hash function of the record Merkle tree. Every algorithm produces
+// 32-byte digests; Params.allowed_hash_algorithms governs which ones new sets may use.
+type HashAlgorithm int32
+
+const (
+	// HASH_ALGORITHM_SHA256 is SHA-256. It is the zero value so that existing roots keep verifying.
+	HashAlgorithm_HASH_ALGORITHM_SHA256 HashAlgorithm = 0
+	// HASH_ALGORITHM_KECCAK256 is the original Keccak-256 used by the EVM, not NIST SHA3-256.
+	HashAlgorithm_HASH_ALGORITHM_KECCAK256 HashAlgorithm = 1
+	// HASH_ALGORITHM_BLAKE3 is BLAKE3 with a 32-byte output.
+	HashAlgorithm_HASH_ALGORITHM_BLAKE3 HashAlgorithm = 2
+)
+
+var HashAlgorithm_name = map[int32]string{
+	0: "HASH_ALGORITHM_SHA256",
+	1: "HASH_ALGORITHM_KECCAK256",
+	2: "HASH_ALGORITHM_BLAKE3",
+}
+
+var HashAlgorithm_value = map[string]int32{
+	"HASH_ALGORITHM_SHA256":    0,
+	"HASH_ALGORITHM_KECCAK256": 1,
+	"HASH_ALGORITHM_BLAKE3":    2,
+}
+
+func (x HashAlgorithm) String() string {
+	return proto.EnumName(HashAlgorithm_name, int32(x))
+}
+
+func (HashAlgorithm) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48d16ea7dcb2350a, []int{1}
+}
+
+// StorageMode defines where the records of an integrity set live.
+type StorageMode int32
+
+const (
+	// STORAGE_MODE_ON_CHAIN sets store every encrypted record on chain. It is the zero value so that
+	// sets committed before storage modes existed keep their meaning.
+	StorageMode_STORAGE_MODE_ON_CHAIN StorageMode = 0
+	// STORAGE_MODE_ROOT_ONLY sets only anchor the root and record count; records stay off chain.
+	StorageMode_STORAGE_MODE_ROOT_ONLY StorageMode = 1
+)
+
+var StorageMode_name = map[int32]string{
+	0: "STORAGE_MODE_ON_CHAIN",
+	1: "STORAGE_MODE_ROOT_ONLY",
+}
+
+var StorageMode_value = map[string]int32{
+	"STORAGE_MODE_ON_CHAIN":  0,
+	"STORAGE_MODE_ROOT_ONLY": 1,
+}
+
+func (x StorageMode) String() string {
+	return proto.EnumName(StorageMode_name, int32(x))
+}
+
+func (StorageMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_48d16ea7dcb2350a, []int{2}
+}
+
 // IntegritySet defines the IntegritySet message.
 type IntegritySet struct {
 	Tenant      string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
@@ -33,6 +127,32 @@ type IntegritySet struct {
 	BlockHeight uint64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
 	BlockTime   string `protobuf:"bytes,7,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
 	RecordCount uint64 `protobuf:"varint,8,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	// version starts at 1 and increases by one each time the set is superseded.
+	Version uint64 `protobuf:"varint,9,opt,name=version,proto3" json:"version,omitempty"`
+	// previous_root is the root of the version this set superseded. Empty for version 1.
+	PreviousRoot string `protobuf:"bytes,10,opt,name=previous_root,json=previousRoot,proto3" json:"previous_root,omitempty"`
+	// reason_hash commits to the off-chain reason for the supersede. Empty for version 1.
+	ReasonHash string `protobuf:"bytes,11,opt,name=reason_hash,json=reasonHash,proto3" json:"reason_hash,omitempty"`
+	// open is true while records can still be appended. root is then the rolling root over the
+	// records appended so far. Sealed sets are immutable.
+	Open bool `protobuf:"varint,12,opt,name=open,proto3" json:"open,omitempty"`
+	// storage_mode tells whether the records of the set are stored on chain.
+	StorageMode StorageMode `protobuf:"varint,13,opt,name=storage_mode,json=storageMode,proto3,enum=kudora.integrity.v1.StorageMode" json:"storage_mode,omitempty"`
+	// storage_uri optionally points to where a root-only set keeps its records off chain.
+	StorageUri string `protobuf:"bytes,14,opt,name=storage_uri,json=storageUri,proto3" json:"storage_uri,omitempty"`
+	// storage_digest optionally commits to the off-chain record bundle of a root-only set.
+	StorageDigest string `protobuf:"bytes,15,opt,name=storage_digest,json=storageDigest,proto3" json:"storage_digest,omitempty"`
+	// hash_scheme is the Merkle hashing scheme the root was built with.
+	HashScheme HashScheme `protobuf:"varint,16,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	// hash_algorithm is the hash function used for every leaf and inner node of the root.
+	HashAlgorithm HashAlgorithm `protobuf:"varint,17,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=kudora.integrity.v1.HashAlgorithm" json:"hash_algorithm,omitempty"`
+	// chain_previous_period is the period of the set this one links to in its tenant and type chain.
+	// Empty when the set starts a chain.
+	ChainPreviousPeriod string `protobuf:"bytes,18,opt,name=chain_previous_period,json=chainPreviousPeriod,proto3" json:"chain_previous_period,omitempty"`
+	// chain_previous_root is the root the linked set had when this set was committed.
+	ChainPreviousRoot string `protobuf:"bytes,19,opt,name=chain_previous_root,json=chainPreviousRoot,proto3" json:"chain_previous_root,omitempty"`
+	// chain_length counts the linked sets ending with this one. Zero for sets outside any chain.
+	ChainLength uint64 `protobuf:"varint,20,opt,name=chain_length,json=chainLength,proto3" json:"chain_length,omitempty"`
 }
 
 func (m *IntegritySet) Reset()         { *m = IntegritySet{} }
@@ -124,6 +244,90 @@ func (m *IntegritySet) GetRecordCount() uint64 {
 	return 0
 }
 
+func (m *IntegritySet) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *IntegritySet) GetPreviousRoot() string {
+	if m != nil {
+		return m.PreviousRoot
+	}
+	return ""
+}
+
+func (m *IntegritySet) GetReasonHash() string {
+	if m != nil {
+		return m.ReasonHash
+	}
+	return ""
+}
+
+func (m *IntegritySet) GetOpen() bool {
+	if m != nil {
+		return m.Open
+	}
+	return false
+}
+
+func (m *IntegritySet) GetStorageMode() StorageMode {
+	if m != nil {
+		return m.StorageMode
+	}
+	return StorageMode_STORAGE_MODE_ON_CHAIN
+}
+
+func (m *IntegritySet) GetStorageUri() string {
+	if m != nil {
+		return m.StorageUri
+	}
+	return ""
+}
+
+func (m *IntegritySet) GetStorageDigest() string {
+	if m != nil {
+		return m.StorageDigest
+	}
+	return ""
+}
+
+func (m *IntegritySet) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_V1
+}
+
+func (m *IntegritySet) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_HASH_ALGORITHM_SHA256
+}
+
+func (m *IntegritySet) GetChainPreviousPeriod() string {
+	if m != nil {
+		return m.ChainPreviousPeriod
+	}
+	return ""
+}
+
+func (m *IntegritySet) GetChainPreviousRoot() string {
+	if m != nil {
+		return m.ChainPreviousRoot
+	}
+	return ""
+}
+
+func (m *IntegritySet) GetChainLength() uint64 {
+	if m != nil {
+		return m.ChainLength
+	}
+	return 0
+}
+
 // IntegritySetBundle defines one integrity set together with its sorted records.
 type IntegritySetBundle struct {
 	Set     IntegritySet      `protobuf:"bytes,1,opt,name=set,proto3" json:"set"`
@@ -178,6 +382,9 @@ func (m *IntegritySetBundle) GetRecords() []IntegrityRecord {
 }
 
 func init() {
+	proto.RegisterEnum("kudora.integrity.v1.HashScheme", HashScheme_name, HashScheme_value)
+	proto.RegisterEnum("kudora.integrity.v1.HashAlgorithm", HashAlgorithm_name, HashAlgorithm_value)
+	proto.RegisterEnum("kudora.integrity.v1.StorageMode", StorageMode_name, StorageMode_value)
 	proto.RegisterType((*IntegritySet)(nil), "kudora.integrity.v1.IntegritySet")
 	proto.RegisterType((*IntegritySetBundle)(nil), "kudora.integrity.v1.IntegritySetBundle")
 }
@@ -187,31 +394,53 @@ func init() {
 }
 
 var fileDescriptor_48d16ea7dcb2350a = []byte{
-	// 371 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x6e, 0xda, 0x40,
-	0x10, 0x86, 0xbd, 0xe0, 0x42, 0x59, 0x38, 0x6d, 0xab, 0x6a, 0x85, 0x54, 0x17, 0x50, 0xa5, 0xa2,
-	0x1e, 0x6c, 0x41, 0x0f, 0x55, 0xaf, 0xb4, 0x87, 0xe6, 0x18, 0x27, 0xca, 0x21, 0x17, 0x64, 0xec,
-	0x91, 0x59, 0x81, 0xbd, 0x68, 0x3d, 0x46, 0xe1, 0x2d, 0x72, 0xc9, 0x3b, 0x71, 0xe4, 0x98, 0x53,
-	0x14, 0x81, 0xf2, 0x1e, 0xd1, 0xee, 0x42, 0x42, 0xa4, 0x88, 0xdb, 0xcc, 0x3f, 0xdf, 0x3f, 0xab,
-	0xd9, 0x9f, 0xfe, 0x98, 0x95, 0x89, 0x54, 0x51, 0x20, 0x72, 0x84, 0x54, 0x09, 0x5c, 0x05, 0xcb,
-	0xc1, 0x6b, 0x33, 0x2e, 0x00, 0xfd, 0x85, 0x92, 0x28, 0xd9, 0x27, 0x0b, 0xfa, 0x2f, 0x33, 0x7f,
-	0x39, 0x68, 0x7f, 0x4e, 0x65, 0x2a, 0xcd, 0x3c, 0xd0, 0x95, 0x45, 0xdb, 0x3f, 0x4f, 0xef, 0x54,
-	0x10, 0x4b, 0x95, 0x58, 0xb6, 0xf7, 0x44, 0x68, 0xeb, 0xec, 0x30, 0xba, 0x00, 0x64, 0x5f, 0x68,
-	0x0d, 0x21, 0x8f, 0x72, 0xe4, 0xa4, 0x43, 0xfa, 0x8d, 0x70, 0xdf, 0x31, 0x46, 0x5d, 0x5c, 0x2d,
-	0x80, 0x57, 0x8c, 0x6a, 0x6a, 0xcd, 0x2e, 0x40, 0x09, 0x99, 0xf0, 0xaa, 0x65, 0x6d, 0xa7, 0x59,
-	0x25, 0x25, 0x72, 0xd7, 0xb2, 0xba, 0x66, 0x9c, 0xd6, 0x63, 0x05, 0x11, 0x4a, 0xc5, 0x3f, 0x18,
-	0xf9, 0xd0, 0xb2, 0x2e, 0x6d, 0x4d, 0xe6, 0x32, 0x9e, 0x8d, 0xa7, 0x20, 0xd2, 0x29, 0xf2, 0x5a,
-	0x87, 0xf4, 0xdd, 0xb0, 0x69, 0xb4, 0xff, 0x46, 0x62, 0x5f, 0x29, 0xb5, 0x08, 0x8a, 0x0c, 0x78,
-	0xdd, 0xf8, 0x1b, 0x46, 0xb9, 0x14, 0x19, 0xe8, 0x0d, 0xf6, 0xa8, 0x71, 0x2c, 0xcb, 0x1c, 0xf9,
-	0x47, 0xbb, 0xc1, 0x6a, 0x7f, 0xb5, 0xd4, 0xbb, 0x23, 0x94, 0x1d, 0xdf, 0x39, 0x2a, 0xf3, 0x64,
-	0x0e, 0xec, 0x0f, 0xad, 0x16, 0x60, 0x4f, 0x6d, 0x0e, 0xbb, 0xfe, 0x3b, 0x7f, 0xec, 0xbf, 0x71,
-	0xb9, 0xeb, 0x87, 0x6f, 0x4e, 0xa8, 0x3d, 0xec, 0x1f, 0xad, 0xdb, 0x07, 0x0a, 0x5e, 0xe9, 0x54,
-	0xfb, 0xcd, 0xe1, 0xf7, 0xd3, 0xf6, 0xd0, 0xc0, 0xfb, 0x0d, 0x07, 0xeb, 0xe8, 0x7c, 0xbd, 0xf5,
-	0xc8, 0x66, 0xeb, 0x91, 0xc7, 0xad, 0x47, 0x6e, 0x77, 0x9e, 0xb3, 0xd9, 0x79, 0xce, 0xfd, 0xce,
-	0x73, 0xae, 0x7f, 0xa7, 0x02, 0xa7, 0xe5, 0xc4, 0x8f, 0x65, 0x16, 0x5c, 0x45, 0x73, 0xc8, 0x51,
-	0xe4, 0x23, 0x05, 0x09, 0x64, 0x50, 0xa0, 0x82, 0x60, 0x1f, 0xf2, 0xcd, 0x51, 0xcc, 0x3a, 0x94,
-	0x62, 0x52, 0x33, 0xc9, 0xfe, 0x7a, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x83, 0x67, 0x46, 0x89, 0x5b,
-	0x02, 0x00, 0x00,
+	// 723 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0xcf, 0x6f, 0x1a, 0x39,
+	0x14, 0xc7, 0x99, 0x84, 0xfc, 0xf2, 0x00, 0x4b, 0x4c, 0x12, 0x79, 0xa3, 0x5d, 0x42, 0xb2, 0xbb,
+	0x5a, 0x84, 0xb4, 0xa0, 0x90, 0xdd, 0x95, 0x7a, 0xeb, 0x04, 0x50, 0x06, 0x01, 0x21, 0x1a, 0x68,
+	0xa5, 0xf6, 0x32, 0x1a, 0x06, 0x6b, 0x66, 0x14, 0x18, 0x23, 0x8f, 0x41, 0xcd, 0x7f, 0xd1, 0x4b,
+	0xff, 0xa7, 0x1c, 0x73, 0xec, 0xa9, 0xaa, 0x92, 0xff, 0xa2, 0xa7, 0xca, 0xcf, 0x4c, 0x02, 0x29,
+	0xcd, 0xcd, 0xef, 0xfb, 0xfd, 0xbc, 0xe7, 0x67, 0xfb, 0xc9, 0xe8, 0xef, 0xeb, 0xe9, 0x90, 0x71,
+	0xa7, 0x12, 0x84, 0x82, 0x7a, 0x3c, 0x10, 0x37, 0x95, 0xd9, 0xe9, 0x53, 0x60, 0x47, 0x54, 0x94,
+	0x27, 0x9c, 0x09, 0x86, 0x73, 0x0a, 0x2c, 0x3f, 0x7a, 0xe5, 0xd9, 0xe9, 0xe1, 0x9e, 0xc7, 0x3c,
+	0x06, 0x7e, 0x45, 0xae, 0x14, 0x7a, 0x58, 0x7a, 0xb9, 0x26, 0xa7, 0x2e, 0xe3, 0x43, 0xc5, 0x9e,
+	0x7c, 0xdb, 0x40, 0xa9, 0x66, 0x6c, 0xf5, 0xa8, 0xc0, 0x07, 0x68, 0x53, 0xd0, 0xd0, 0x09, 0x05,
+	0xd1, 0x0a, 0x5a, 0x71, 0xc7, 0x9a, 0x47, 0x18, 0xa3, 0xa4, 0xb8, 0x99, 0x50, 0xb2, 0x06, 0x2a,
+	0xac, 0x25, 0x3b, 0xa1, 0x3c, 0x60, 0x43, 0xb2, 0xae, 0x58, 0x15, 0x49, 0x96, 0x33, 0x26, 0x48,
+	0x52, 0xb1, 0x72, 0x8d, 0x09, 0xda, 0x72, 0x39, 0x75, 0x04, 0xe3, 0x64, 0x03, 0xe4, 0x38, 0xc4,
+	0xc7, 0x28, 0x35, 0x18, 0x31, 0xf7, 0xda, 0xf6, 0x69, 0xe0, 0xf9, 0x82, 0x6c, 0x16, 0xb4, 0x62,
+	0xd2, 0xd2, 0x41, 0x33, 0x41, 0xc2, 0xbf, 0x23, 0xa4, 0x10, 0x11, 0x8c, 0x29, 0xd9, 0x82, 0xfc,
+	0x1d, 0x50, 0xfa, 0xc1, 0x98, 0xca, 0x0a, 0xea, 0x50, 0xb6, 0xcb, 0xa6, 0xa1, 0x20, 0xdb, 0xaa,
+	0x82, 0xd2, 0x6a, 0x52, 0x92, 0xdb, 0xcf, 0x28, 0x8f, 0x02, 0x16, 0x92, 0x1d, 0x70, 0xe3, 0x10,
+	0xff, 0x81, 0xd2, 0x13, 0x4e, 0x67, 0x01, 0x9b, 0x46, 0x36, 0x74, 0x8d, 0xa0, 0x7c, 0x2a, 0x16,
+	0x2d, 0xd9, 0xfd, 0x11, 0xd2, 0x39, 0x75, 0x22, 0x16, 0xda, 0xbe, 0x13, 0xf9, 0x44, 0x07, 0x04,
+	0x29, 0xc9, 0x74, 0x22, 0x5f, 0x1e, 0x99, 0x4d, 0x68, 0x48, 0x52, 0x05, 0xad, 0xb8, 0x6d, 0xc1,
+	0x1a, 0xd7, 0x50, 0x2a, 0x12, 0x8c, 0x3b, 0x1e, 0xb5, 0xc7, 0x6c, 0x48, 0x49, 0xba, 0xa0, 0x15,
+	0x33, 0xd5, 0x42, 0x79, 0xc5, 0x4b, 0x96, 0x7b, 0x0a, 0xec, 0xb0, 0x21, 0xb5, 0xf4, 0xe8, 0x29,
+	0x90, 0x3b, 0xc7, 0x45, 0xa6, 0x3c, 0x20, 0x19, 0xb5, 0xf3, 0x5c, 0x7a, 0xc3, 0x03, 0xfc, 0x17,
+	0xca, 0xc4, 0xc0, 0x30, 0xf0, 0x68, 0x24, 0xc8, 0x2f, 0xc0, 0xa4, 0xe7, 0x6a, 0x1d, 0x44, 0xfc,
+	0x1a, 0xe9, 0xb2, 0x75, 0x3b, 0x72, 0x7d, 0x3a, 0xa6, 0x24, 0x0b, 0xbd, 0x1c, 0xad, 0xec, 0x45,
+	0x1e, 0xa8, 0x07, 0x98, 0x85, 0xfc, 0xc7, 0x35, 0x6e, 0xa2, 0x0c, 0x54, 0x70, 0x46, 0x1e, 0xe3,
+	0x81, 0xf0, 0xc7, 0x64, 0x17, 0x8a, 0x9c, 0xfc, 0xb4, 0x88, 0x11, 0x93, 0x56, 0xda, 0x5f, 0x0c,
+	0x71, 0x15, 0xed, 0xbb, 0xbe, 0x13, 0x84, 0xf6, 0xe3, 0xcd, 0xcf, 0xe7, 0x08, 0x43, 0xeb, 0x39,
+	0x30, 0xaf, 0xe6, 0xde, 0x15, 0x58, 0xb8, 0x8c, 0x72, 0xcf, 0x72, 0xe0, 0xb5, 0x72, 0x90, 0xb1,
+	0xbb, 0x94, 0x01, 0x4f, 0x76, 0x8c, 0x52, 0x8a, 0x1f, 0xd1, 0xd0, 0x13, 0x3e, 0xd9, 0x53, 0x43,
+	0x01, 0x5a, 0x1b, 0xa4, 0x93, 0x4f, 0x1a, 0xc2, 0x8b, 0xc3, 0x7f, 0x3e, 0x0d, 0x87, 0x23, 0x8a,
+	0x5f, 0xa1, 0xf5, 0x88, 0xaa, 0xf9, 0xd7, 0xab, 0xc7, 0x2b, 0x4f, 0xb7, 0x94, 0x95, 0xbc, 0xfd,
+	0x72, 0x94, 0xb0, 0x64, 0x0e, 0xae, 0xa3, 0x2d, 0x35, 0x75, 0x11, 0x59, 0x2b, 0xac, 0x17, 0xf5,
+	0xea, 0x9f, 0x2f, 0xa7, 0x5b, 0x00, 0xcf, 0x2b, 0xc4, 0xa9, 0xa5, 0x7f, 0x11, 0x7a, 0x7a, 0x03,
+	0x8c, 0x51, 0xc6, 0x34, 0x7a, 0xa6, 0xdd, 0xab, 0x99, 0x8d, 0x4e, 0xc3, 0x7e, 0x7b, 0x9a, 0x4d,
+	0xfc, 0xa0, 0x55, 0xb3, 0x5a, 0xc9, 0x45, 0xe9, 0xa5, 0x4b, 0xc7, 0xbf, 0xa2, 0x7d, 0x80, 0x8c,
+	0xf6, 0x45, 0xd7, 0x6a, 0xf6, 0xcd, 0x8e, 0xdd, 0x33, 0x8d, 0xea, 0x7f, 0xff, 0x67, 0x13, 0xf8,
+	0x37, 0x44, 0x9e, 0x59, 0xad, 0x46, 0xad, 0x66, 0xb4, 0xa4, 0xab, 0xad, 0x48, 0x3c, 0x6f, 0x1b,
+	0xad, 0xc6, 0x59, 0x76, 0xad, 0x54, 0x47, 0xfa, 0xc2, 0xa8, 0x4a, 0xb2, 0xd7, 0xef, 0x5a, 0xc6,
+	0x45, 0xc3, 0xee, 0x74, 0xeb, 0x0d, 0xbb, 0x7b, 0x69, 0xd7, 0x4c, 0xa3, 0x79, 0x99, 0x4d, 0xe0,
+	0x43, 0x74, 0xb0, 0x64, 0x59, 0xdd, 0x6e, 0xdf, 0xee, 0x5e, 0xb6, 0xdf, 0x65, 0xb5, 0xf3, 0xe6,
+	0xed, 0x7d, 0x5e, 0xbb, 0xbb, 0xcf, 0x6b, 0x5f, 0xef, 0xf3, 0xda, 0xc7, 0x87, 0x7c, 0xe2, 0xee,
+	0x21, 0x9f, 0xf8, 0xfc, 0x90, 0x4f, 0xbc, 0xaf, 0x78, 0x81, 0xf0, 0xa7, 0x83, 0xb2, 0xcb, 0xc6,
+	0x95, 0x16, 0xdc, 0xdc, 0x3f, 0x6d, 0x67, 0x10, 0x55, 0xe6, 0x5f, 0xda, 0x87, 0x85, 0x4f, 0x4d,
+	0x7e, 0x41, 0xd1, 0x60, 0x13, 0xfe, 0xb1, 0xb3, 0xef, 0x03, 0x00, 0xd8, 0xd9, 0xdf, 0xba, 0x49,
+	0x05, 0x00, 0x00,
 }
 
 func (m *IntegritySet) Marshal() (dAtA []byte, err error) {
@@ -234,6 +463,93 @@ func (m *IntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ChainLength != 0 {
+		i = encodeVarintIntegritySet(dAtA, i, uint64(m.ChainLength))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.ChainPreviousRoot) > 0 {
+		i -= len(m.ChainPreviousRoot)
+		copy(dAtA[i:], m.ChainPreviousRoot)
+		i = encodeVarintIntegritySet(dAtA, i, uint64(len(m.ChainPreviousRoot)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if len(m.ChainPreviousPeriod) > 0 {
+		i -= len(m.ChainPreviousPeriod)
+		copy(dAtA[i:], m.ChainPreviousPeriod)
+		i = encodeVarintIntegritySet(dAtA, i, uint64(len(m.ChainPreviousPeriod)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintIntegritySet(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintIntegritySet(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if len(m.StorageDigest) > 0 {
+		i -= len(m.StorageDigest)
+		copy(dAtA[i:], m.StorageDigest)
+		i = encodeVarintIntegritySet(dAtA, i, uint64(len(m.StorageDigest)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if len(m.StorageUri) > 0 {
+		i -= len(m.StorageUri)
+		copy(dAtA[i:], m.StorageUri)
+		i = encodeVarintIntegritySet(dAtA, i, uint64(len(m.StorageUri)))
+		i--
+		dAtA[i] = 0x72
+	}
+	if m.StorageMode != 0 {
+		i = encodeVarintIntegritySet(dAtA, i, uint64(m.StorageMode))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.Open {
+		i--
+		if m.Open {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.ReasonHash) > 0 {
+		i -= len(m.ReasonHash)
+		copy(dAtA[i:], m.ReasonHash)
+		i = encodeVarintIntegritySet(dAtA, i, uint64(len(m.ReasonHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.PreviousRoot) > 0 {
+		i -= len(m.PreviousRoot)
+		copy(dAtA[i:], m.PreviousRoot)
+		i = encodeVarintIntegritySet(dAtA, i, uint64(len(m.PreviousRoot)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Version != 0 {
+		i = encodeVarintIntegritySet(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.RecordCount != 0 {
 		i = encodeVarintIntegritySet(dAtA, i, uint64(m.RecordCount))
 		i--
@@ -383,6 +699,48 @@ func (m *IntegritySet) Size() (n int) {
 	if m.RecordCount != 0 {
 		n += 1 + sovIntegritySet(uint64(m.RecordCount))
 	}
+	if m.Version != 0 {
+		n += 1 + sovIntegritySet(uint64(m.Version))
+	}
+	l = len(m.PreviousRoot)
+	if l > 0 {
+		n += 1 + l + sovIntegritySet(uint64(l))
+	}
+	l = len(m.ReasonHash)
+	if l > 0 {
+		n += 1 + l + sovIntegritySet(uint64(l))
+	}
+	if m.Open {
+		n += 2
+	}
+	if m.StorageMode != 0 {
+		n += 1 + sovIntegritySet(uint64(m.StorageMode))
+	}
+	l = len(m.StorageUri)
+	if l > 0 {
+		n += 1 + l + sovIntegritySet(uint64(l))
+	}
+	l = len(m.StorageDigest)
+	if l > 0 {
+		n += 1 + l + sovIntegritySet(uint64(l))
+	}
+	if m.HashScheme != 0 {
+		n += 2 + sovIntegritySet(uint64(m.HashScheme))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 2 + sovIntegritySet(uint64(m.HashAlgorithm))
+	}
+	l = len(m.ChainPreviousPeriod)
+	if l > 0 {
+		n += 2 + l + sovIntegritySet(uint64(l))
+	}
+	l = len(m.ChainPreviousRoot)
+	if l > 0 {
+		n += 2 + l + sovIntegritySet(uint64(l))
+	}
+	if m.ChainLength != 0 {
+		n += 2 + sovIntegritySet(uint64(m.ChainLength))
+	}
 	return n
 }
 
@@ -668,6 +1026,313 @@ func (m *IntegritySet) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Open", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Open = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageMode", wireType)
+			}
+			m.StorageMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StorageMode |= StorageMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainPreviousPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainPreviousPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainPreviousRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegritySet
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainPreviousRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainLength", wireType)
+			}
+			m.ChainLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegritySet
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ChainLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIntegritySet(dAtA[iNdEx:])
@@ -0,0 +1,21 @@
+package types
+
+import "strings"
+
+// NormalizeSchemaHash normalizes the 32-byte commitment to the off-chain plaintext schema of a type.
+func NormalizeSchemaHash(schemaHash string) (string, error) {
+	return normalizeFixedLengthHex(schemaHash, 32, ErrInvalidSchemaHash, "schema hash")
+}
+
+// NormalizeTypeDescription trims the optional description of a registered integrity type.
+func NormalizeTypeDescription(description string) (string, error) {
+	description = strings.TrimSpace(description)
+	switch {
+	case len(description) > MaxIntegrityTypeDescriptionLength:
+		return "", ErrInvalidType.Wrapf("description exceeds maximum length %d", MaxIntegrityTypeDescriptionLength)
+	case strings.ContainsFunc(description, func(r rune) bool { return r < 0x20 || r == 0x7f }):
+		return "", ErrInvalidType.Wrap("description must not contain control characters")
+	default:
+		return description, nil
+	}
+}
@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudora/integrity/v1/integrity_type.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// IntegrityType is the on-chain declaration of an integrity type owned by a tenant.
+type IntegrityType struct {
+	Tenant      string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type        string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// schema_hash is a 0x prefixed 32-byte commitment to the off-chain plaintext schema of the records.
+	SchemaHash string `protobuf:"bytes,4,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+	// period_format is kept equal to the period format declared for the type.
+	PeriodFormat PeriodFormat `protobuf:"varint,5,opt,name=period_format,json=periodFormat,proto3,enum=kudora.integrity.v1.PeriodFormat" json:"period_format,omitempty"`
+	// hash_scheme is the Merkle hash scheme every set of the type must use.
+	HashScheme HashScheme `protobuf:"varint,6,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	// deprecated types reject new sets; existing sets can still be superseded.
+	Deprecated       bool   `protobuf:"varint,7,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	RegisteredBy     string `protobuf:"bytes,8,opt,name=registered_by,json=registeredBy,proto3" json:"registered_by,omitempty"`
+	RegisteredHeight uint64 `protobuf:"varint,9,opt,name=registered_height,json=registeredHeight,proto3" json:"registered_height,omitempty"`
+	RegisteredTime   string `protobuf:"bytes,10,opt,name=registered_time,json=registeredTime,proto3" json:"registered_time,omitempty"`
+}
+
+func (m *IntegrityType) Reset()         { *m = IntegrityType{} }
+func (m *IntegrityType) String() string { return proto.CompactTextString(m) }
+func (*IntegrityType) ProtoMessage()    {}
+func (*IntegrityType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a1dfe9bb041a3f55, []int{0}
+}
+func (m *IntegrityType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntegrityType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntegrityType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntegrityType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegrityType.Merge(m, src)
+}
+func (m *IntegrityType) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntegrityType) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegrityType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IntegrityType proto.InternalMessageInfo
+
+func (m *IntegrityType) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *IntegrityType) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *IntegrityType) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *IntegrityType) GetSchemaHash() string {
+	if m != nil {
+		return m.SchemaHash
+	}
+	return ""
+}
+
+func (m *IntegrityType) GetPeriodFormat() PeriodFormat {
+	if m != nil {
+		return m.PeriodFormat
+	}
+	return PeriodFormat_PERIOD_FORMAT_UNSPECIFIED
+}
+
+func (m *IntegrityType) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_V1
+}
+
+func (m *IntegrityType) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+func (m *IntegrityType) GetRegisteredBy() string {
+	if m != nil {
+		return m.RegisteredBy
+	}
+	return ""
+}
+
+func (m *IntegrityType) GetRegisteredHeight() uint64 {
+	if m != nil {
+		return m.RegisteredHeight
+	}
+	return 0
+}
+
+func (m *IntegrityType) GetRegisteredTime() string {
+	if m != nil {
+		return m.RegisteredTime
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*IntegrityType)(nil), "kudora.integrity.v1.IntegrityType")
+}
+
+func init() {
+	proto.RegisterFile("kudora/integrity/v1/integrity_type.proto", fileDescriptor_a1dfe9bb041a3f55)
+}
+
+var fileDescriptor_a1dfe9bb041a3f55 = []byte{
+	// 379 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x4d, 0xae, 0xd3, 0x30,
+	0x10, 0xc7, 0x6b, 0x5e, 0x29, 0xef, 0x4d, 0x3f, 0x00, 0x23, 0x21, 0x8b, 0x45, 0x1a, 0x60, 0xd1,
+	0x48, 0x88, 0x44, 0x85, 0x0b, 0xa0, 0x2e, 0xaa, 0x56, 0xb0, 0x40, 0xa1, 0x2b, 0x36, 0x91, 0x93,
+	0x0c, 0xb1, 0x85, 0xf2, 0x21, 0xdb, 0xad, 0xc8, 0x2d, 0xb8, 0x03, 0x97, 0x61, 0xd9, 0x25, 0x4b,
+	0xd4, 0x5e, 0x04, 0xc5, 0x2d, 0x4d, 0x90, 0x2a, 0x76, 0x9e, 0xff, 0xfc, 0xe6, 0x3f, 0x33, 0x1a,
+	0x83, 0xf7, 0x75, 0x9b, 0x96, 0x8a, 0x07, 0xb2, 0x30, 0x98, 0x29, 0x69, 0xea, 0x60, 0x37, 0x6f,
+	0x83, 0xc8, 0xd4, 0x15, 0xfa, 0x95, 0x2a, 0x4d, 0x49, 0x9f, 0x9c, 0x48, 0xff, 0x92, 0xf4, 0x77,
+	0xf3, 0x67, 0xb3, 0xff, 0x97, 0x6b, 0x34, 0xa7, 0xea, 0xeb, 0x60, 0x85, 0x4a, 0x96, 0x69, 0xf4,
+	0xa5, 0x54, 0x39, 0x3f, 0x83, 0x2f, 0x7e, 0xdc, 0xc0, 0x78, 0xfd, 0x17, 0xda, 0xd4, 0x15, 0xd2,
+	0xa7, 0x30, 0x30, 0x58, 0xf0, 0xc2, 0x30, 0xe2, 0x12, 0xef, 0x2e, 0x3c, 0x47, 0x94, 0x42, 0xbf,
+	0x19, 0x8f, 0xdd, 0xb3, 0xaa, 0x7d, 0x53, 0x17, 0x86, 0x29, 0xea, 0x44, 0xc9, 0xca, 0xc8, 0xb2,
+	0x60, 0x37, 0x36, 0xd5, 0x95, 0xe8, 0x14, 0x86, 0x3a, 0x11, 0x98, 0xf3, 0x48, 0x70, 0x2d, 0x58,
+	0xdf, 0x12, 0x70, 0x92, 0x56, 0x5c, 0x0b, 0xba, 0x84, 0xf1, 0x3f, 0x73, 0xb1, 0xfb, 0x2e, 0xf1,
+	0x26, 0x6f, 0x9e, 0xfb, 0x57, 0xf6, 0xf7, 0x3f, 0x5a, 0x72, 0x69, 0xc1, 0x70, 0x54, 0x75, 0x22,
+	0xfa, 0x0e, 0x86, 0x4d, 0x87, 0xc8, 0x5a, 0x23, 0x1b, 0x58, 0x97, 0xe9, 0x55, 0x97, 0xa6, 0xef,
+	0x27, 0x8b, 0x85, 0x20, 0x2e, 0x6f, 0xea, 0x00, 0xa4, 0x58, 0x29, 0x4c, 0xb8, 0xc1, 0x94, 0x3d,
+	0x70, 0x89, 0x77, 0x1b, 0x76, 0x14, 0xfa, 0x12, 0xc6, 0x0a, 0x33, 0xa9, 0x0d, 0x2a, 0x4c, 0xa3,
+	0xb8, 0x66, 0xb7, 0x76, 0x99, 0x51, 0x2b, 0x2e, 0x6a, 0xfa, 0x0a, 0x1e, 0x77, 0x20, 0x81, 0x32,
+	0x13, 0x86, 0xdd, 0xb9, 0xc4, 0xeb, 0x87, 0x8f, 0xda, 0xc4, 0xca, 0xea, 0x74, 0x06, 0x0f, 0x3b,
+	0xb0, 0x91, 0x39, 0x32, 0xb0, 0x9e, 0x93, 0x56, 0xde, 0xc8, 0x1c, 0x17, 0xeb, 0x9f, 0x07, 0x87,
+	0xec, 0x0f, 0x0e, 0xf9, 0x7d, 0x70, 0xc8, 0xf7, 0xa3, 0xd3, 0xdb, 0x1f, 0x9d, 0xde, 0xaf, 0xa3,
+	0xd3, 0xfb, 0x1c, 0x64, 0xd2, 0x88, 0x6d, 0xec, 0x27, 0x65, 0x1e, 0xbc, 0xb7, 0xbb, 0xbe, 0xfe,
+	0xc0, 0x63, 0x1d, 0x9c, 0xef, 0xff, 0xad, 0xf3, 0x03, 0x9a, 0x8b, 0xe9, 0x78, 0x60, 0xef, 0xfe,
+	0xf6, 0xcf, 0x00, 0x76, 0x2b, 0x67, 0x60, 0x8a, 0x02, 0x00, 0x00,
+}
+
+func (m *IntegrityType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntegrityType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntegrityType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RegisteredTime) > 0 {
+		i -= len(m.RegisteredTime)
+		copy(dAtA[i:], m.RegisteredTime)
+		i = encodeVarintIntegrityType(dAtA, i, uint64(len(m.RegisteredTime)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.RegisteredHeight != 0 {
+		i = encodeVarintIntegrityType(dAtA, i, uint64(m.RegisteredHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RegisteredBy) > 0 {
+		i -= len(m.RegisteredBy)
+		copy(dAtA[i:], m.RegisteredBy)
+		i = encodeVarintIntegrityType(dAtA, i, uint64(len(m.RegisteredBy)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintIntegrityType(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PeriodFormat != 0 {
+		i = encodeVarintIntegrityType(dAtA, i, uint64(m.PeriodFormat))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintIntegrityType(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintIntegrityType(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintIntegrityType(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintIntegrityType(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintIntegrityType(dAtA []byte, offset int, v uint64) int {
+	offset -= sovIntegrityType(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IntegrityType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovIntegrityType(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovIntegrityType(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovIntegrityType(uint64(l))
+	}
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovIntegrityType(uint64(l))
+	}
+	if m.PeriodFormat != 0 {
+		n += 1 + sovIntegrityType(uint64(m.PeriodFormat))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovIntegrityType(uint64(m.HashScheme))
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	l = len(m.RegisteredBy)
+	if l > 0 {
+		n += 1 + l + sovIntegrityType(uint64(l))
+	}
+	if m.RegisteredHeight != 0 {
+		n += 1 + sovIntegrityType(uint64(m.RegisteredHeight))
+	}
+	l = len(m.RegisteredTime)
+	if l > 0 {
+		n += 1 + l + sovIntegrityType(uint64(l))
+	}
+	return n
+}
+
+func sovIntegrityType(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozIntegrityType(x uint64) (n int) {
+	return sovIntegrityType(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IntegrityType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowIntegrityType
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntegrityType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntegrityType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodFormat", wireType)
+			}
+			m.PeriodFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodFormat |= PeriodFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredHeight", wireType)
+			}
+			m.RegisteredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegisteredHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisteredTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegisteredTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipIntegrityType(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthIntegrityType
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipIntegrityType(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowIntegrityType
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowIntegrityType
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthIntegrityType
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupIntegrityType
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthIntegrityType
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthIntegrityType        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowIntegrityType          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupIntegrityType = fmt.Errorf("proto: unexpected end of group")
+)
@@ -12,14 +12,23 @@ const (
 	// GovModuleName duplicates the gov module's name to avoid a dependency with x/gov.
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
-	GovModuleName           = "gov"
-	MaxTenantLength         = 64
-	MaxTypeLength           = 128
-	MaxPeriodLength         = 64
-	MaxRecordsPerSet        = 1024
-	MaxNonceBytes           = 64
-	MaxCiphertextBytes      = 32 * 1024
-	MaxTotalCiphertextBytes = 4 * 1024 * 1024
+	GovModuleName = "gov"
+
+	// MaxTenantWriterTypePrefixes bounds the number of type prefixes on one writer grant.
+	MaxTenantWriterTypePrefixes = 32
+
+	// MaxStorageURILength bounds the off-chain storage URI of a root-only set.
+	MaxStorageURILength = 512
+
+	// MaxIntegrityTypeDescriptionLength bounds the description of a registered integrity type.
+	MaxIntegrityTypeDescriptionLength = 512
+
+	// DefaultChainVerifyLimit and MaxChainVerifyLimit bound the links visited by one VerifyChain query.
+	DefaultChainVerifyLimit = 100
+	MaxChainVerifyLimit     = 1000
+
+	// MaxTenantSigners bounds the signer set of a tenant.
+	MaxTenantSigners = 16
 )
 
 // ParamsKey is the prefix to retrieve all Params
@@ -28,4 +37,22 @@ var (
 	TenantKeyPrefix       = collections.NewPrefix(1)
 	IntegritySetPrefix    = collections.NewPrefix(2)
 	IntegrityRecordPrefix = collections.NewPrefix(3)
+
+	TenantOwnerIndexPrefix        = collections.NewPrefix(4)
+	TenantPendingOwnerIndexPrefix = collections.NewPrefix(5)
+	TenantWriterPrefix            = collections.NewPrefix(6)
+	// LegacyIntegritySetVersionPrefix held superseded versions as one bundle of header plus records before
+	// consensus version 6. Only the 5 to 6 migration reads it.
+	LegacyIntegritySetVersionPrefix = collections.NewPrefix(7)
+	ChainHeadPrefix                 = collections.NewPrefix(8)
+	PeriodFormatPrefix              = collections.NewPrefix(9)
+	IntegritySetPeriodIndexPrefix   = collections.NewPrefix(10)
+	IntegrityTypePrefix             = collections.NewPrefix(11)
+	RecordRevocationPrefix          = collections.NewPrefix(12)
+	TransferExpiryTimePrefix        = collections.NewPrefix(13)
+	TransferExpiryHeightPrefix      = collections.NewPrefix(14)
+	TenantProposalPrefix            = collections.NewPrefix(15)
+	TenantProposalSeqKey            = collections.NewPrefix(16)
+	IntegritySetVersionPrefix       = collections.NewPrefix(17)
+	IntegritySetVersionRecordPrefix = collections.NewPrefix(18)
 )
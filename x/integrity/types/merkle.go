@@ -1,10 +1,47 @@
 package types
 
 import (
-	"crypto/sha256"
+	"bytes"
 	"encoding/hex"
 )
 
+// Domain separation prefixes of HASH_SCHEME_V2, as in RFC 6962.
+const (
+	merkleLeafPrefix byte = 0x00
+	merkleNodePrefix byte = 0x01
+)
+
+// merkleHashSize is the digest size shared by every registered hash algorithm.
+const merkleHashSize = 32
+
+// Validate rejects hash schemes this chain does not know.
+func (s HashScheme) Validate() error {
+	if _, ok := HashScheme_name[int32(s)]; !ok {
+		return ErrInvalidHashScheme.Wrapf("unknown hash scheme %d", s)
+	}
+	return nil
+}
+
+// MerkleHasher combines the tree layout of a hash scheme with the hash function of an algorithm.
+type MerkleHasher struct {
+	Scheme    HashScheme
+	Algorithm HashAlgorithm
+}
+
+// Validate rejects unknown schemes and algorithms.
+func (h MerkleHasher) Validate() error {
+	if err := h.Scheme.Validate(); err != nil {
+		return err
+	}
+	return h.Algorithm.Validate()
+}
+
+// MerkleHasher returns the hasher the set root was built with.
+func (s IntegritySet) MerkleHasher() MerkleHasher {
+	return MerkleHasher{Scheme: s.HashScheme, Algorithm: s.HashAlgorithm}
+}
+
+// CalculateMerkleRoot prepares records and returns their HASH_SCHEME_V1 root.
 func CalculateMerkleRoot(records []IntegrityRecord) (string, []IntegrityRecord, error) {
 	prepared, _, err := PrepareIntegrityRecords(records)
 	if err != nil {
@@ -14,26 +51,246 @@ func CalculateMerkleRoot(records []IntegrityRecord) (string, []IntegrityRecord,
 	return CalculateMerkleRootFromPreparedRecords(prepared), prepared, nil
 }
 
+// CalculateMerkleRootFromPreparedRecords returns the HASH_SCHEME_V1 root of prepared records.
 func CalculateMerkleRootFromPreparedRecords(records []IntegrityRecord) string {
-	level := make([][]byte, len(records))
-	for i := range records {
-		sum := sha256.Sum256([]byte(CanonicalLeafJSON(records[i])))
-		level[i] = sum[:]
+	return HashScheme_HASH_SCHEME_V1.CalculateMerkleRoot(records)
+}
+
+// CalculateMerkleProofFromPreparedRecords builds the HASH_SCHEME_V1 inclusion proof of the record at index.
+func CalculateMerkleProofFromPreparedRecords(records []IntegrityRecord, index int) (IntegrityRecordProof, error) {
+	return HashScheme_HASH_SCHEME_V1.CalculateMerkleProof(records, index)
+}
+
+// CalculateMerkleRoot returns the SHA-256 root of prepared, tag-sorted records under the hash scheme.
+func (s HashScheme) CalculateMerkleRoot(records []IntegrityRecord) string {
+	return MerkleHasher{Scheme: s}.CalculateMerkleRoot(records)
+}
+
+// CalculateMerkleProof builds the SHA-256 inclusion proof of the record at index under the hash scheme.
+func (s HashScheme) CalculateMerkleProof(records []IntegrityRecord, index int) (IntegrityRecordProof, error) {
+	return MerkleHasher{Scheme: s}.CalculateMerkleProof(records, index)
+}
+
+// CalculateMerkleRoot returns the root of prepared, tag-sorted records. The hasher must be valid.
+func (h MerkleHasher) CalculateMerkleRoot(records []IntegrityRecord) string {
+	level := h.merkleLeafHashes(records)
+
+	for len(level) > 1 {
+		level = h.merkleNextLevel(level)
+	}
+
+	return encodeMerkleHash(level[0])
+}
+
+// CalculateMerkleProof builds the inclusion proof of the record at index using the same tree layout as
+// CalculateMerkleRoot. Records must already be prepared and sorted.
+func (h MerkleHasher) CalculateMerkleProof(records []IntegrityRecord, index int) (IntegrityRecordProof, error) {
+	if err := h.Validate(); err != nil {
+		return IntegrityRecordProof{}, err
+	}
+	if len(records) == 0 {
+		return IntegrityRecordProof{}, ErrEmptyRecords
+	}
+	if index < 0 || index >= len(records) {
+		return IntegrityRecordProof{}, ErrIntegrityRecordNotFound.Wrapf("leaf index %d is out of range", index)
+	}
+
+	level := h.merkleLeafHashes(records)
+	proof := IntegrityRecordProof{
+		LeafIndex:     uint64(index),
+		LeafCount:     uint64(len(records)),
+		LeafHash:      encodeMerkleHash(level[index]),
+		HashScheme:    h.Scheme,
+		HashAlgorithm: h.Algorithm,
 	}
 
+	position := index
 	for len(level) > 1 {
 		if len(level)%2 == 1 {
-			level = append(level, level[len(level)-1])
+			switch {
+			case h.Scheme != HashScheme_HASH_SCHEME_V2:
+				level = append(level, level[len(level)-1])
+			case position == len(level)-1:
+				// V2 promotes an unpaired last node, so it contributes no sibling at this level.
+				level = h.merkleNextLevel(level)
+				position /= 2
+				continue
+			}
+		}
+
+		siblingOnLeft := position%2 == 1
+		sibling := position + 1
+		if siblingOnLeft {
+			sibling = position - 1
+		}
+		proof.Siblings = append(proof.Siblings, encodeMerkleHash(level[sibling]))
+		proof.SiblingOnLeft = append(proof.SiblingOnLeft, siblingOnLeft)
+
+		level = h.merkleNextLevel(level)
+		position /= 2
+	}
+
+	return proof, nil
+}
+
+// VerifyRecordProof checks that record is included in the set committed under root. The record is
+// canonicalized exactly like PrepareIntegrityRecords does, and the proof must follow the tree layout of
+// its hash scheme: HASH_SCHEME_V1 duplicates the last node of odd-sized levels, HASH_SCHEME_V2 promotes it.
+// Every hash is computed with the proof's hash algorithm.
+func VerifyRecordProof(root string, record IntegrityRecord, proof IntegrityRecordProof) error {
+	hasher := MerkleHasher{Scheme: proof.HashScheme, Algorithm: proof.HashAlgorithm}
+	if err := hasher.Validate(); err != nil {
+		return err
+	}
+	normalizedRoot, err := NormalizeRoot(root)
+	if err != nil {
+		return err
+	}
+	leaf, err := normalizeProofRecord(record)
+	if err != nil {
+		return err
+	}
+	if proof.LeafCount == 0 || proof.LeafIndex >= proof.LeafCount {
+		return ErrInvalidProof.Wrapf("leaf index %d is out of range for %d leaves", proof.LeafIndex, proof.LeafCount)
+	}
+	if len(proof.Siblings) != len(proof.SiblingOnLeft) {
+		return ErrInvalidProof.Wrapf("proof has %d siblings but %d direction bits", len(proof.Siblings), len(proof.SiblingOnLeft))
+	}
+
+	current := hasher.merkleLeafHash(leaf)
+	if proof.LeafHash != "" {
+		leafHash, err := decodeMerkleHash(proof.LeafHash, "leaf hash")
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(leafHash, current) {
+			return ErrInvalidProof.Wrap("leaf hash does not match the canonical record")
+		}
+	}
+
+	position, width, step := proof.LeafIndex, proof.LeafCount, 0
+	for width > 1 {
+		if hasher.Scheme == HashScheme_HASH_SCHEME_V2 && width%2 == 1 && position+1 == width {
+			position /= 2
+			width = (width + 1) / 2
+			continue
 		}
+		if step >= len(proof.Siblings) {
+			return ErrInvalidProof.Wrapf("proof is too short for %d leaves", proof.LeafCount)
+		}
+		sibling, err := decodeMerkleHash(proof.Siblings[step], "sibling")
+		if err != nil {
+			return err
+		}
+
+		siblingOnLeft := position%2 == 1
+		if proof.SiblingOnLeft[step] != siblingOnLeft {
+			return ErrInvalidProof.Wrapf("direction bit %d does not match leaf index %d", step, proof.LeafIndex)
+		}
+		if siblingOnLeft {
+			current = hasher.merkleParentHash(sibling, current)
+		} else {
+			if position+1 == width && !bytes.Equal(sibling, current) {
+				return ErrInvalidProof.Wrapf("sibling %d must duplicate the last node of an odd-sized level", step)
+			}
+			current = hasher.merkleParentHash(current, sibling)
+		}
+
+		position /= 2
+		width = (width + 1) / 2
+		step++
+	}
+	if step != len(proof.Siblings) {
+		return ErrInvalidProof.Wrapf("proof is too long for %d leaves", proof.LeafCount)
+	}
+
+	if calculatedRoot := encodeMerkleHash(current); calculatedRoot != normalizedRoot {
+		return ErrRootMismatch.Wrapf("proof resolves to root %s, expected %s", calculatedRoot, normalizedRoot)
+	}
+
+	return nil
+}
+
+func normalizeProofRecord(record IntegrityRecord) (IntegrityRecord, error) {
+	tag, err := normalizeTag(record.Tag)
+	if err != nil {
+		return IntegrityRecord{}, err
+	}
+	nonce, _, err := normalizeVariableLengthHex(record.Nonce, 0, ErrInvalidRecord, "nonce")
+	if err != nil {
+		return IntegrityRecord{}, err
+	}
+	ciphertext, _, err := normalizeVariableLengthHex(record.Ciphertext, 0, ErrInvalidRecord, "ciphertext")
+	if err != nil {
+		return IntegrityRecord{}, err
+	}
+
+	return IntegrityRecord{
+		Tag:        tag,
+		Nonce:      nonce,
+		Ciphertext: ciphertext,
+	}, nil
+}
+
+func (h MerkleHasher) merkleLeafHash(record IntegrityRecord) []byte {
+	leaf := []byte(CanonicalLeafJSON(record))
+	if h.Scheme == HashScheme_HASH_SCHEME_V2 {
+		leaf = append([]byte{merkleLeafPrefix}, leaf...)
+	}
+	return h.Algorithm.Sum(leaf)
+}
+
+func (h MerkleHasher) merkleParentHash(left, right []byte) []byte {
+	parentInput := make([]byte, 0, len(left)+len(right)+1)
+	if h.Scheme == HashScheme_HASH_SCHEME_V2 {
+		parentInput = append(parentInput, merkleNodePrefix)
+	}
+	parentInput = append(append(parentInput, left...), right...)
+	return h.Algorithm.Sum(parentInput)
+}
+
+func (h MerkleHasher) merkleLeafHashes(records []IntegrityRecord) [][]byte {
+	level := make([][]byte, len(records))
+	for i := range records {
+		level[i] = h.merkleLeafHash(records[i])
+	}
+	return level
+}
 
-		nextLevel := make([][]byte, 0, len(level)/2)
-		for i := 0; i < len(level); i += 2 {
-			parentInput := append(append(make([]byte, 0, len(level[i])+len(level[i+1])), level[i]...), level[i+1]...)
-			sum := sha256.Sum256(parentInput)
-			nextLevel = append(nextLevel, sum[:])
+// merkleNextLevel hashes one tree level into its parent level. On odd-sized levels V1 duplicates the last
+// node and V2 promotes it unchanged.
+func (h MerkleHasher) merkleNextLevel(level [][]byte) [][]byte {
+	var promoted []byte
+	if len(level)%2 == 1 {
+		if h.Scheme == HashScheme_HASH_SCHEME_V2 {
+			promoted = level[len(level)-1]
+			level = level[:len(level)-1]
+		} else {
+			level = append(level, level[len(level)-1])
 		}
-		level = nextLevel
 	}
 
-	return "0x" + hex.EncodeToString(level[0])
+	nextLevel := make([][]byte, 0, len(level)/2+1)
+	for i := 0; i < len(level); i += 2 {
+		nextLevel = append(nextLevel, h.merkleParentHash(level[i], level[i+1]))
+	}
+	if promoted != nil {
+		nextLevel = append(nextLevel, promoted)
+	}
+	return nextLevel
+}
+
+func encodeMerkleHash(hash []byte) string {
+	return "0x" + hex.EncodeToString(hash)
+}
+
+func decodeMerkleHash(value string, field string) ([]byte, error) {
+	_, decoded, err := normalizeHexBytes(value, merkleHashSize)
+	if err != nil {
+		return nil, ErrInvalidProof.Wrapf("%s %s", field, err.Error())
+	}
+	if len(decoded) != merkleHashSize {
+		return nil, ErrInvalidProof.Wrapf("%s must be exactly %d bytes", field, merkleHashSize)
+	}
+	return decoded, nil
 }
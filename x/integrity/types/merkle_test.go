@@ -1,6 +1,9 @@
 package types_test
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -27,3 +30,226 @@ func TestCalculateMerkleRootDeterministic(t *testing.T) {
 	require.Equal(t, preparedA, preparedB)
 	require.Equal(t, `{"tag":"0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa","nonce":"0x0304","ciphertext":"0x0c0d"}`, types.CanonicalLeafJSON(preparedA[0]))
 }
+
+func TestCalculateMerkleProofFromPreparedRecords(t *testing.T) {
+	records := []types.IntegrityRecord{
+		{Tag: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Nonce: "0x0102", Ciphertext: "0x0a0b"},
+		{Tag: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Nonce: "0x0304", Ciphertext: "0x0c0d"},
+		{Tag: "0xcccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccccc", Nonce: "0x0506", Ciphertext: "0x0e0f"},
+	}
+	root, prepared, err := types.CalculateMerkleRoot(records)
+	require.NoError(t, err)
+
+	for i := range prepared {
+		proof, err := types.CalculateMerkleProofFromPreparedRecords(prepared, i)
+		require.NoError(t, err)
+		require.Equal(t, uint64(i), proof.LeafIndex)
+		require.Equal(t, uint64(len(prepared)), proof.LeafCount)
+		require.Len(t, proof.Siblings, 2)
+		require.Len(t, proof.SiblingOnLeft, 2)
+
+		current := sha256.Sum256([]byte(types.CanonicalLeafJSON(prepared[i])))
+		require.Equal(t, "0x"+hex.EncodeToString(current[:]), proof.LeafHash)
+		for level, siblingHex := range proof.Siblings {
+			sibling, err := hex.DecodeString(strings.TrimPrefix(siblingHex, "0x"))
+			require.NoError(t, err)
+			if proof.SiblingOnLeft[level] {
+				current = sha256.Sum256(append(sibling, current[:]...))
+			} else {
+				current = sha256.Sum256(append(current[:], sibling...))
+			}
+		}
+		require.Equal(t, root, "0x"+hex.EncodeToString(current[:]))
+	}
+
+	// The odd last leaf is paired with its own duplicate.
+	lastProof, err := types.CalculateMerkleProofFromPreparedRecords(prepared, 2)
+	require.NoError(t, err)
+	require.Equal(t, lastProof.LeafHash, lastProof.Siblings[0])
+	require.Equal(t, []bool{false, true}, lastProof.SiblingOnLeft)
+
+	_, err = types.CalculateMerkleProofFromPreparedRecords(prepared, len(prepared))
+	require.ErrorIs(t, err, types.ErrIntegrityRecordNotFound)
+}
+
+func TestVerifyRecordProof(t *testing.T) {
+	records := make([]types.IntegrityRecord, 0, 5)
+	for i := 0; i < 5; i++ {
+		records = append(records, types.IntegrityRecord{
+			Tag:        "0x" + strings.Repeat(hex.EncodeToString([]byte{byte(0x10 + i)}), 32),
+			Nonce:      "0x0102",
+			Ciphertext: "0x" + hex.EncodeToString([]byte{byte(i), 0xff}),
+		})
+	}
+
+	for count := 1; count <= len(records); count++ {
+		root, prepared, err := types.CalculateMerkleRoot(records[:count])
+		require.NoError(t, err)
+		for i := range prepared {
+			proof, err := types.CalculateMerkleProofFromPreparedRecords(prepared, i)
+			require.NoError(t, err)
+			require.NoError(t, types.VerifyRecordProof(root, prepared[i], proof))
+		}
+	}
+
+	root, prepared, err := types.CalculateMerkleRoot(records)
+	require.NoError(t, err)
+	proof, err := types.CalculateMerkleProofFromPreparedRecords(prepared, 4)
+	require.NoError(t, err)
+
+	t.Run("accepts non-canonical hex casing", func(t *testing.T) {
+		record := prepared[4]
+		record.Ciphertext = "0x" + strings.ToUpper(record.Ciphertext[2:])
+		require.NoError(t, types.VerifyRecordProof("0x"+strings.ToUpper(root[2:]), record, proof))
+	})
+
+	t.Run("rejects a different root", func(t *testing.T) {
+		otherRoot, _, err := types.CalculateMerkleRoot(records[:4])
+		require.NoError(t, err)
+		require.ErrorIs(t, types.VerifyRecordProof(otherRoot, prepared[4], proof), types.ErrRootMismatch)
+	})
+
+	t.Run("rejects a different record", func(t *testing.T) {
+		require.Error(t, types.VerifyRecordProof(root, prepared[3], proof))
+	})
+
+	t.Run("rejects flipped direction bits", func(t *testing.T) {
+		tampered := proof
+		tampered.SiblingOnLeft = append([]bool{}, proof.SiblingOnLeft...)
+		tampered.SiblingOnLeft[1] = !tampered.SiblingOnLeft[1]
+		require.ErrorIs(t, types.VerifyRecordProof(root, prepared[4], tampered), types.ErrInvalidProof)
+	})
+
+	t.Run("rejects a non-duplicated odd node", func(t *testing.T) {
+		tampered := proof
+		tampered.Siblings = append([]string{}, proof.Siblings...)
+		tampered.Siblings[0] = proof.Siblings[1]
+		require.ErrorIs(t, types.VerifyRecordProof(root, prepared[4], tampered), types.ErrInvalidProof)
+	})
+
+	t.Run("rejects truncated proofs", func(t *testing.T) {
+		tampered := proof
+		tampered.Siblings = proof.Siblings[:2]
+		tampered.SiblingOnLeft = proof.SiblingOnLeft[:2]
+		require.ErrorIs(t, types.VerifyRecordProof(root, prepared[4], tampered), types.ErrInvalidProof)
+	})
+}
+
+func TestHashSchemeV2MatchesRFC6962(t *testing.T) {
+	records := make([]types.IntegrityRecord, 0, 9)
+	for i := 0; i < 9; i++ {
+		records = append(records, types.IntegrityRecord{
+			Tag:        "0x" + strings.Repeat(hex.EncodeToString([]byte{byte(0x20 + i)}), 32),
+			Nonce:      "0x0102",
+			Ciphertext: "0x" + hex.EncodeToString([]byte{byte(i), 0xee}),
+		})
+	}
+
+	// rfc6962Root is the recursive tree hash of RFC 6962 section 2.1, kept independent of the module code.
+	var rfc6962Root func(records []types.IntegrityRecord) []byte
+	rfc6962Root = func(records []types.IntegrityRecord) []byte {
+		if len(records) == 1 {
+			sum := sha256.Sum256(append([]byte{0x00}, types.CanonicalLeafJSON(records[0])...))
+			return sum[:]
+		}
+		split := 1
+		for split*2 < len(records) {
+			split *= 2
+		}
+		input := append([]byte{0x01}, rfc6962Root(records[:split])...)
+		sum := sha256.Sum256(append(input, rfc6962Root(records[split:])...))
+		return sum[:]
+	}
+
+	v2 := types.HashScheme_HASH_SCHEME_V2
+	for count := 1; count <= len(records); count++ {
+		prepared, _, err := types.PrepareIntegrityRecords(records[:count])
+		require.NoError(t, err)
+		root := v2.CalculateMerkleRoot(prepared)
+		require.Equal(t, "0x"+hex.EncodeToString(rfc6962Root(prepared)), root)
+		if count > 1 {
+			require.NotEqual(t, types.CalculateMerkleRootFromPreparedRecords(prepared), root)
+		}
+
+		for i := range prepared {
+			proof, err := v2.CalculateMerkleProof(prepared, i)
+			require.NoError(t, err)
+			require.Equal(t, v2, proof.HashScheme)
+			require.NoError(t, types.VerifyRecordProof(root, prepared[i], proof))
+
+			// A proof must not verify once it claims the other scheme.
+			if count > 1 {
+				downgraded := proof
+				downgraded.HashScheme = types.HashScheme_HASH_SCHEME_V1
+				require.Error(t, types.VerifyRecordProof(root, prepared[i], downgraded))
+			}
+		}
+	}
+
+	// The unpaired last leaf of an odd-sized level is promoted without a sibling.
+	prepared, _, err := types.PrepareIntegrityRecords(records[:5])
+	require.NoError(t, err)
+	lastProof, err := v2.CalculateMerkleProof(prepared, 4)
+	require.NoError(t, err)
+	require.Len(t, lastProof.Siblings, 1)
+	require.Equal(t, []bool{true}, lastProof.SiblingOnLeft)
+
+	_, err = types.HashScheme(7).CalculateMerkleProof(prepared, 0)
+	require.ErrorIs(t, err, types.ErrInvalidHashScheme)
+	lastProof.HashScheme = types.HashScheme(7)
+	require.ErrorIs(t, types.VerifyRecordProof(v2.CalculateMerkleRoot(prepared), prepared[4], lastProof), types.ErrInvalidHashScheme)
+}
+
+func TestHashAlgorithmsBuildVerifiableRoots(t *testing.T) {
+	emptyDigests := map[types.HashAlgorithm]string{
+		types.HashAlgorithm_HASH_ALGORITHM_SHA256:    "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855",
+		types.HashAlgorithm_HASH_ALGORITHM_KECCAK256: "c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470",
+		types.HashAlgorithm_HASH_ALGORITHM_BLAKE3:    "af1349b9f5f9a1a6a0404dea36dcc9499bcb25c9adc112b7cc9a93cae41f3262",
+	}
+
+	records := make([]types.IntegrityRecord, 0, 5)
+	for i := 0; i < 5; i++ {
+		records = append(records, types.IntegrityRecord{
+			Tag:        "0x" + strings.Repeat(hex.EncodeToString([]byte{byte(0x40 + i)}), 32),
+			Nonce:      "0x0a0b",
+			Ciphertext: "0x" + hex.EncodeToString([]byte{byte(i), 0xcd}),
+		})
+	}
+	prepared, _, err := types.PrepareIntegrityRecords(records)
+	require.NoError(t, err)
+
+	roots := make(map[string]types.HashAlgorithm)
+	for algorithm, emptyDigest := range emptyDigests {
+		require.NoError(t, algorithm.Validate())
+		require.Equal(t, emptyDigest, hex.EncodeToString(algorithm.Sum(nil)))
+
+		for _, scheme := range []types.HashScheme{types.HashScheme_HASH_SCHEME_V1, types.HashScheme_HASH_SCHEME_V2} {
+			hasher := types.MerkleHasher{Scheme: scheme, Algorithm: algorithm}
+			root := hasher.CalculateMerkleRoot(prepared)
+			require.NotContains(t, roots, root)
+			roots[root] = algorithm
+
+			for i := range prepared {
+				proof, err := hasher.CalculateMerkleProof(prepared, i)
+				require.NoError(t, err)
+				require.Equal(t, algorithm, proof.HashAlgorithm)
+				require.NoError(t, types.VerifyRecordProof(root, prepared[i], proof))
+
+				// A proof must not verify once it claims another algorithm.
+				for other := range emptyDigests {
+					if other == algorithm {
+						continue
+					}
+					swapped := proof
+					swapped.HashAlgorithm = other
+					require.Error(t, types.VerifyRecordProof(root, prepared[i], swapped))
+				}
+			}
+		}
+	}
+
+	require.Equal(t, types.CalculateMerkleRootFromPreparedRecords(prepared), types.MerkleHasher{}.CalculateMerkleRoot(prepared))
+	require.ErrorIs(t, types.HashAlgorithm(9).Validate(), types.ErrInvalidHashAlgorithm)
+	_, err = types.MerkleHasher{Algorithm: types.HashAlgorithm(9)}.CalculateMerkleProof(prepared, 0)
+	require.ErrorIs(t, err, types.ErrInvalidHashAlgorithm)
+}
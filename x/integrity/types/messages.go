@@ -1,5 +1,7 @@
 package types
 
+import errorsmod "cosmossdk.io/errors"
+
 func (msg MsgRegisterTenant) ValidateBasic() error {
 	if _, err := NormalizeCreator(msg.Creator); err != nil {
 		return err
@@ -23,7 +25,8 @@ func (msg MsgTransferTenantOwnership) ValidateBasic() error {
 	if creator == newOwner {
 		return ErrTenantOwnershipUnchanged.Wrap("new owner must differ from the current owner")
 	}
-	return nil
+	_, err = NormalizeTransferExpiresAt(msg.ExpiresAt)
+	return err
 }
 
 func (msg MsgAcceptTenantOwnership) ValidateBasic() error {
@@ -42,6 +45,30 @@ func (msg MsgCancelTenantOwnershipTransfer) ValidateBasic() error {
 	return err
 }
 
+func (msg MsgFreezeTenant) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	_, err := NormalizeTenant(msg.Tenant)
+	return err
+}
+
+func (msg MsgUnfreezeTenant) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	_, err := NormalizeTenant(msg.Tenant)
+	return err
+}
+
+func (msg MsgArchiveTenant) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	_, err := NormalizeTenant(msg.Tenant)
+	return err
+}
+
 func (msg MsgCommitIntegritySet) ValidateBasic() error {
 	if _, err := NormalizeCreator(msg.Creator); err != nil {
 		return err
@@ -55,9 +82,330 @@ func (msg MsgCommitIntegritySet) ValidateBasic() error {
 	if _, err := NormalizePeriod(msg.Period); err != nil {
 		return err
 	}
+	if err := msg.HashScheme.Validate(); err != nil {
+		return err
+	}
+	if err := msg.HashAlgorithm.Validate(); err != nil {
+		return err
+	}
 	if _, err := NormalizeRoot(msg.Root); err != nil {
 		return err
 	}
+	if msg.PreviousRoot != "" {
+		if _, err := NormalizeRoot(msg.PreviousRoot); err != nil {
+			return err
+		}
+	}
 	_, _, err := PrepareIntegrityRecords(msg.Records)
 	return err
 }
+
+func (msg MsgCommitIntegritySets) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if len(msg.Sets) == 0 {
+		return ErrEmptyIntegritySetBatch
+	}
+
+	seen := make(map[string]struct{}, len(msg.Sets))
+	for i, commit := range msg.CommitMsgs() {
+		if err := commit.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "set %d", i)
+		}
+		tenant, _ := NormalizeTenant(commit.Tenant)
+		integrityType, _ := NormalizeIntegrityType(commit.Type)
+		period, _ := NormalizePeriod(commit.Period)
+		key := tenant + "/" + integrityType + "/" + period
+		if _, ok := seen[key]; ok {
+			return ErrDuplicateBatchSet.Wrapf("set %s", key)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// CommitMsgs expands the batch into one MsgCommitIntegritySet per set so that every entry goes through
+// the same validation as a single commit.
+func (msg MsgCommitIntegritySets) CommitMsgs() []MsgCommitIntegritySet {
+	commits := make([]MsgCommitIntegritySet, len(msg.Sets))
+	for i, set := range msg.Sets {
+		commits[i] = MsgCommitIntegritySet{
+			Creator:       msg.Creator,
+			Tenant:        set.Tenant,
+			Type:          set.Type,
+			Period:        set.Period,
+			Root:          set.Root,
+			Records:       set.Records,
+			HashScheme:    set.HashScheme,
+			HashAlgorithm: set.HashAlgorithm,
+			PreviousRoot:  set.PreviousRoot,
+		}
+	}
+	return commits
+}
+
+func (msg MsgGrantTenantWriter) ValidateBasic() error {
+	creator, err := NormalizeCreator(msg.Creator)
+	if err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	writer, err := NormalizeOwnerAddress(msg.Writer, "writer")
+	if err != nil {
+		return err
+	}
+	if writer == creator {
+		return ErrInvalidTenantWriter.Wrap("writer must differ from the tenant owner")
+	}
+	if _, err := NormalizeTypePrefixes(msg.TypePrefixes); err != nil {
+		return err
+	}
+	_, err = NormalizeExpiresAt(msg.ExpiresAt)
+	return err
+}
+
+func (msg MsgRevokeTenantWriter) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	_, err := NormalizeOwnerAddress(msg.Writer, "writer")
+	return err
+}
+
+func (msg MsgSupersedeIntegritySet) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	if _, err := NormalizePeriod(msg.Period); err != nil {
+		return err
+	}
+	if err := msg.HashScheme.Validate(); err != nil {
+		return err
+	}
+	if err := msg.HashAlgorithm.Validate(); err != nil {
+		return err
+	}
+	if _, err := NormalizeRoot(msg.Root); err != nil {
+		return err
+	}
+	if _, err := NormalizeReasonHash(msg.ReasonHash); err != nil {
+		return err
+	}
+	_, _, err := PrepareIntegrityRecords(msg.Records)
+	return err
+}
+
+func (msg MsgOpenIntegritySet) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	if _, err := NormalizePeriod(msg.Period); err != nil {
+		return err
+	}
+	if err := msg.HashScheme.Validate(); err != nil {
+		return err
+	}
+	return msg.HashAlgorithm.Validate()
+}
+
+func (msg MsgAppendIntegrityRecords) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	if _, err := NormalizePeriod(msg.Period); err != nil {
+		return err
+	}
+	_, _, err := PrepareIntegrityRecords(msg.Records)
+	return err
+}
+
+func (msg MsgSealIntegritySet) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	if _, err := NormalizePeriod(msg.Period); err != nil {
+		return err
+	}
+	_, err := NormalizeRoot(msg.Root)
+	return err
+}
+
+func (msg MsgCancelIntegritySet) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	_, err := NormalizePeriod(msg.Period)
+	return err
+}
+
+func (msg MsgAnchorIntegrityRoot) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	if _, err := NormalizePeriod(msg.Period); err != nil {
+		return err
+	}
+	if err := msg.HashScheme.Validate(); err != nil {
+		return err
+	}
+	if err := msg.HashAlgorithm.Validate(); err != nil {
+		return err
+	}
+	if _, err := NormalizeRoot(msg.Root); err != nil {
+		return err
+	}
+	if msg.RecordCount == 0 {
+		return ErrEmptyRecords.Wrap("record count must be positive")
+	}
+	if _, err := NormalizeStorageURI(msg.StorageUri); err != nil {
+		return err
+	}
+	_, err := NormalizeStorageDigest(msg.StorageDigest)
+	return err
+}
+
+func (msg MsgSetPeriodFormat) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	return msg.Format.Validate()
+}
+
+func (msg MsgRegisterIntegrityType) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	if _, err := NormalizeTypeDescription(msg.Description); err != nil {
+		return err
+	}
+	if _, err := NormalizeSchemaHash(msg.SchemaHash); err != nil {
+		return err
+	}
+	if err := msg.PeriodFormat.Validate(); err != nil {
+		return err
+	}
+	return msg.HashScheme.Validate()
+}
+
+func (msg MsgRevokeIntegrityRecords) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	if _, err := NormalizeIntegrityType(msg.Type); err != nil {
+		return err
+	}
+	if _, err := NormalizePeriod(msg.Period); err != nil {
+		return err
+	}
+	if _, err := NormalizeRevocationTags(msg.Tags); err != nil {
+		return err
+	}
+	return ValidateRevocationReasonCode(msg.ReasonCode)
+}
+
+func (msg MsgSetTenantSigners) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	if _, err := NormalizeTenant(msg.Tenant); err != nil {
+		return err
+	}
+	_, err := NormalizeTenantSigners(msg.Signers, msg.Threshold)
+	return err
+}
+
+func (msg MsgProposeTenantAction) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	tenant, err := NormalizeTenant(msg.Tenant)
+	if err != nil {
+		return err
+	}
+	// The wrapped message is fully validated by the keeper once the tenant owner is known.
+	action, err := msg.Action.Msg(msg.Creator)
+	if err != nil {
+		return err
+	}
+	actionTenant, err := NormalizeTenant(action.GetTenant())
+	if err != nil {
+		return err
+	}
+	if actionTenant != tenant {
+		return ErrInvalidTenantAction.Wrapf("action targets tenant %s instead of %s", actionTenant, tenant)
+	}
+	return nil
+}
+
+func (msg MsgApproveTenantAction) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	_, err := NormalizeTenant(msg.Tenant)
+	return err
+}
+
+func (msg MsgExecuteTenantAction) ValidateBasic() error {
+	if _, err := NormalizeCreator(msg.Creator); err != nil {
+		return err
+	}
+	_, err := NormalizeTenant(msg.Tenant)
+	return err
+}
@@ -79,21 +79,20 @@ func init() {
 }
 
 var fileDescriptor_c6282e62d8c7f01a = []byte{
-	// 211 bytes of a gzipped FileDescriptorProto
+	// 197 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcf, 0x2e, 0x4d, 0xc9,
 	0x2f, 0x4a, 0xd4, 0xcf, 0xcc, 0x2b, 0x49, 0x4d, 0x2f, 0xca, 0x2c, 0xa9, 0xd4, 0xcf, 0xcd, 0x4f,
 	0x29, 0xcd, 0x49, 0xd5, 0x2f, 0x33, 0x84, 0xb2, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xa4,
 	0x20, 0x0a, 0xf5, 0xe0, 0x0a, 0xf5, 0xa0, 0xd2, 0x65, 0x86, 0x52, 0x0a, 0xc9, 0xf9, 0xc5, 0xb9,
 	0xf9, 0xc5, 0xfa, 0x89, 0x05, 0x05, 0xfa, 0x65, 0x86, 0x89, 0x39, 0x05, 0x19, 0x89, 0xa8, 0xba,
-	0x95, 0x12, 0xb9, 0xd8, 0x7c, 0xc1, 0x7c, 0x21, 0x19, 0x2e, 0xce, 0xc4, 0xd2, 0x92, 0x8c, 0x7c,
-	0x90, 0x11, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x08, 0x01, 0x2b, 0xcb, 0x5d, 0x07, 0xa6,
-	0xdd, 0x62, 0x34, 0xe6, 0x32, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
-	0x0f, 0x4b, 0xcc, 0x49, 0xcd, 0x2b, 0xc9, 0xcc, 0x73, 0x2a, 0x4a, 0x4d, 0x49, 0xcd, 0x4d, 0x2d,
-	0x2e, 0x29, 0x4a, 0xd5, 0x87, 0xba, 0xba, 0x02, 0xe1, 0x6e, 0xa7, 0xc0, 0x13, 0x8f, 0xe4, 0x18,
-	0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5,
-	0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x27, 0xd9, 0x30, 0xfd, 0x92, 0xca, 0x82, 0xd4, 0xe2,
-	0x24, 0x36, 0xb0, 0xe3, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x84, 0xbf, 0x93, 0xce, 0x25,
-	0x01, 0x00, 0x00,
+	0x95, 0x22, 0xb9, 0xd8, 0x7c, 0xc1, 0x7c, 0x21, 0x19, 0x2e, 0xce, 0xc4, 0xd2, 0x92, 0x8c, 0x7c,
+	0x90, 0x11, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x08, 0x01, 0x2b, 0xc3, 0x5d, 0x07, 0xa6,
+	0xdd, 0x62, 0xd4, 0xe6, 0xd2, 0x4c, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b, 0xce, 0xcf, 0xd5,
+	0xf7, 0x06, 0x5b, 0xac, 0xeb, 0x93, 0x98, 0x54, 0xac, 0x0f, 0x75, 0x6d, 0x05, 0xc2, 0xbd, 0x4e,
+	0x9e, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7,
+	0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xa5, 0x4f, 0xb4, 0x21, 0xfa,
+	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xc7, 0x1a, 0x03, 0x06, 0x00, 0xce, 0xa4, 0xfe,
+	0xb6, 0x15, 0x01, 0x00, 0x00,
 }
 
 func (m *Module) Marshal() (dAtA []byte, err error) {
@@ -1,17 +1,180 @@
 package types
 
+import (
+	"fmt"
+	"slices"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	DefaultMaxTenantLength         uint64 = 64
+	DefaultMaxTypeLength           uint64 = 128
+	DefaultMaxPeriodLength         uint64 = 64
+	DefaultMaxRecordsPerSet        uint64 = 1024
+	DefaultMaxNonceBytes           uint64 = 64
+	DefaultMaxCiphertextBytes      uint64 = 32 * 1024
+	DefaultMaxTotalCiphertextBytes uint64 = 4 * 1024 * 1024
+	DefaultMaxBatchCiphertextBytes uint64 = 4 * 1024 * 1024
+
+	DefaultStorageFeeDenom = "akud"
+	DefaultBurnStorageFee  = false
+
+	DefaultRequireRegisteredTypes = false
+)
+
+// Absolute limits hold whatever the params say. Stateless validation enforces them before a transaction
+// reaches the keeper, and params may only set limits at or below them.
+const (
+	AbsoluteMaxTenantLength         uint64 = 256
+	AbsoluteMaxTypeLength           uint64 = 512
+	AbsoluteMaxPeriodLength         uint64 = 256
+	AbsoluteMaxRecordsPerSet        uint64 = 16 * 1024
+	AbsoluteMaxNonceBytes           uint64 = 1024
+	AbsoluteMaxCiphertextBytes      uint64 = 1024 * 1024
+	AbsoluteMaxTotalCiphertextBytes uint64 = 64 * 1024 * 1024
+	AbsoluteMaxBatchCiphertextBytes uint64 = 64 * 1024 * 1024
+)
+
+var (
+	DefaultBaseStorageFee        = math.ZeroInt()
+	DefaultStorageFeePerByte     = math.ZeroInt()
+	DefaultAllowedHashAlgorithms = []HashAlgorithm{
+		HashAlgorithm_HASH_ALGORITHM_SHA256,
+		HashAlgorithm_HASH_ALGORITHM_KECCAK256,
+		HashAlgorithm_HASH_ALGORITHM_BLAKE3,
+	}
+)
+
 // NewParams creates a new Params instance.
-func NewParams() Params {
-	return Params{}
+func NewParams(
+	maxTenantLength uint64,
+	maxTypeLength uint64,
+	maxPeriodLength uint64,
+	maxRecordsPerSet uint64,
+	maxNonceBytes uint64,
+	maxCiphertextBytes uint64,
+	maxTotalCiphertextBytes uint64,
+	storageFeeDenom string,
+	baseStorageFee math.Int,
+	storageFeePerByte math.Int,
+	burnStorageFee bool,
+	maxBatchCiphertextBytes uint64,
+	allowedHashAlgorithms []HashAlgorithm,
+	requireRegisteredTypes bool,
+) Params {
+	return Params{
+		MaxTenantLength:         maxTenantLength,
+		MaxTypeLength:           maxTypeLength,
+		MaxPeriodLength:         maxPeriodLength,
+		MaxRecordsPerSet:        maxRecordsPerSet,
+		MaxNonceBytes:           maxNonceBytes,
+		MaxCiphertextBytes:      maxCiphertextBytes,
+		MaxTotalCiphertextBytes: maxTotalCiphertextBytes,
+		StorageFeeDenom:         storageFeeDenom,
+		BaseStorageFee:          baseStorageFee,
+		StorageFeePerByte:       storageFeePerByte,
+		BurnStorageFee:          burnStorageFee,
+		MaxBatchCiphertextBytes: maxBatchCiphertextBytes,
+		AllowedHashAlgorithms:   allowedHashAlgorithms,
+		RequireRegisteredTypes:  requireRegisteredTypes,
+	}
 }
 
 // DefaultParams returns a default set of parameters.
 func DefaultParams() Params {
-	return NewParams()
+	return NewParams(
+		DefaultMaxTenantLength,
+		DefaultMaxTypeLength,
+		DefaultMaxPeriodLength,
+		DefaultMaxRecordsPerSet,
+		DefaultMaxNonceBytes,
+		DefaultMaxCiphertextBytes,
+		DefaultMaxTotalCiphertextBytes,
+		DefaultStorageFeeDenom,
+		DefaultBaseStorageFee,
+		DefaultStorageFeePerByte,
+		DefaultBurnStorageFee,
+		DefaultMaxBatchCiphertextBytes,
+		slices.Clone(DefaultAllowedHashAlgorithms),
+		DefaultRequireRegisteredTypes,
+	)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	limits := []struct {
+		name     string
+		value    uint64
+		absolute uint64
+	}{
+		{"max tenant length", p.MaxTenantLength, AbsoluteMaxTenantLength},
+		{"max type length", p.MaxTypeLength, AbsoluteMaxTypeLength},
+		{"max period length", p.MaxPeriodLength, AbsoluteMaxPeriodLength},
+		{"max records per set", p.MaxRecordsPerSet, AbsoluteMaxRecordsPerSet},
+		{"max nonce bytes", p.MaxNonceBytes, AbsoluteMaxNonceBytes},
+		{"max ciphertext bytes", p.MaxCiphertextBytes, AbsoluteMaxCiphertextBytes},
+		{"max total ciphertext bytes", p.MaxTotalCiphertextBytes, AbsoluteMaxTotalCiphertextBytes},
+		{"max batch ciphertext bytes", p.MaxBatchCiphertextBytes, AbsoluteMaxBatchCiphertextBytes},
+	}
+	for _, limit := range limits {
+		if limit.value == 0 {
+			return fmt.Errorf("%s must be positive", limit.name)
+		}
+		if limit.value > limit.absolute {
+			return fmt.Errorf("%s %d exceeds the absolute maximum %d", limit.name, limit.value, limit.absolute)
+		}
+	}
+	if p.MaxCiphertextBytes > p.MaxTotalCiphertextBytes {
+		return fmt.Errorf("max ciphertext bytes %d exceeds max total ciphertext bytes %d", p.MaxCiphertextBytes, p.MaxTotalCiphertextBytes)
+	}
+
+	if err := sdk.ValidateDenom(p.StorageFeeDenom); err != nil {
+		return fmt.Errorf("invalid storage fee denom: %w", err)
+	}
+	if p.BaseStorageFee.IsNil() || p.BaseStorageFee.IsNegative() {
+		return fmt.Errorf("base storage fee must not be negative")
+	}
+	if p.StorageFeePerByte.IsNil() || p.StorageFeePerByte.IsNegative() {
+		return fmt.Errorf("storage fee per byte must not be negative")
+	}
 
+	if len(p.AllowedHashAlgorithms) == 0 {
+		return fmt.Errorf("allowed hash algorithms must not be empty")
+	}
+	for i, algorithm := range p.AllowedHashAlgorithms {
+		if err := algorithm.Validate(); err != nil {
+			return err
+		}
+		if slices.Contains(p.AllowedHashAlgorithms[:i], algorithm) {
+			return fmt.Errorf("duplicate allowed hash algorithm %s", algorithm)
+		}
+	}
+
+	return nil
+}
+
+// ValidateHashAlgorithm rejects hash algorithms that new sets may not be committed with.
+func (p Params) ValidateHashAlgorithm(algorithm HashAlgorithm) error {
+	if err := algorithm.Validate(); err != nil {
+		return err
+	}
+	if !slices.Contains(p.AllowedHashAlgorithms, algorithm) {
+		return ErrHashAlgorithmNotAllowed.Wrapf("hash algorithm %s is not allowed", algorithm)
+	}
 	return nil
 }
+
+// StorageFee returns the fee charged for committing a set with the given decoded ciphertext size.
+func (p Params) StorageFee(ciphertextBytes int) sdk.Coins {
+	amount := p.BaseStorageFee.Add(p.StorageFeePerByte.MulRaw(int64(ciphertextBytes)))
+	return sdk.NewCoins(sdk.NewCoin(p.StorageFeeDenom, amount))
+}
+
+// StorageByteFee returns the per-byte part of the storage fee, charged on each append to an open set
+// whose base fee was already paid when it was opened.
+func (p Params) StorageByteFee(ciphertextBytes int) sdk.Coins {
+	amount := p.StorageFeePerByte.MulRaw(int64(ciphertextBytes))
+	return sdk.NewCoins(sdk.NewCoin(p.StorageFeeDenom, amount))
+}
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,6 +28,34 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
+	// max_tenant_length is the maximum tenant name length in characters.
+	MaxTenantLength uint64 `protobuf:"varint,1,opt,name=max_tenant_length,json=maxTenantLength,proto3" json:"max_tenant_length,omitempty"`
+	// max_type_length is the maximum integrity type length in characters.
+	MaxTypeLength uint64 `protobuf:"varint,2,opt,name=max_type_length,json=maxTypeLength,proto3" json:"max_type_length,omitempty"`
+	// max_period_length is the maximum period length in characters.
+	MaxPeriodLength uint64 `protobuf:"varint,3,opt,name=max_period_length,json=maxPeriodLength,proto3" json:"max_period_length,omitempty"`
+	// max_records_per_set is the maximum number of records in one integrity set.
+	MaxRecordsPerSet uint64 `protobuf:"varint,4,opt,name=max_records_per_set,json=maxRecordsPerSet,proto3" json:"max_records_per_set,omitempty"`
+	// max_nonce_bytes is the maximum decoded size of one record nonce.
+	MaxNonceBytes uint64 `protobuf:"varint,5,opt,name=max_nonce_bytes,json=maxNonceBytes,proto3" json:"max_nonce_bytes,omitempty"`
+	// max_ciphertext_bytes is the maximum decoded size of one record ciphertext.
+	MaxCiphertextBytes uint64 `protobuf:"varint,6,opt,name=max_ciphertext_bytes,json=maxCiphertextBytes,proto3" json:"max_ciphertext_bytes,omitempty"`
+	// max_total_ciphertext_bytes is the maximum decoded ciphertext size of one integrity set.
+	MaxTotalCiphertextBytes uint64 `protobuf:"varint,7,opt,name=max_total_ciphertext_bytes,json=maxTotalCiphertextBytes,proto3" json:"max_total_ciphertext_bytes,omitempty"`
+	// storage_fee_denom is the denom in which integrity storage fees are charged.
+	StorageFeeDenom string `protobuf:"bytes,8,opt,name=storage_fee_denom,json=storageFeeDenom,proto3" json:"storage_fee_denom,omitempty"`
+	// base_storage_fee is charged once for every committed integrity set.
+	BaseStorageFee cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=base_storage_fee,json=baseStorageFee,proto3,customtype=cosmossdk.io/math.Int" json:"base_storage_fee"`
+	// storage_fee_per_byte is charged for every decoded ciphertext byte of a committed integrity set.
+	StorageFeePerByte cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=storage_fee_per_byte,json=storageFeePerByte,proto3,customtype=cosmossdk.io/math.Int" json:"storage_fee_per_byte"`
+	// burn_storage_fee burns collected storage fees instead of funding the community pool.
+	BurnStorageFee bool `protobuf:"varint,11,opt,name=burn_storage_fee,json=burnStorageFee,proto3" json:"burn_storage_fee,omitempty"`
+	// max_batch_ciphertext_bytes is the maximum decoded ciphertext size across all sets of one batch commit.
+	MaxBatchCiphertextBytes uint64 `protobuf:"varint,12,opt,name=max_batch_ciphertext_bytes,json=maxBatchCiphertextBytes,proto3" json:"max_batch_ciphertext_bytes,omitempty"`
+	// allowed_hash_algorithms lists the hash algorithms new integrity sets may be committed with.
+	AllowedHashAlgorithms []HashAlgorithm `protobuf:"varint,13,rep,packed,name=allowed_hash_algorithms,json=allowedHashAlgorithms,proto3,enum=kudora.integrity.v1.HashAlgorithm" json:"allowed_hash_algorithms,omitempty"`
+	// require_registered_types rejects new sets whose type was not registered by the tenant.
+	RequireRegisteredTypes bool `protobuf:"varint,14,opt,name=require_registered_types,json=requireRegisteredTypes,proto3" json:"require_registered_types,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -61,6 +91,90 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+func (m *Params) GetMaxTenantLength() uint64 {
+	if m != nil {
+		return m.MaxTenantLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTypeLength() uint64 {
+	if m != nil {
+		return m.MaxTypeLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxPeriodLength() uint64 {
+	if m != nil {
+		return m.MaxPeriodLength
+	}
+	return 0
+}
+
+func (m *Params) GetMaxRecordsPerSet() uint64 {
+	if m != nil {
+		return m.MaxRecordsPerSet
+	}
+	return 0
+}
+
+func (m *Params) GetMaxNonceBytes() uint64 {
+	if m != nil {
+		return m.MaxNonceBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxCiphertextBytes() uint64 {
+	if m != nil {
+		return m.MaxCiphertextBytes
+	}
+	return 0
+}
+
+func (m *Params) GetMaxTotalCiphertextBytes() uint64 {
+	if m != nil {
+		return m.MaxTotalCiphertextBytes
+	}
+	return 0
+}
+
+func (m *Params) GetStorageFeeDenom() string {
+	if m != nil {
+		return m.StorageFeeDenom
+	}
+	return ""
+}
+
+func (m *Params) GetBurnStorageFee() bool {
+	if m != nil {
+		return m.BurnStorageFee
+	}
+	return false
+}
+
+func (m *Params) GetMaxBatchCiphertextBytes() uint64 {
+	if m != nil {
+		return m.MaxBatchCiphertextBytes
+	}
+	return 0
+}
+
+func (m *Params) GetAllowedHashAlgorithms() []HashAlgorithm {
+	if m != nil {
+		return m.AllowedHashAlgorithms
+	}
+	return nil
+}
+
+func (m *Params) GetRequireRegisteredTypes() bool {
+	if m != nil {
+		return m.RequireRegisteredTypes
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "kudora.integrity.v1.Params")
 }
@@ -68,19 +182,45 @@ func init() {
 func init() { proto.RegisterFile("kudora/integrity/v1/params.proto", fileDescriptor_4b0df0145adb159f) }
 
 var fileDescriptor_4b0df0145adb159f = []byte{
-	// 191 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0x2e, 0x4d, 0xc9,
-	0x2f, 0x4a, 0xd4, 0xcf, 0xcc, 0x2b, 0x49, 0x4d, 0x2f, 0xca, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4,
-	0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86, 0xa8,
-	0xd0, 0x83, 0xab, 0xd0, 0x2b, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb, 0xd7, 0x07, 0x93,
-	0x10, 0x75, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0xd2,
-	0xe1, 0x62, 0x0b, 0x00, 0x9b, 0x66, 0xa5, 0xf4, 0x62, 0x81, 0x3c, 0x63, 0xd7, 0xf3, 0x0d, 0x5a,
-	0x92, 0x50, 0x2b, 0x2b, 0x90, 0x2c, 0x85, 0xa8, 0x71, 0x0a, 0x3c, 0xf1, 0x48, 0x8e, 0xf1, 0xc2,
-	0x23, 0x39, 0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1,
-	0xc6, 0x63, 0x39, 0x86, 0x28, 0xf3, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c,
-	0xfd, 0xb0, 0xc4, 0x9c, 0xd4, 0xbc, 0x92, 0xcc, 0x3c, 0xa7, 0xa2, 0xd4, 0x94, 0xd4, 0xdc, 0xd4,
-	0xe2, 0x92, 0xa2, 0x54, 0x7d, 0x2c, 0x66, 0x96, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xdd,
-	0x61, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x3c, 0x43, 0xa2, 0xdc, 0xe9, 0x00, 0x00, 0x00,
+	// 600 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0x31, 0x6f, 0xd3, 0x40,
+	0x14, 0xc7, 0x63, 0x5a, 0x42, 0x7b, 0xd0, 0xb4, 0x71, 0x5b, 0xea, 0x76, 0x70, 0xa3, 0x0e, 0x10,
+	0x45, 0x8a, 0xdd, 0xc2, 0x82, 0x60, 0x22, 0x20, 0x44, 0x44, 0x85, 0x22, 0x97, 0x29, 0x8b, 0x75,
+	0xb6, 0x1f, 0xb6, 0xd5, 0xd8, 0x67, 0xee, 0x2e, 0xc5, 0xf9, 0x0a, 0x4c, 0x7c, 0x04, 0x46, 0xc6,
+	0x0e, 0x7c, 0x88, 0x8e, 0x15, 0x13, 0xea, 0x50, 0xa1, 0x64, 0x28, 0x1f, 0x03, 0xdd, 0xf9, 0x62,
+	0xd2, 0x36, 0x13, 0x4b, 0x94, 0xfb, 0xbf, 0xdf, 0x7b, 0xf7, 0x7f, 0xcf, 0xf7, 0x50, 0xe3, 0x78,
+	0x18, 0x10, 0x8a, 0xed, 0x38, 0xe5, 0x10, 0xd2, 0x98, 0x8f, 0xec, 0x93, 0x03, 0x3b, 0xc3, 0x14,
+	0x27, 0xcc, 0xca, 0x28, 0xe1, 0x44, 0x5f, 0x2f, 0x08, 0xab, 0x24, 0xac, 0x93, 0x83, 0x9d, 0x3a,
+	0x4e, 0xe2, 0x94, 0xd8, 0xf2, 0xb7, 0xe0, 0x76, 0xb6, 0x7d, 0xc2, 0x12, 0xc2, 0x5c, 0x79, 0xb2,
+	0x8b, 0x83, 0x0a, 0x6d, 0x84, 0x24, 0x24, 0x85, 0x2e, 0xfe, 0x29, 0xf5, 0xf1, 0xbc, 0xab, 0xcb,
+	0x83, 0xcb, 0x80, 0x17, 0xe0, 0xde, 0x45, 0x15, 0x55, 0x7b, 0xd2, 0x92, 0xde, 0x42, 0xf5, 0x04,
+	0xe7, 0x2e, 0x87, 0x14, 0xa7, 0xdc, 0x1d, 0x40, 0x1a, 0xf2, 0xc8, 0xd0, 0x1a, 0x5a, 0x73, 0xd1,
+	0x59, 0x4d, 0x70, 0xfe, 0x41, 0xea, 0x87, 0x52, 0xd6, 0x1f, 0xa1, 0x55, 0xc9, 0x8e, 0x32, 0x98,
+	0x92, 0x77, 0x24, 0xb9, 0x22, 0xc8, 0x51, 0x06, 0x8a, 0x53, 0x35, 0x33, 0xa0, 0x31, 0x09, 0xa6,
+	0xe4, 0x42, 0x59, 0xb3, 0x27, 0x75, 0xc5, 0xb6, 0xd1, 0xba, 0x60, 0x29, 0xf8, 0x84, 0x06, 0x4c,
+	0xe4, 0x08, 0x9f, 0xc6, 0xa2, 0xa4, 0xd7, 0x12, 0x9c, 0x3b, 0x45, 0xa4, 0x07, 0xf4, 0x08, 0xf8,
+	0xd4, 0x42, 0x4a, 0x52, 0x1f, 0x5c, 0x6f, 0xc4, 0x81, 0x19, 0x77, 0x4b, 0x0b, 0xef, 0x85, 0xda,
+	0x11, 0xa2, 0xbe, 0x8f, 0x36, 0x04, 0xe7, 0xc7, 0x59, 0x04, 0x94, 0x43, 0xce, 0x15, 0x5c, 0x95,
+	0xb0, 0x9e, 0xe0, 0xfc, 0x55, 0x19, 0x2a, 0x32, 0x5e, 0xa0, 0x1d, 0xd9, 0x1c, 0xe1, 0x78, 0x70,
+	0x3b, 0xef, 0x9e, 0xcc, 0xdb, 0x12, 0x7d, 0x0a, 0xe0, 0x66, 0x72, 0x0b, 0xd5, 0x19, 0x27, 0x14,
+	0x87, 0xe0, 0x7e, 0x04, 0x70, 0x03, 0x48, 0x49, 0x62, 0x2c, 0x35, 0xb4, 0xe6, 0xb2, 0xb3, 0xaa,
+	0x02, 0x6f, 0x00, 0x5e, 0x0b, 0x59, 0xef, 0xa3, 0x35, 0x0f, 0x33, 0x70, 0x67, 0x12, 0x8c, 0x65,
+	0x81, 0x76, 0xf6, 0xcf, 0x2e, 0x77, 0x2b, 0x17, 0x97, 0xbb, 0x9b, 0xc5, 0xb7, 0x66, 0xc1, 0xb1,
+	0x15, 0x13, 0x3b, 0xc1, 0x3c, 0xb2, 0xba, 0x29, 0xff, 0xf9, 0xa3, 0x8d, 0xd4, 0x23, 0xe8, 0xa6,
+	0xfc, 0xfb, 0xd5, 0x69, 0x4b, 0x73, 0x6a, 0xa2, 0xd2, 0x51, 0x79, 0x81, 0x8e, 0xd1, 0xc6, 0xac,
+	0x0f, 0x31, 0x4d, 0xe1, 0xdf, 0x40, 0xff, 0x59, 0xbf, 0xfe, 0xcf, 0x7c, 0x0f, 0xa8, 0xe8, 0x55,
+	0x6f, 0xa2, 0x35, 0x6f, 0x48, 0xd3, 0x6b, 0xf6, 0xef, 0x37, 0xb4, 0xe6, 0x92, 0x53, 0x13, 0xfa,
+	0x8c, 0x19, 0x35, 0x51, 0x0f, 0x73, 0x3f, 0xba, 0x3d, 0xd1, 0x07, 0xe5, 0x44, 0x3b, 0x02, 0xb8,
+	0x39, 0xd1, 0x3e, 0xda, 0xc2, 0x83, 0x01, 0xf9, 0x0c, 0x81, 0x1b, 0x61, 0x16, 0xb9, 0x78, 0x10,
+	0x12, 0x1a, 0xf3, 0x28, 0x61, 0xc6, 0x4a, 0x63, 0xa1, 0x59, 0x7b, 0xb2, 0x67, 0xcd, 0x59, 0x23,
+	0xeb, 0x2d, 0x66, 0xd1, 0xcb, 0x29, 0xea, 0x6c, 0xaa, 0x12, 0xd7, 0x54, 0xa6, 0x3f, 0x43, 0x06,
+	0x85, 0x4f, 0xc3, 0x98, 0x82, 0x4b, 0x21, 0x8c, 0x19, 0x07, 0x0a, 0x81, 0x7c, 0xd6, 0xcc, 0xa8,
+	0xc9, 0x56, 0x1e, 0xaa, 0xb8, 0x53, 0x86, 0xc5, 0xf3, 0x66, 0xcf, 0xf7, 0xfe, 0x7c, 0xdb, 0xd5,
+	0xbe, 0x5c, 0x9d, 0xb6, 0xb6, 0xd5, 0xaa, 0xe5, 0x33, 0xcb, 0x56, 0x6c, 0x54, 0xa7, 0x7b, 0x36,
+	0x36, 0xb5, 0xf3, 0xb1, 0xa9, 0xfd, 0x1e, 0x9b, 0xda, 0xd7, 0x89, 0x59, 0x39, 0x9f, 0x98, 0x95,
+	0x5f, 0x13, 0xb3, 0xd2, 0xb7, 0xc3, 0x98, 0x47, 0x43, 0xcf, 0xf2, 0x49, 0x62, 0xbf, 0x93, 0xf9,
+	0xed, 0x43, 0xec, 0x31, 0x7b, 0x4e, 0x2d, 0x69, 0xc6, 0xab, 0xca, 0x75, 0x7d, 0xfa, 0x77, 0x00,
+	0xf5, 0x2e, 0x6f, 0x15, 0x54, 0x04, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -102,6 +242,53 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
+	if this.MaxTenantLength != that1.MaxTenantLength {
+		return false
+	}
+	if this.MaxTypeLength != that1.MaxTypeLength {
+		return false
+	}
+	if this.MaxPeriodLength != that1.MaxPeriodLength {
+		return false
+	}
+	if this.MaxRecordsPerSet != that1.MaxRecordsPerSet {
+		return false
+	}
+	if this.MaxNonceBytes != that1.MaxNonceBytes {
+		return false
+	}
+	if this.MaxCiphertextBytes != that1.MaxCiphertextBytes {
+		return false
+	}
+	if this.MaxTotalCiphertextBytes != that1.MaxTotalCiphertextBytes {
+		return false
+	}
+	if this.StorageFeeDenom != that1.StorageFeeDenom {
+		return false
+	}
+	if !this.BaseStorageFee.Equal(that1.BaseStorageFee) {
+		return false
+	}
+	if !this.StorageFeePerByte.Equal(that1.StorageFeePerByte) {
+		return false
+	}
+	if this.BurnStorageFee != that1.BurnStorageFee {
+		return false
+	}
+	if this.MaxBatchCiphertextBytes != that1.MaxBatchCiphertextBytes {
+		return false
+	}
+	if len(this.AllowedHashAlgorithms) != len(that1.AllowedHashAlgorithms) {
+		return false
+	}
+	for i := range this.AllowedHashAlgorithms {
+		if this.AllowedHashAlgorithms[i] != that1.AllowedHashAlgorithms[i] {
+			return false
+		}
+	}
+	if this.RequireRegisteredTypes != that1.RequireRegisteredTypes {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -124,6 +311,111 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RequireRegisteredTypes {
+		i--
+		if m.RequireRegisteredTypes {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.AllowedHashAlgorithms) > 0 {
+		dAtA2 := make([]byte, len(m.AllowedHashAlgorithms)*10)
+		var j1 int
+		for _, num := range m.AllowedHashAlgorithms {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintParams(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.MaxBatchCiphertextBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchCiphertextBytes))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BurnStorageFee {
+		i--
+		if m.BurnStorageFee {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.StorageFeePerByte.Size()
+		i -= size
+		if _, err := m.StorageFeePerByte.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.BaseStorageFee.Size()
+		i -= size
+		if _, err := m.BaseStorageFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.StorageFeeDenom) > 0 {
+		i -= len(m.StorageFeeDenom)
+		copy(dAtA[i:], m.StorageFeeDenom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.StorageFeeDenom)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxTotalCiphertextBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTotalCiphertextBytes))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxCiphertextBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCiphertextBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxNonceBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxNonceBytes))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRecordsPerSet != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecordsPerSet))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaxPeriodLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxPeriodLength))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxTypeLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTypeLength))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MaxTenantLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxTenantLength))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
@@ -144,6 +436,51 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
+	if m.MaxTenantLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTenantLength))
+	}
+	if m.MaxTypeLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxTypeLength))
+	}
+	if m.MaxPeriodLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxPeriodLength))
+	}
+	if m.MaxRecordsPerSet != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecordsPerSet))
+	}
+	if m.MaxNonceBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxNonceBytes))
+	}
+	if m.MaxCiphertextBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxCiphertextBytes))
+	}
+	if m.MaxTotalCiphertextBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxTotalCiphertextBytes))
+	}
+	l = len(m.StorageFeeDenom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.BaseStorageFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.StorageFeePerByte.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.BurnStorageFee {
+		n += 2
+	}
+	if m.MaxBatchCiphertextBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchCiphertextBytes))
+	}
+	if len(m.AllowedHashAlgorithms) > 0 {
+		l = 0
+		for _, e := range m.AllowedHashAlgorithms {
+			l += sovParams(uint64(e))
+		}
+		n += 1 + sovParams(uint64(l)) + l
+	}
+	if m.RequireRegisteredTypes {
+		n += 2
+	}
 	return n
 }
 
@@ -182,6 +519,367 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTenantLength", wireType)
+			}
+			m.MaxTenantLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTenantLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTypeLength", wireType)
+			}
+			m.MaxTypeLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTypeLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPeriodLength", wireType)
+			}
+			m.MaxPeriodLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPeriodLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordsPerSet", wireType)
+			}
+			m.MaxRecordsPerSet = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordsPerSet |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxNonceBytes", wireType)
+			}
+			m.MaxNonceBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxNonceBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCiphertextBytes", wireType)
+			}
+			m.MaxCiphertextBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCiphertextBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxTotalCiphertextBytes", wireType)
+			}
+			m.MaxTotalCiphertextBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxTotalCiphertextBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageFeeDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageFeeDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseStorageFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseStorageFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageFeePerByte", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StorageFeePerByte.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnStorageFee", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnStorageFee = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchCiphertextBytes", wireType)
+			}
+			m.MaxBatchCiphertextBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchCiphertextBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType == 0 {
+				var v HashAlgorithm
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= HashAlgorithm(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.AllowedHashAlgorithms = append(m.AllowedHashAlgorithms, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowParams
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthParams
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthParams
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.AllowedHashAlgorithms) == 0 {
+					m.AllowedHashAlgorithms = make([]HashAlgorithm, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v HashAlgorithm
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowParams
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= HashAlgorithm(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.AllowedHashAlgorithms = append(m.AllowedHashAlgorithms, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedHashAlgorithms", wireType)
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireRegisteredTypes", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireRegisteredTypes = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,210 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// periodSortKeyTimeLayout renders interval bounds with a fixed width so that sort keys of intervals compare
+// chronologically as plain strings.
+const periodSortKeyTimeLayout = "2006-01-02T15:04:05.000000000Z"
+
+// Validate rejects period formats that are not defined.
+func (f PeriodFormat) Validate() error {
+	if _, ok := PeriodFormat_name[int32(f)]; !ok {
+		return ErrInvalidPeriodFormat.Wrapf("unknown period format %d", f)
+	}
+	return nil
+}
+
+// NormalizePeriod parses period under the format and returns its canonical form, so that spellings of
+// the same period such as 2026-6-25 and 2026-06-25 name one set. The format must be valid.
+func (f PeriodFormat) NormalizePeriod(period string) (string, error) {
+	period, err := NormalizePeriod(period)
+	if err != nil {
+		return "", err
+	}
+
+	switch f {
+	case PeriodFormat_PERIOD_FORMAT_ISO_DATE:
+		parts, err := periodNumbers(period, "-", 3)
+		if err != nil {
+			return "", ErrInvalidPeriod.Wrapf("period %q is not an ISO date: %s", period, err)
+		}
+		date := time.Date(parts[0], time.Month(parts[1]), parts[2], 0, 0, 0, 0, time.UTC)
+		if date.Year() != parts[0] || int(date.Month()) != parts[1] || date.Day() != parts[2] {
+			return "", ErrInvalidPeriod.Wrapf("period %q is not a calendar date", period)
+		}
+		return date.Format(time.DateOnly), nil
+	case PeriodFormat_PERIOD_FORMAT_ISO_WEEK:
+		year, week, ok := strings.Cut(period, "-")
+		if !ok || (!strings.HasPrefix(week, "W") && !strings.HasPrefix(week, "w")) {
+			return "", ErrInvalidPeriod.Wrapf("period %q is not an ISO week", period)
+		}
+		parts, err := periodNumbers(year+"-"+week[1:], "-", 2)
+		if err != nil {
+			return "", ErrInvalidPeriod.Wrapf("period %q is not an ISO week: %s", period, err)
+		}
+		if _, weeks := time.Date(parts[0], 12, 28, 0, 0, 0, 0, time.UTC).ISOWeek(); parts[1] < 1 || parts[1] > weeks {
+			return "", ErrInvalidPeriod.Wrapf("year %04d has no week %d", parts[0], parts[1])
+		}
+		return fmt.Sprintf("%04d-W%02d", parts[0], parts[1]), nil
+	case PeriodFormat_PERIOD_FORMAT_MONTH:
+		parts, err := periodNumbers(period, "-", 2)
+		if err != nil {
+			return "", ErrInvalidPeriod.Wrapf("period %q is not a month: %s", period, err)
+		}
+		if parts[1] < 1 || parts[1] > 12 {
+			return "", ErrInvalidPeriod.Wrapf("period %q is not a month", period)
+		}
+		return fmt.Sprintf("%04d-%02d", parts[0], parts[1]), nil
+	case PeriodFormat_PERIOD_FORMAT_INTERVAL:
+		start, end, err := parsePeriodInterval(period)
+		if err != nil {
+			return "", err
+		}
+		return start.Format(time.RFC3339Nano) + "/" + end.Format(time.RFC3339Nano), nil
+	case PeriodFormat_PERIOD_FORMAT_SEQUENCE:
+		sequence, err := parsePeriodSequence(period)
+		if err != nil {
+			return "", err
+		}
+		return strconv.FormatUint(sequence, 10), nil
+	default:
+		return period, nil
+	}
+}
+
+// PeriodSortKey returns the key that orders canonical periods of the format chronologically, or
+// numerically for sequences, when compared as plain strings.
+func (f PeriodFormat) PeriodSortKey(period string) (string, error) {
+	switch f {
+	case PeriodFormat_PERIOD_FORMAT_INTERVAL:
+		start, end, err := parsePeriodInterval(period)
+		if err != nil {
+			return "", err
+		}
+		return start.Format(periodSortKeyTimeLayout) + "/" + end.Format(periodSortKeyTimeLayout), nil
+	case PeriodFormat_PERIOD_FORMAT_SEQUENCE:
+		sequence, err := parsePeriodSequence(period)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%020d", sequence), nil
+	default:
+		// Dates, weeks and months are fixed width once normalized and already sort as strings.
+		return f.NormalizePeriod(period)
+	}
+}
+
+// PeriodRange turns the inclusive from and to bounds of a range query into sort keys. start is inclusive
+// and end is exclusive; an empty key leaves that side open. Interval formats also accept a single RFC 3339
+// timestamp as a bound on the interval start.
+func (f PeriodFormat) PeriodRange(from, to string) (start, end string, err error) {
+	if strings.TrimSpace(from) != "" {
+		start, err = f.periodRangeBound(from, false)
+		if err != nil {
+			return "", "", err
+		}
+	}
+	if strings.TrimSpace(to) != "" {
+		end, err = f.periodRangeBound(to, true)
+		if err != nil {
+			return "", "", err
+		}
+		if end <= start {
+			return "", "", ErrInvalidPeriod.Wrapf("range end %s is before range start %s", to, from)
+		}
+	}
+	return start, end, nil
+}
+
+func (f PeriodFormat) periodRangeBound(bound string, upper bool) (string, error) {
+	if f == PeriodFormat_PERIOD_FORMAT_INTERVAL && !strings.Contains(bound, "/") {
+		instant, err := parsePeriodTime(strings.TrimSpace(bound))
+		if err != nil {
+			return "", ErrInvalidPeriod.Wrapf("range bound %q is neither an interval nor an RFC 3339 time", bound)
+		}
+		if upper {
+			// Every interval starting at instant sorts before the next nanosecond.
+			instant = instant.Add(time.Nanosecond)
+		}
+		return instant.Format(periodSortKeyTimeLayout), nil
+	}
+
+	key, err := f.PeriodSortKey(bound)
+	if err != nil {
+		return "", err
+	}
+	if upper {
+		// NormalizePeriod rejects NUL bytes, so no sort key contains one and this is the first key after the bound.
+		key += "\x00"
+	}
+	return key, nil
+}
+
+// periodNumbers splits a period into count unsigned decimal fields. The first field is a four-digit year.
+func periodNumbers(period, separator string, count int) ([]int, error) {
+	fields := strings.Split(period, separator)
+	if len(fields) != count {
+		return nil, fmt.Errorf("expected %d fields separated by %q", count, separator)
+	}
+	numbers := make([]int, count)
+	for i, field := range fields {
+		if field == "" || len(field) > 4 || strings.Trim(field, "0123456789") != "" {
+			return nil, fmt.Errorf("field %q is not a number", field)
+		}
+		numbers[i], _ = strconv.Atoi(field)
+	}
+	if len(fields[0]) != 4 || numbers[0] == 0 {
+		return nil, fmt.Errorf("year must have four digits")
+	}
+	return numbers, nil
+}
+
+func parsePeriodInterval(period string) (time.Time, time.Time, error) {
+	startText, endText, ok := strings.Cut(strings.TrimSpace(period), "/")
+	if !ok {
+		return time.Time{}, time.Time{}, ErrInvalidPeriod.Wrapf("period %q is not an RFC 3339 interval", period)
+	}
+	start, err := parsePeriodTime(startText)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidPeriod.Wrapf("interval start %q is not an RFC 3339 time", startText)
+	}
+	end, err := parsePeriodTime(endText)
+	if err != nil {
+		return time.Time{}, time.Time{}, ErrInvalidPeriod.Wrapf("interval end %q is not an RFC 3339 time", endText)
+	}
+	if !start.Before(end) {
+		return time.Time{}, time.Time{}, ErrInvalidPeriod.Wrapf("interval %q must start before it ends", period)
+	}
+	return start, end, nil
+}
+
+// parsePeriodTime parses an RFC 3339 time into UTC, keeping it within four-digit years so that its sort
+// key stays fixed width.
+func parsePeriodTime(value string) (time.Time, error) {
+	parsed, err := time.Parse(time.RFC3339Nano, value)
+	if err != nil {
+		return time.Time{}, err
+	}
+	parsed = parsed.UTC()
+	if parsed.Year() < 1 || parsed.Year() > 9999 {
+		return time.Time{}, fmt.Errorf("year %d is out of range", parsed.Year())
+	}
+	return parsed, nil
+}
+
+func parsePeriodSequence(period string) (uint64, error) {
+	period = strings.TrimSpace(period)
+	if period == "" || strings.Trim(period, "0123456789") != "" {
+		return 0, ErrInvalidPeriod.Wrapf("period %q is not a sequence number", period)
+	}
+	sequence, err := strconv.ParseUint(period, 10, 64)
+	if err != nil {
+		return 0, ErrInvalidPeriod.Wrapf("period %q is not a sequence number: %s", period, err)
+	}
+	return sequence, nil
+}
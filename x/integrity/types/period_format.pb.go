@@ -0,0 +1,600 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudora/integrity/v1/period_format.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PeriodFormat defines how the periods of one integrity type are parsed and ordered.
+type PeriodFormat int32
+
+const (
+	// PERIOD_FORMAT_UNSPECIFIED accepts any period up to the configured maximum length and orders periods
+	// as plain strings. It is the zero value so that types without a declared format keep their meaning.
+	PeriodFormat_PERIOD_FORMAT_UNSPECIFIED PeriodFormat = 0
+	// PERIOD_FORMAT_ISO_DATE is a calendar date such as 2026-06-25.
+	PeriodFormat_PERIOD_FORMAT_ISO_DATE PeriodFormat = 1
+	// PERIOD_FORMAT_ISO_WEEK is an ISO 8601 week such as 2026-W26.
+	PeriodFormat_PERIOD_FORMAT_ISO_WEEK PeriodFormat = 2
+	// PERIOD_FORMAT_MONTH is a calendar month such as 2026-06.
+	PeriodFormat_PERIOD_FORMAT_MONTH PeriodFormat = 3
+	// PERIOD_FORMAT_INTERVAL is an RFC 3339 interval start/end with start before end, normalized to UTC.
+	PeriodFormat_PERIOD_FORMAT_INTERVAL PeriodFormat = 4
+	// PERIOD_FORMAT_SEQUENCE is a monotonic unsigned decimal sequence number.
+	PeriodFormat_PERIOD_FORMAT_SEQUENCE PeriodFormat = 5
+)
+
+var PeriodFormat_name = map[int32]string{
+	0: "PERIOD_FORMAT_UNSPECIFIED",
+	1: "PERIOD_FORMAT_ISO_DATE",
+	2: "PERIOD_FORMAT_ISO_WEEK",
+	3: "PERIOD_FORMAT_MONTH",
+	4: "PERIOD_FORMAT_INTERVAL",
+	5: "PERIOD_FORMAT_SEQUENCE",
+}
+
+var PeriodFormat_value = map[string]int32{
+	"PERIOD_FORMAT_UNSPECIFIED": 0,
+	"PERIOD_FORMAT_ISO_DATE":    1,
+	"PERIOD_FORMAT_ISO_WEEK":    2,
+	"PERIOD_FORMAT_MONTH":       3,
+	"PERIOD_FORMAT_INTERVAL":    4,
+	"PERIOD_FORMAT_SEQUENCE":    5,
+}
+
+func (x PeriodFormat) String() string {
+	return proto.EnumName(PeriodFormat_name, int32(x))
+}
+
+func (PeriodFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_49ce5b33f7d41029, []int{0}
+}
+
+// TypePeriodFormat is the period format a tenant declared for one of its integrity types.
+type TypePeriodFormat struct {
+	Tenant         string       `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type           string       `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Format         PeriodFormat `protobuf:"varint,3,opt,name=format,proto3,enum=kudora.integrity.v1.PeriodFormat" json:"format,omitempty"`
+	DeclaredBy     string       `protobuf:"bytes,4,opt,name=declared_by,json=declaredBy,proto3" json:"declared_by,omitempty"`
+	DeclaredHeight uint64       `protobuf:"varint,5,opt,name=declared_height,json=declaredHeight,proto3" json:"declared_height,omitempty"`
+	DeclaredTime   string       `protobuf:"bytes,6,opt,name=declared_time,json=declaredTime,proto3" json:"declared_time,omitempty"`
+}
+
+func (m *TypePeriodFormat) Reset()         { *m = TypePeriodFormat{} }
+func (m *TypePeriodFormat) String() string { return proto.CompactTextString(m) }
+func (*TypePeriodFormat) ProtoMessage()    {}
+func (*TypePeriodFormat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49ce5b33f7d41029, []int{0}
+}
+func (m *TypePeriodFormat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TypePeriodFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TypePeriodFormat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TypePeriodFormat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TypePeriodFormat.Merge(m, src)
+}
+func (m *TypePeriodFormat) XXX_Size() int {
+	return m.Size()
+}
+func (m *TypePeriodFormat) XXX_DiscardUnknown() {
+	xxx_messageInfo_TypePeriodFormat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TypePeriodFormat proto.InternalMessageInfo
+
+func (m *TypePeriodFormat) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *TypePeriodFormat) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TypePeriodFormat) GetFormat() PeriodFormat {
+	if m != nil {
+		return m.Format
+	}
+	return PeriodFormat_PERIOD_FORMAT_UNSPECIFIED
+}
+
+func (m *TypePeriodFormat) GetDeclaredBy() string {
+	if m != nil {
+		return m.DeclaredBy
+	}
+	return ""
+}
+
+func (m *TypePeriodFormat) GetDeclaredHeight() uint64 {
+	if m != nil {
+		return m.DeclaredHeight
+	}
+	return 0
+}
+
+func (m *TypePeriodFormat) GetDeclaredTime() string {
+	if m != nil {
+		return m.DeclaredTime
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("kudora.integrity.v1.PeriodFormat", PeriodFormat_name, PeriodFormat_value)
+	proto.RegisterType((*TypePeriodFormat)(nil), "kudora.integrity.v1.TypePeriodFormat")
+}
+
+func init() {
+	proto.RegisterFile("kudora/integrity/v1/period_format.proto", fileDescriptor_49ce5b33f7d41029)
+}
+
+var fileDescriptor_49ce5b33f7d41029 = []byte{
+	// 375 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x52, 0xcd, 0x6a, 0xe2, 0x40,
+	0x1c, 0xcf, 0x68, 0x0c, 0xec, 0xac, 0xeb, 0x86, 0x11, 0xdc, 0xec, 0xc2, 0x66, 0xdd, 0xdd, 0x83,
+	0x52, 0x68, 0x82, 0xed, 0xa9, 0x47, 0x3f, 0x46, 0x0c, 0x6a, 0x62, 0x63, 0x6c, 0xa1, 0x97, 0x90,
+	0x98, 0xa9, 0x86, 0x36, 0x26, 0xc4, 0x51, 0x9a, 0xb7, 0xe8, 0xd3, 0xf4, 0x19, 0x7a, 0xf4, 0xd8,
+	0x63, 0xd1, 0x4b, 0x1f, 0xa3, 0x74, 0xfc, 0x40, 0xa9, 0xb7, 0x99, 0xdf, 0xd7, 0x9f, 0x99, 0xff,
+	0x0f, 0x96, 0xee, 0x66, 0x5e, 0x18, 0x3b, 0xaa, 0x3f, 0xa1, 0x64, 0x14, 0xfb, 0x34, 0x51, 0xe7,
+	0x15, 0x35, 0x22, 0xb1, 0x1f, 0x7a, 0xf6, 0x6d, 0x18, 0x07, 0x0e, 0x55, 0xa2, 0x38, 0xa4, 0x21,
+	0xca, 0xaf, 0x85, 0xca, 0x4e, 0xa8, 0xcc, 0x2b, 0xff, 0xde, 0x00, 0x14, 0xad, 0x24, 0x22, 0x3d,
+	0x66, 0x68, 0x32, 0x3d, 0x2a, 0x40, 0x81, 0x92, 0x89, 0x33, 0xa1, 0x12, 0x28, 0x82, 0xf2, 0x17,
+	0x73, 0x73, 0x43, 0x08, 0xf2, 0x34, 0x89, 0x88, 0x94, 0x62, 0x28, 0x3b, 0xa3, 0x0b, 0x28, 0xac,
+	0xa7, 0x48, 0xe9, 0x22, 0x28, 0xe7, 0xce, 0xfe, 0x2a, 0x47, 0xc6, 0x28, 0xfb, 0xf1, 0xe6, 0xc6,
+	0x80, 0xfe, 0xc0, 0xaf, 0x1e, 0x19, 0xde, 0x3b, 0x31, 0xf1, 0x6c, 0x37, 0x91, 0x78, 0x96, 0x0a,
+	0xb7, 0x50, 0x2d, 0x41, 0x25, 0xf8, 0x7d, 0x27, 0x18, 0x13, 0x7f, 0x34, 0xa6, 0x52, 0xa6, 0x08,
+	0xca, 0xbc, 0x99, 0xdb, 0xc2, 0x2d, 0x86, 0xa2, 0xff, 0xf0, 0xdb, 0x4e, 0x48, 0xfd, 0x80, 0x48,
+	0x02, 0xcb, 0xca, 0x6e, 0x41, 0xcb, 0x0f, 0xc8, 0xc9, 0x13, 0x80, 0xd9, 0x83, 0x67, 0xfe, 0x86,
+	0x3f, 0x7b, 0xd8, 0xd4, 0x8c, 0x86, 0xdd, 0x34, 0xcc, 0x6e, 0xd5, 0xb2, 0x07, 0x7a, 0xbf, 0x87,
+	0xeb, 0x5a, 0x53, 0xc3, 0x0d, 0x91, 0x43, 0xbf, 0x60, 0xe1, 0x90, 0xd6, 0xfa, 0x86, 0xdd, 0xa8,
+	0x5a, 0x58, 0x04, 0xc7, 0xb9, 0x6b, 0x8c, 0xdb, 0x62, 0x0a, 0xfd, 0x80, 0xf9, 0x43, 0xae, 0x6b,
+	0xe8, 0x56, 0x4b, 0x4c, 0x1f, 0x31, 0xe9, 0x16, 0x36, 0xaf, 0xaa, 0x1d, 0x91, 0xff, 0xcc, 0xf5,
+	0xf1, 0xe5, 0x00, 0xeb, 0x75, 0x2c, 0x66, 0x6a, 0xda, 0xf3, 0x52, 0x06, 0x8b, 0xa5, 0x0c, 0x5e,
+	0x97, 0x32, 0x78, 0x5c, 0xc9, 0xdc, 0x62, 0x25, 0x73, 0x2f, 0x2b, 0x99, 0xbb, 0x51, 0x47, 0x3e,
+	0x1d, 0xcf, 0x5c, 0x65, 0x18, 0x06, 0x6a, 0x9b, 0x7d, 0xfb, 0x69, 0xc7, 0x71, 0xa7, 0xea, 0xa6,
+	0x12, 0x0f, 0x7b, 0xa5, 0xf8, 0x58, 0xd6, 0xd4, 0x15, 0x58, 0x15, 0xce, 0xdf, 0x07, 0x00, 0x34,
+	0x8e, 0x73, 0xee, 0x35, 0x02, 0x00, 0x00,
+}
+
+func (m *TypePeriodFormat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TypePeriodFormat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TypePeriodFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DeclaredTime) > 0 {
+		i -= len(m.DeclaredTime)
+		copy(dAtA[i:], m.DeclaredTime)
+		i = encodeVarintPeriodFormat(dAtA, i, uint64(len(m.DeclaredTime)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.DeclaredHeight != 0 {
+		i = encodeVarintPeriodFormat(dAtA, i, uint64(m.DeclaredHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.DeclaredBy) > 0 {
+		i -= len(m.DeclaredBy)
+		copy(dAtA[i:], m.DeclaredBy)
+		i = encodeVarintPeriodFormat(dAtA, i, uint64(len(m.DeclaredBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Format != 0 {
+		i = encodeVarintPeriodFormat(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPeriodFormat(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintPeriodFormat(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPeriodFormat(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPeriodFormat(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TypePeriodFormat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovPeriodFormat(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPeriodFormat(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovPeriodFormat(uint64(m.Format))
+	}
+	l = len(m.DeclaredBy)
+	if l > 0 {
+		n += 1 + l + sovPeriodFormat(uint64(l))
+	}
+	if m.DeclaredHeight != 0 {
+		n += 1 + sovPeriodFormat(uint64(m.DeclaredHeight))
+	}
+	l = len(m.DeclaredTime)
+	if l > 0 {
+		n += 1 + l + sovPeriodFormat(uint64(l))
+	}
+	return n
+}
+
+func sovPeriodFormat(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPeriodFormat(x uint64) (n int) {
+	return sovPeriodFormat(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TypePeriodFormat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPeriodFormat
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TypePeriodFormat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TypePeriodFormat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriodFormat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriodFormat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriodFormat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= PeriodFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeclaredBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriodFormat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeclaredBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeclaredHeight", wireType)
+			}
+			m.DeclaredHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriodFormat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeclaredHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeclaredTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPeriodFormat
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DeclaredTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPeriodFormat(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPeriodFormat
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPeriodFormat(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPeriodFormat
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeriodFormat
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPeriodFormat
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPeriodFormat
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPeriodFormat
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPeriodFormat
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPeriodFormat        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPeriodFormat          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPeriodFormat = fmt.Errorf("proto: unexpected end of group")
+)
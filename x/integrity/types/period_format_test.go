@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+func TestPeriodFormatNormalizePeriod(t *testing.T) {
+	testCases := []struct {
+		format    types.PeriodFormat
+		period    string
+		canonical string
+	}{
+		{types.PeriodFormat_PERIOD_FORMAT_UNSPECIFIED, " 2026-6-25 ", "2026-6-25"},
+		{types.PeriodFormat_PERIOD_FORMAT_UNSPECIFIED, "2026\x0006-25", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_DATE, "2026-6-25", "2026-06-25"},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_DATE, "2024-02-29", "2024-02-29"},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_DATE, "2026-02-29", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_DATE, "26-06-25", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_DATE, "2026-06", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_WEEK, "2026-w5", "2026-W05"},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_WEEK, "2026-W53", "2026-W53"},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_WEEK, "2025-W53", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_ISO_WEEK, "2026-05", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_MONTH, "2026-6", "2026-06"},
+		{types.PeriodFormat_PERIOD_FORMAT_MONTH, "2026-13", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_INTERVAL, "2026-06-25T02:00:00+02:00/2026-06-26T00:00:00Z", "2026-06-25T00:00:00Z/2026-06-26T00:00:00Z"},
+		{types.PeriodFormat_PERIOD_FORMAT_INTERVAL, "2026-06-26T00:00:00Z/2026-06-25T00:00:00Z", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_INTERVAL, "2026-06-25", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_SEQUENCE, "007", "7"},
+		{types.PeriodFormat_PERIOD_FORMAT_SEQUENCE, "-1", ""},
+		{types.PeriodFormat_PERIOD_FORMAT_SEQUENCE, "18446744073709551616", ""},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.format.String()+"/"+tc.period, func(t *testing.T) {
+			canonical, err := tc.format.NormalizePeriod(tc.period)
+			if tc.canonical == "" {
+				require.ErrorIs(t, err, types.ErrInvalidPeriod)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.canonical, canonical)
+		})
+	}
+
+	require.ErrorIs(t, types.PeriodFormat(99).Validate(), types.ErrInvalidPeriodFormat)
+}
+
+func TestPeriodFormatSortKeysFollowPeriodOrder(t *testing.T) {
+	sequence := types.PeriodFormat_PERIOD_FORMAT_SEQUENCE
+	nine, err := sequence.PeriodSortKey("9")
+	require.NoError(t, err)
+	ten, err := sequence.PeriodSortKey("10")
+	require.NoError(t, err)
+	require.Less(t, nine, ten)
+
+	interval := types.PeriodFormat_PERIOD_FORMAT_INTERVAL
+	whole, err := interval.PeriodSortKey("2026-06-25T00:00:05Z/2026-06-26T00:00:00Z")
+	require.NoError(t, err)
+	fraction, err := interval.PeriodSortKey("2026-06-25T00:00:05.5Z/2026-06-26T00:00:00Z")
+	require.NoError(t, err)
+	require.Less(t, whole, fraction)
+
+	// A timestamp bound covers every interval starting at that instant.
+	start, end, err := interval.PeriodRange("2026-06-25T00:00:05Z", "2026-06-25T00:00:05Z")
+	require.NoError(t, err)
+	require.LessOrEqual(t, start, whole)
+	require.Less(t, whole, end)
+	require.Less(t, end, fraction)
+
+	_, _, err = types.PeriodFormat_PERIOD_FORMAT_ISO_DATE.PeriodRange("2026-06-25", "2026-06-24")
+	require.ErrorIs(t, err, types.ErrInvalidPeriod)
+}
@@ -6,6 +6,8 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -208,6 +210,10 @@ type QueryIntegritySetRequest struct {
 	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	Period string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	// exclude_records returns only the set header; use IntegrityRecords to page through records.
+	ExcludeRecords bool `protobuf:"varint,4,opt,name=exclude_records,json=excludeRecords,proto3" json:"exclude_records,omitempty"`
+	// version selects one version of the set. Zero returns the latest version.
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *QueryIntegritySetRequest) Reset()         { *m = QueryIntegritySetRequest{} }
@@ -264,10 +270,29 @@ func (m *QueryIntegritySetRequest) GetPeriod() string {
 	return ""
 }
 
+func (m *QueryIntegritySetRequest) GetExcludeRecords() bool {
+	if m != nil {
+		return m.ExcludeRecords
+	}
+	return false
+}
+
+func (m *QueryIntegritySetRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // QueryIntegritySetResponse defines the QueryIntegritySetResponse message.
 type QueryIntegritySetResponse struct {
 	Set     IntegritySet      `protobuf:"bytes,1,opt,name=set,proto3" json:"set"`
 	Records []IntegrityRecord `protobuf:"bytes,2,rep,name=records,proto3" json:"records"`
+	// records_included is false when the records were omitted on request.
+	RecordsIncluded bool `protobuf:"varint,3,opt,name=records_included,json=recordsIncluded,proto3" json:"records_included,omitempty"`
+	// revocations lists the revoked records of the returned version in tag order, whether or not the
+	// records are included.
+	Revocations []IntegrityRecordRevocation `protobuf:"bytes,4,rep,name=revocations,proto3" json:"revocations"`
 }
 
 func (m *QueryIntegritySetResponse) Reset()         { *m = QueryIntegritySetResponse{} }
@@ -317,12 +342,28 @@ func (m *QueryIntegritySetResponse) GetRecords() []IntegrityRecord {
 	return nil
 }
 
+func (m *QueryIntegritySetResponse) GetRecordsIncluded() bool {
+	if m != nil {
+		return m.RecordsIncluded
+	}
+	return false
+}
+
+func (m *QueryIntegritySetResponse) GetRevocations() []IntegrityRecordRevocation {
+	if m != nil {
+		return m.Revocations
+	}
+	return nil
+}
+
 // QueryIntegrityRecordRequest defines the QueryIntegrityRecordRequest message.
 type QueryIntegrityRecordRequest struct {
 	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
 	Period string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
 	Tag    string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// version selects one version of the set. Zero returns the latest version.
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 }
 
 func (m *QueryIntegrityRecordRequest) Reset()         { *m = QueryIntegrityRecordRequest{} }
@@ -386,10 +427,20 @@ func (m *QueryIntegrityRecordRequest) GetTag() string {
 	return ""
 }
 
+func (m *QueryIntegrityRecordRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 // QueryIntegrityRecordResponse defines the QueryIntegrityRecordResponse message.
 type QueryIntegrityRecordResponse struct {
-	Set    IntegritySet    `protobuf:"bytes,1,opt,name=set,proto3" json:"set"`
-	Record IntegrityRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	Set     IntegritySet    `protobuf:"bytes,1,opt,name=set,proto3" json:"set"`
+	Record  IntegrityRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	Revoked bool            `protobuf:"varint,3,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	// revocation is set when revoked is true.
+	Revocation IntegrityRecordRevocation `protobuf:"bytes,4,opt,name=revocation,proto3" json:"revocation"`
 }
 
 func (m *QueryIntegrityRecordResponse) Reset()         { *m = QueryIntegrityRecordResponse{} }
@@ -439,701 +490,10347 @@ func (m *QueryIntegrityRecordResponse) GetRecord() IntegrityRecord {
 	return IntegrityRecord{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "kudora.integrity.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "kudora.integrity.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryTenantRequest)(nil), "kudora.integrity.v1.QueryTenantRequest")
-	proto.RegisterType((*QueryTenantResponse)(nil), "kudora.integrity.v1.QueryTenantResponse")
-	proto.RegisterType((*QueryIntegritySetRequest)(nil), "kudora.integrity.v1.QueryIntegritySetRequest")
-	proto.RegisterType((*QueryIntegritySetResponse)(nil), "kudora.integrity.v1.QueryIntegritySetResponse")
-	proto.RegisterType((*QueryIntegrityRecordRequest)(nil), "kudora.integrity.v1.QueryIntegrityRecordRequest")
-	proto.RegisterType((*QueryIntegrityRecordResponse)(nil), "kudora.integrity.v1.QueryIntegrityRecordResponse")
+func (m *QueryIntegrityRecordResponse) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
 }
 
-func init() { proto.RegisterFile("kudora/integrity/v1/query.proto", fileDescriptor_b8da523759ced570) }
-
-var fileDescriptor_b8da523759ced570 = []byte{
-	// 615 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0x3f, 0x6f, 0x13, 0x31,
-	0x18, 0xc6, 0xe3, 0x26, 0x1c, 0xaa, 0x8b, 0x04, 0xb8, 0x15, 0x3a, 0x92, 0x72, 0x0d, 0x47, 0x45,
-	0xa3, 0xaa, 0x9c, 0x49, 0x3a, 0xa0, 0x22, 0xc4, 0x70, 0x62, 0x61, 0x6b, 0xc3, 0x9f, 0x81, 0x01,
-	0xe4, 0x36, 0xd6, 0x71, 0xa2, 0x39, 0x5f, 0xcf, 0x4e, 0x45, 0x14, 0x45, 0x42, 0x6c, 0x6c, 0x48,
-	0xac, 0xb0, 0x77, 0x64, 0xe6, 0x13, 0x74, 0xac, 0xc4, 0xc2, 0x84, 0x50, 0x82, 0xc4, 0xb7, 0x40,
-	0xe8, 0x6c, 0x27, 0xe4, 0xc0, 0xb9, 0xb6, 0x82, 0x25, 0xb1, 0x9d, 0xe7, 0x79, 0x9f, 0xdf, 0xbd,
-	0x79, 0x7d, 0x70, 0xe9, 0x45, 0xa7, 0xc5, 0x12, 0x82, 0xc3, 0x48, 0xd0, 0x20, 0x09, 0x45, 0x17,
-	0xef, 0xd7, 0xf1, 0x5e, 0x87, 0x26, 0x5d, 0x2f, 0x4e, 0x98, 0x60, 0x68, 0x5e, 0x09, 0xbc, 0xb1,
-	0xc0, 0xdb, 0xaf, 0x97, 0x2f, 0x92, 0x76, 0x18, 0x31, 0x2c, 0x3f, 0x95, 0xae, 0xbc, 0x10, 0xb0,
-	0x80, 0xc9, 0x25, 0x4e, 0x57, 0xfa, 0x74, 0x31, 0x60, 0x2c, 0xd8, 0xa5, 0x98, 0xc4, 0x21, 0x26,
-	0x51, 0xc4, 0x04, 0x11, 0x21, 0x8b, 0xb8, 0xfe, 0x75, 0xd5, 0x14, 0x3e, 0xde, 0x3c, 0x4b, 0xe8,
-	0x0e, 0x4b, 0x5a, 0x5a, 0xbb, 0x92, 0xaf, 0xe5, 0x54, 0x68, 0x61, 0xd5, 0x24, 0x8c, 0x49, 0x42,
-	0xda, 0x3c, 0x4f, 0x21, 0x68, 0x44, 0x22, 0x5d, 0xc3, 0x5d, 0x80, 0x68, 0x2b, 0xed, 0xc1, 0xa6,
-	0xb4, 0x35, 0xe9, 0x5e, 0x87, 0x72, 0xe1, 0x3e, 0x82, 0xf3, 0x99, 0x53, 0x1e, 0xb3, 0x88, 0x53,
-	0x74, 0x17, 0x5a, 0xaa, 0xbc, 0x0d, 0xaa, 0xa0, 0x36, 0xd7, 0xa8, 0x78, 0x86, 0x96, 0x79, 0xca,
-	0xe4, 0xcf, 0x1e, 0x7e, 0x5d, 0x2a, 0x1c, 0xfc, 0xf8, 0xb8, 0x0a, 0x9a, 0xda, 0xe5, 0xae, 0xe9,
-	0xb0, 0x87, 0x92, 0x40, 0x87, 0xa1, 0x4b, 0xd0, 0x52, 0x48, 0xb2, 0xea, 0x6c, 0x53, 0xef, 0xdc,
-	0x4d, 0x0d, 0x31, 0x52, 0x6b, 0x88, 0x8d, 0x8c, 0x7c, 0x1a, 0x84, 0x32, 0xf9, 0xa5, 0x14, 0x62,
-	0x5c, 0xf1, 0x29, 0xb4, 0x65, 0xc5, 0xfb, 0x23, 0xe5, 0x03, 0x7a, 0x1c, 0x05, 0x42, 0xb0, 0x24,
-	0xba, 0x31, 0xb5, 0x67, 0xe4, 0xa9, 0x5c, 0xa7, 0xda, 0x98, 0x26, 0x21, 0x6b, 0xd9, 0x45, 0xa5,
-	0x55, 0x3b, 0xf7, 0x3d, 0x80, 0x97, 0x0d, 0x01, 0x63, 0xf0, 0x22, 0xa7, 0x23, 0xea, 0xab, 0x46,
-	0xea, 0x49, 0x9f, 0x66, 0x4f, 0x3d, 0xe8, 0x1e, 0x3c, 0xab, 0x46, 0x84, 0xdb, 0x33, 0xd5, 0x62,
-	0x6d, 0xae, 0xb1, 0x9c, 0x6f, 0x6f, 0x4a, 0xb1, 0xae, 0x30, 0xb2, 0xba, 0x1c, 0x56, 0xb2, 0x74,
-	0x4a, 0xf6, 0x1f, 0x3b, 0x80, 0x2e, 0xc0, 0xa2, 0x20, 0x81, 0x5d, 0x92, 0x87, 0xe9, 0xd2, 0xfd,
-	0x00, 0xe0, 0xa2, 0x39, 0xf5, 0xdf, 0xdb, 0xe2, 0x43, 0x4b, 0x3d, 0x9b, 0x64, 0x3b, 0x5d, 0x57,
-	0xb4, 0xb3, 0xf1, 0xb3, 0x04, 0xcf, 0x48, 0x3e, 0xf4, 0x0a, 0x40, 0x4b, 0xcd, 0x2e, 0x5a, 0x31,
-	0x16, 0xfa, 0xfb, 0xa2, 0x94, 0x6b, 0xc7, 0x0b, 0xd5, 0x63, 0xba, 0xd7, 0x5e, 0x7f, 0xfe, 0xfe,
-	0x6e, 0xe6, 0x0a, 0xaa, 0xe0, 0xe9, 0xb7, 0x16, 0xbd, 0x01, 0xd0, 0x52, 0x93, 0x9b, 0x87, 0x90,
-	0xb9, 0x3e, 0x79, 0x08, 0xd9, 0x9b, 0xe3, 0xae, 0x49, 0x84, 0xeb, 0x68, 0x19, 0x4f, 0x7f, 0x2d,
-	0xe0, 0x9e, 0xfa, 0xee, 0xa3, 0x03, 0x00, 0xcf, 0x4d, 0x36, 0x1e, 0xdd, 0x98, 0x1e, 0x64, 0xb8,
-	0x50, 0x65, 0xef, 0xa4, 0x72, 0x4d, 0xb7, 0x21, 0xe9, 0xd6, 0x51, 0xdd, 0x48, 0xc7, 0xe9, 0x6f,
-	0x34, 0xdc, 0x4b, 0x67, 0xb0, 0x8f, 0x7b, 0x6a, 0xe8, 0xfa, 0xe8, 0x13, 0x80, 0xe7, 0xff, 0xf8,
-	0x97, 0xd1, 0xcd, 0x13, 0xc4, 0x67, 0xe6, 0xbf, 0x5c, 0x3f, 0x85, 0x43, 0x33, 0xfb, 0x92, 0xf9,
-	0x0e, 0xba, 0x6d, 0x64, 0x56, 0x13, 0x36, 0x15, 0x1b, 0xf7, 0x04, 0x09, 0xfa, 0xfe, 0xd6, 0xe1,
-	0xc0, 0x01, 0x47, 0x03, 0x07, 0x7c, 0x1b, 0x38, 0xe0, 0xed, 0xd0, 0x29, 0x1c, 0x0d, 0x9d, 0xc2,
-	0x97, 0xa1, 0x53, 0x78, 0x72, 0x2b, 0x08, 0xc5, 0xf3, 0xce, 0xb6, 0xb7, 0xc3, 0xda, 0xf8, 0x31,
-	0xd9, 0xa5, 0x91, 0x08, 0x23, 0x3f, 0xa1, 0x2d, 0xda, 0xa6, 0x5c, 0x24, 0x74, 0x94, 0xf9, 0x72,
-	0x22, 0x35, 0x4d, 0xe0, 0xdb, 0x96, 0x7c, 0xb7, 0xaf, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xa4,
-	0x9e, 0x35, 0x40, 0xf3, 0x06, 0x00, 0x00,
+func (m *QueryIntegrityRecordResponse) GetRevocation() IntegrityRecordRevocation {
+	if m != nil {
+		return m.Revocation
+	}
+	return IntegrityRecordRevocation{}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Tenant returns one registered tenant.
-	Tenant(ctx context.Context, in *QueryTenantRequest, opts ...grpc.CallOption) (*QueryTenantResponse, error)
-	// IntegritySet returns one committed integrity set with all of its records.
-	IntegritySet(ctx context.Context, in *QueryIntegritySetRequest, opts ...grpc.CallOption) (*QueryIntegritySetResponse, error)
-	// IntegrityRecord returns one encrypted record from a committed integrity set.
-	IntegrityRecord(ctx context.Context, in *QueryIntegrityRecordRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordResponse, error)
+// QueryIntegrityRecordProofRequest defines the QueryIntegrityRecordProofRequest message.
+type QueryIntegrityRecordProofRequest struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Period string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Tag    string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// version selects one version of the set. Zero returns the latest version.
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryIntegrityRecordProofRequest) Reset()         { *m = QueryIntegrityRecordProofRequest{} }
+func (m *QueryIntegrityRecordProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityRecordProofRequest) ProtoMessage()    {}
+func (*QueryIntegrityRecordProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{8}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryIntegrityRecordProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrityRecordProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityRecordProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegrityRecordProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityRecordProofRequest.Merge(m, src)
+}
+func (m *QueryIntegrityRecordProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityRecordProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityRecordProofRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIntegrityRecordProofRequest proto.InternalMessageInfo
+
+func (m *QueryIntegrityRecordProofRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) Tenant(ctx context.Context, in *QueryTenantRequest, opts ...grpc.CallOption) (*QueryTenantResponse, error) {
-	out := new(QueryTenantResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/Tenant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIntegrityRecordProofRequest) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) IntegritySet(ctx context.Context, in *QueryIntegritySetRequest, opts ...grpc.CallOption) (*QueryIntegritySetResponse, error) {
-	out := new(QueryIntegritySetResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegritySet", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIntegrityRecordProofRequest) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) IntegrityRecord(ctx context.Context, in *QueryIntegrityRecordRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordResponse, error) {
-	out := new(QueryIntegrityRecordResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegrityRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryIntegrityRecordProofRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Tenant returns one registered tenant.
-	Tenant(context.Context, *QueryTenantRequest) (*QueryTenantResponse, error)
-	// IntegritySet returns one committed integrity set with all of its records.
-	IntegritySet(context.Context, *QueryIntegritySetRequest) (*QueryIntegritySetResponse, error)
-	// IntegrityRecord returns one encrypted record from a committed integrity set.
-	IntegrityRecord(context.Context, *QueryIntegrityRecordRequest) (*QueryIntegrityRecordResponse, error)
+func (m *QueryIntegrityRecordProofRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryIntegrityRecordProofResponse defines the QueryIntegrityRecordProofResponse message.
+type QueryIntegrityRecordProofResponse struct {
+	Set    IntegritySet         `protobuf:"bytes,1,opt,name=set,proto3" json:"set"`
+	Record IntegrityRecord      `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	Proof  IntegrityRecordProof `protobuf:"bytes,3,opt,name=proof,proto3" json:"proof"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryIntegrityRecordProofResponse) Reset()         { *m = QueryIntegrityRecordProofResponse{} }
+func (m *QueryIntegrityRecordProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityRecordProofResponse) ProtoMessage()    {}
+func (*QueryIntegrityRecordProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{9}
 }
-func (*UnimplementedQueryServer) Tenant(ctx context.Context, req *QueryTenantRequest) (*QueryTenantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Tenant not implemented")
+func (m *QueryIntegrityRecordProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) IntegritySet(ctx context.Context, req *QueryIntegritySetRequest) (*QueryIntegritySetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IntegritySet not implemented")
+func (m *QueryIntegrityRecordProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityRecordProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) IntegrityRecord(ctx context.Context, req *QueryIntegrityRecordRequest) (*QueryIntegrityRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method IntegrityRecord not implemented")
+func (m *QueryIntegrityRecordProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityRecordProofResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryIntegrityRecordProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityRecordProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityRecordProofResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryIntegrityRecordProofResponse proto.InternalMessageInfo
+
+func (m *QueryIntegrityRecordProofResponse) GetSet() IntegritySet {
+	if m != nil {
+		return m.Set
 	}
-	return interceptor(ctx, in, info, handler)
+	return IntegritySet{}
 }
 
-func _Query_Tenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTenantRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Tenant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Query/Tenant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Tenant(ctx, req.(*QueryTenantRequest))
+func (m *QueryIntegrityRecordProofResponse) GetRecord() IntegrityRecord {
+	if m != nil {
+		return m.Record
 	}
-	return interceptor(ctx, in, info, handler)
+	return IntegrityRecord{}
 }
 
-func _Query_IntegritySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIntegritySetRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).IntegritySet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Query/IntegritySet",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IntegritySet(ctx, req.(*QueryIntegritySetRequest))
+func (m *QueryIntegrityRecordProofResponse) GetProof() IntegrityRecordProof {
+	if m != nil {
+		return m.Proof
 	}
-	return interceptor(ctx, in, info, handler)
+	return IntegrityRecordProof{}
 }
 
-func _Query_IntegrityRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryIntegrityRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+// QueryIntegrityRecordAbsenceProofRequest defines the QueryIntegrityRecordAbsenceProofRequest message.
+type QueryIntegrityRecordAbsenceProofRequest struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Period string `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Tag    string `protobuf:"bytes,4,opt,name=tag,proto3" json:"tag,omitempty"`
+	// version selects one version of the set. Zero returns the latest version.
+	Version uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *QueryIntegrityRecordAbsenceProofRequest) Reset() {
+	*m = QueryIntegrityRecordAbsenceProofRequest{}
+}
+func (m *QueryIntegrityRecordAbsenceProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityRecordAbsenceProofRequest) ProtoMessage()    {}
+func (*QueryIntegrityRecordAbsenceProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{10}
+}
+func (m *QueryIntegrityRecordAbsenceProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrityRecordAbsenceProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityRecordAbsenceProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).IntegrityRecord(ctx, in)
+}
+func (m *QueryIntegrityRecordAbsenceProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityRecordAbsenceProofRequest.Merge(m, src)
+}
+func (m *QueryIntegrityRecordAbsenceProofRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityRecordAbsenceProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityRecordAbsenceProofRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegrityRecordAbsenceProofRequest proto.InternalMessageInfo
+
+func (m *QueryIntegrityRecordAbsenceProofRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Query/IntegrityRecord",
+	return ""
+}
+
+func (m *QueryIntegrityRecordAbsenceProofRequest) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).IntegrityRecord(ctx, req.(*QueryIntegrityRecordRequest))
+	return ""
+}
+
+func (m *QueryIntegrityRecordAbsenceProofRequest) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kudora.integrity.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Tenant",
-			Handler:    _Query_Tenant_Handler,
-		},
-		{
-			MethodName: "IntegritySet",
-			Handler:    _Query_IntegritySet_Handler,
-		},
-		{
-			MethodName: "IntegrityRecord",
-			Handler:    _Query_IntegrityRecord_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kudora/integrity/v1/query.proto",
+func (m *QueryIntegrityRecordAbsenceProofRequest) GetTag() string {
+	if m != nil {
+		return m.Tag
+	}
+	return ""
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIntegrityRecordAbsenceProofRequest) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryIntegrityRecordAbsenceProofResponse defines the QueryIntegrityRecordAbsenceProofResponse message.
+type QueryIntegrityRecordAbsenceProofResponse struct {
+	Set   IntegritySet                `protobuf:"bytes,1,opt,name=set,proto3" json:"set"`
+	Proof IntegrityRecordAbsenceProof `protobuf:"bytes,2,opt,name=proof,proto3" json:"proof"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryIntegrityRecordAbsenceProofResponse) Reset() {
+	*m = QueryIntegrityRecordAbsenceProofResponse{}
 }
-
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryIntegrityRecordAbsenceProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityRecordAbsenceProofResponse) ProtoMessage()    {}
+func (*QueryIntegrityRecordAbsenceProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{11}
 }
-
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIntegrityRecordAbsenceProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryIntegrityRecordAbsenceProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityRecordAbsenceProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryTenantRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryIntegrityRecordAbsenceProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityRecordAbsenceProofResponse.Merge(m, src)
 }
-
-func (m *QueryTenantRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIntegrityRecordAbsenceProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityRecordAbsenceProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityRecordAbsenceProofResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTenantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryIntegrityRecordAbsenceProofResponse proto.InternalMessageInfo
+
+func (m *QueryIntegrityRecordAbsenceProofResponse) GetSet() IntegritySet {
+	if m != nil {
+		return m.Set
 	}
-	return len(dAtA) - i, nil
+	return IntegritySet{}
 }
 
-func (m *QueryTenantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIntegrityRecordAbsenceProofResponse) GetProof() IntegrityRecordAbsenceProof {
+	if m != nil {
+		return m.Proof
 	}
-	return dAtA[:n], nil
+	return IntegrityRecordAbsenceProof{}
 }
 
-func (m *QueryTenantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryIntegritySetsByTenantRequest defines the QueryIntegritySetsByTenantRequest message.
+type QueryIntegritySetsByTenantRequest struct {
+	Tenant     string             `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Tenant.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryIntegritySetsByTenantRequest) Reset()         { *m = QueryIntegritySetsByTenantRequest{} }
+func (m *QueryIntegritySetsByTenantRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegritySetsByTenantRequest) ProtoMessage()    {}
+func (*QueryIntegritySetsByTenantRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{12}
+}
+func (m *QueryIntegritySetsByTenantRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegritySetsByTenantRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegritySetsByTenantRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryIntegritySetRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryIntegritySetsByTenantRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegritySetsByTenantRequest.Merge(m, src)
 }
-
-func (m *QueryIntegritySetRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIntegritySetsByTenantRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegritySetsByTenantRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegritySetsByTenantRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIntegritySetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Period) > 0 {
-		i -= len(m.Period)
-		copy(dAtA[i:], m.Period)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Period)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryIntegritySetsByTenantRequest proto.InternalMessageInfo
+
+func (m *QueryIntegritySetsByTenantRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryIntegritySetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIntegritySetsByTenantRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIntegritySetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryIntegritySetsByTenantResponse defines the QueryIntegritySetsByTenantResponse message.
+type QueryIntegritySetsByTenantResponse struct {
+	Sets       []IntegritySet      `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIntegritySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryIntegritySetsByTenantResponse) Reset()         { *m = QueryIntegritySetsByTenantResponse{} }
+func (m *QueryIntegritySetsByTenantResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegritySetsByTenantResponse) ProtoMessage()    {}
+func (*QueryIntegritySetsByTenantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{13}
+}
+func (m *QueryIntegritySetsByTenantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegritySetsByTenantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegritySetsByTenantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryIntegritySetsByTenantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegritySetsByTenantResponse.Merge(m, src)
+}
+func (m *QueryIntegritySetsByTenantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegritySetsByTenantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegritySetsByTenantResponse.DiscardUnknown(m)
 }
 
-func (m *QueryIntegrityRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryIntegritySetsByTenantResponse proto.InternalMessageInfo
+
+func (m *QueryIntegritySetsByTenantResponse) GetSets() []IntegritySet {
+	if m != nil {
+		return m.Sets
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIntegrityRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryIntegritySetsByTenantResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryIntegrityRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tag) > 0 {
-		i -= len(m.Tag)
-		copy(dAtA[i:], m.Tag)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Period) > 0 {
-		i -= len(m.Period)
-		copy(dAtA[i:], m.Period)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Period)))
-		i--
-		dAtA[i] = 0x1a
+// QueryIntegritySetsByTenantAndTypeRequest defines the QueryIntegritySetsByTenantAndTypeRequest message.
+type QueryIntegritySetsByTenantAndTypeRequest struct {
+	Tenant     string             `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type       string             `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeRequest) Reset() {
+	*m = QueryIntegritySetsByTenantAndTypeRequest{}
+}
+func (m *QueryIntegritySetsByTenantAndTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegritySetsByTenantAndTypeRequest) ProtoMessage()    {}
+func (*QueryIntegritySetsByTenantAndTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{14}
+}
+func (m *QueryIntegritySetsByTenantAndTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegritySetsByTenantAndTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegritySetsByTenantAndTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *QueryIntegritySetsByTenantAndTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegritySetsByTenantAndTypeRequest.Merge(m, src)
+}
+func (m *QueryIntegritySetsByTenantAndTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegritySetsByTenantAndTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegritySetsByTenantAndTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegritySetsByTenantAndTypeRequest proto.InternalMessageInfo
+
+func (m *QueryIntegritySetsByTenantAndTypeRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeRequest) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryIntegrityRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryIntegritySetsByTenantAndTypeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryIntegrityRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryIntegritySetsByTenantAndTypeResponse defines the QueryIntegritySetsByTenantAndTypeResponse message.
+type QueryIntegritySetsByTenantAndTypeResponse struct {
+	Sets       []IntegritySet      `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryIntegrityRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryIntegritySetsByTenantAndTypeResponse) Reset() {
+	*m = QueryIntegritySetsByTenantAndTypeResponse{}
+}
+func (m *QueryIntegritySetsByTenantAndTypeResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryIntegritySetsByTenantAndTypeResponse) ProtoMessage() {}
+func (*QueryIntegritySetsByTenantAndTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{15}
+}
+func (m *QueryIntegritySetsByTenantAndTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegritySetsByTenantAndTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegritySetsByTenantAndTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+}
+func (m *QueryIntegritySetsByTenantAndTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegritySetsByTenantAndTypeResponse.Merge(m, src)
+}
+func (m *QueryIntegritySetsByTenantAndTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegritySetsByTenantAndTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegritySetsByTenantAndTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegritySetsByTenantAndTypeResponse proto.InternalMessageInfo
+
+func (m *QueryIntegritySetsByTenantAndTypeResponse) GetSets() []IntegritySet {
+	if m != nil {
+		return m.Sets
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryIntegritySetsByTenantAndTypeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+// QueryIntegrityRecordsRequest defines the QueryIntegrityRecordsRequest message.
+type QueryIntegrityRecordsRequest struct {
+	Tenant     string             `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type       string             `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Period     string             `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegrityRecordsRequest) Reset()         { *m = QueryIntegrityRecordsRequest{} }
+func (m *QueryIntegrityRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityRecordsRequest) ProtoMessage()    {}
+func (*QueryIntegrityRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{16}
+}
+func (m *QueryIntegrityRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrityRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryIntegrityRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityRecordsRequest.Merge(m, src)
+}
+func (m *QueryIntegrityRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryIntegrityRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryIntegrityRecordsRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func (m *QueryTenantRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIntegrityRecordsRequest) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+func (m *QueryIntegrityRecordsRequest) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	return n
+	return ""
 }
 
-func (m *QueryTenantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryIntegrityRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Tenant.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryIntegritySetRequest) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryIntegrityRecordsResponse defines the QueryIntegrityRecordsResponse message.
+type QueryIntegrityRecordsResponse struct {
+	Records    []IntegrityRecord   `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegrityRecordsResponse) Reset()         { *m = QueryIntegrityRecordsResponse{} }
+func (m *QueryIntegrityRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityRecordsResponse) ProtoMessage()    {}
+func (*QueryIntegrityRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{17}
+}
+func (m *QueryIntegrityRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrityRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryIntegrityRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityRecordsResponse.Merge(m, src)
+}
+func (m *QueryIntegrityRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegrityRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryIntegrityRecordsResponse) GetRecords() []IntegrityRecord {
+	if m != nil {
+		return m.Records
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+func (m *QueryIntegrityRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	l = len(m.Period)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryTenantsRequest defines the QueryTenantsRequest message.
+type QueryTenantsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTenantsRequest) Reset()         { *m = QueryTenantsRequest{} }
+func (m *QueryTenantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantsRequest) ProtoMessage()    {}
+func (*QueryTenantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{18}
+}
+func (m *QueryTenantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryTenantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantsRequest.Merge(m, src)
+}
+func (m *QueryTenantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryIntegritySetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryTenantsRequest proto.InternalMessageInfo
+
+func (m *QueryTenantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
+	return nil
+}
+
+// QueryTenantsResponse defines the QueryTenantsResponse message.
+type QueryTenantsResponse struct {
+	Tenants    []Tenant            `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTenantsResponse) Reset()         { *m = QueryTenantsResponse{} }
+func (m *QueryTenantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantsResponse) ProtoMessage()    {}
+func (*QueryTenantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{19}
+}
+func (m *QueryTenantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantsResponse.Merge(m, src)
+}
+func (m *QueryTenantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantsResponse proto.InternalMessageInfo
+
+func (m *QueryTenantsResponse) GetTenants() []Tenant {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
+func (m *QueryTenantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTenantsByOwnerRequest defines the QueryTenantsByOwnerRequest message.
+type QueryTenantsByOwnerRequest struct {
+	Owner      string             `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTenantsByOwnerRequest) Reset()         { *m = QueryTenantsByOwnerRequest{} }
+func (m *QueryTenantsByOwnerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantsByOwnerRequest) ProtoMessage()    {}
+func (*QueryTenantsByOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{20}
+}
+func (m *QueryTenantsByOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantsByOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantsByOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantsByOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantsByOwnerRequest.Merge(m, src)
+}
+func (m *QueryTenantsByOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantsByOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantsByOwnerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantsByOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryTenantsByOwnerRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QueryTenantsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTenantsByOwnerResponse defines the QueryTenantsByOwnerResponse message.
+type QueryTenantsByOwnerResponse struct {
+	Tenants    []Tenant            `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTenantsByOwnerResponse) Reset()         { *m = QueryTenantsByOwnerResponse{} }
+func (m *QueryTenantsByOwnerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantsByOwnerResponse) ProtoMessage()    {}
+func (*QueryTenantsByOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{21}
+}
+func (m *QueryTenantsByOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantsByOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantsByOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantsByOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantsByOwnerResponse.Merge(m, src)
+}
+func (m *QueryTenantsByOwnerResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantsByOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantsByOwnerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantsByOwnerResponse proto.InternalMessageInfo
+
+func (m *QueryTenantsByOwnerResponse) GetTenants() []Tenant {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
+func (m *QueryTenantsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingTenantTransfersToRequest defines the QueryPendingTenantTransfersToRequest message.
+type QueryPendingTenantTransfersToRequest struct {
+	PendingOwner string             `protobuf:"bytes,1,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTenantTransfersToRequest) Reset()         { *m = QueryPendingTenantTransfersToRequest{} }
+func (m *QueryPendingTenantTransfersToRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTenantTransfersToRequest) ProtoMessage()    {}
+func (*QueryPendingTenantTransfersToRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{22}
+}
+func (m *QueryPendingTenantTransfersToRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTenantTransfersToRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTenantTransfersToRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTenantTransfersToRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTenantTransfersToRequest.Merge(m, src)
+}
+func (m *QueryPendingTenantTransfersToRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTenantTransfersToRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTenantTransfersToRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTenantTransfersToRequest proto.InternalMessageInfo
+
+func (m *QueryPendingTenantTransfersToRequest) GetPendingOwner() string {
+	if m != nil {
+		return m.PendingOwner
+	}
+	return ""
+}
+
+func (m *QueryPendingTenantTransfersToRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingTenantTransfersToResponse defines the QueryPendingTenantTransfersToResponse message.
+type QueryPendingTenantTransfersToResponse struct {
+	Tenants    []Tenant            `protobuf:"bytes,1,rep,name=tenants,proto3" json:"tenants"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingTenantTransfersToResponse) Reset()         { *m = QueryPendingTenantTransfersToResponse{} }
+func (m *QueryPendingTenantTransfersToResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingTenantTransfersToResponse) ProtoMessage()    {}
+func (*QueryPendingTenantTransfersToResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{23}
+}
+func (m *QueryPendingTenantTransfersToResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingTenantTransfersToResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingTenantTransfersToResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingTenantTransfersToResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingTenantTransfersToResponse.Merge(m, src)
+}
+func (m *QueryPendingTenantTransfersToResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingTenantTransfersToResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingTenantTransfersToResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingTenantTransfersToResponse proto.InternalMessageInfo
+
+func (m *QueryPendingTenantTransfersToResponse) GetTenants() []Tenant {
+	if m != nil {
+		return m.Tenants
+	}
+	return nil
+}
+
+func (m *QueryPendingTenantTransfersToResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTenantWriterRequest defines the QueryTenantWriterRequest message.
+type QueryTenantWriterRequest struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Writer string `protobuf:"bytes,2,opt,name=writer,proto3" json:"writer,omitempty"`
+}
+
+func (m *QueryTenantWriterRequest) Reset()         { *m = QueryTenantWriterRequest{} }
+func (m *QueryTenantWriterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantWriterRequest) ProtoMessage()    {}
+func (*QueryTenantWriterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{24}
+}
+func (m *QueryTenantWriterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantWriterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantWriterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantWriterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantWriterRequest.Merge(m, src)
+}
+func (m *QueryTenantWriterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantWriterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantWriterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantWriterRequest proto.InternalMessageInfo
+
+func (m *QueryTenantWriterRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryTenantWriterRequest) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+// QueryTenantWriterResponse defines the QueryTenantWriterResponse message.
+type QueryTenantWriterResponse struct {
+	Writer TenantWriter `protobuf:"bytes,1,opt,name=writer,proto3" json:"writer"`
+}
+
+func (m *QueryTenantWriterResponse) Reset()         { *m = QueryTenantWriterResponse{} }
+func (m *QueryTenantWriterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantWriterResponse) ProtoMessage()    {}
+func (*QueryTenantWriterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{25}
+}
+func (m *QueryTenantWriterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantWriterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantWriterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantWriterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantWriterResponse.Merge(m, src)
+}
+func (m *QueryTenantWriterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantWriterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantWriterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantWriterResponse proto.InternalMessageInfo
+
+func (m *QueryTenantWriterResponse) GetWriter() TenantWriter {
+	if m != nil {
+		return m.Writer
+	}
+	return TenantWriter{}
+}
+
+// QueryTenantWritersRequest defines the QueryTenantWritersRequest message.
+type QueryTenantWritersRequest struct {
+	Tenant     string             `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTenantWritersRequest) Reset()         { *m = QueryTenantWritersRequest{} }
+func (m *QueryTenantWritersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantWritersRequest) ProtoMessage()    {}
+func (*QueryTenantWritersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{26}
+}
+func (m *QueryTenantWritersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantWritersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantWritersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantWritersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantWritersRequest.Merge(m, src)
+}
+func (m *QueryTenantWritersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantWritersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantWritersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantWritersRequest proto.InternalMessageInfo
+
+func (m *QueryTenantWritersRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryTenantWritersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTenantWritersResponse defines the QueryTenantWritersResponse message.
+type QueryTenantWritersResponse struct {
+	Writers    []TenantWriter      `protobuf:"bytes,1,rep,name=writers,proto3" json:"writers"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTenantWritersResponse) Reset()         { *m = QueryTenantWritersResponse{} }
+func (m *QueryTenantWritersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantWritersResponse) ProtoMessage()    {}
+func (*QueryTenantWritersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{27}
+}
+func (m *QueryTenantWritersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantWritersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantWritersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantWritersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantWritersResponse.Merge(m, src)
+}
+func (m *QueryTenantWritersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantWritersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantWritersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantWritersResponse proto.InternalMessageInfo
+
+func (m *QueryTenantWritersResponse) GetWriters() []TenantWriter {
+	if m != nil {
+		return m.Writers
+	}
+	return nil
+}
+
+func (m *QueryTenantWritersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryChainHeadRequest defines the QueryChainHeadRequest message.
+type QueryChainHeadRequest struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *QueryChainHeadRequest) Reset()         { *m = QueryChainHeadRequest{} }
+func (m *QueryChainHeadRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChainHeadRequest) ProtoMessage()    {}
+func (*QueryChainHeadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{28}
+}
+func (m *QueryChainHeadRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainHeadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainHeadRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainHeadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainHeadRequest.Merge(m, src)
+}
+func (m *QueryChainHeadRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainHeadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainHeadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainHeadRequest proto.InternalMessageInfo
+
+func (m *QueryChainHeadRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryChainHeadRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// QueryChainHeadResponse defines the QueryChainHeadResponse message.
+type QueryChainHeadResponse struct {
+	Head ChainHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head"`
+}
+
+func (m *QueryChainHeadResponse) Reset()         { *m = QueryChainHeadResponse{} }
+func (m *QueryChainHeadResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChainHeadResponse) ProtoMessage()    {}
+func (*QueryChainHeadResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{29}
+}
+func (m *QueryChainHeadResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChainHeadResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChainHeadResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChainHeadResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChainHeadResponse.Merge(m, src)
+}
+func (m *QueryChainHeadResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChainHeadResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChainHeadResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChainHeadResponse proto.InternalMessageInfo
+
+func (m *QueryChainHeadResponse) GetHead() ChainHead {
+	if m != nil {
+		return m.Head
+	}
+	return ChainHead{}
+}
+
+// QueryVerifyChainRequest defines the QueryVerifyChainRequest message.
+type QueryVerifyChainRequest struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// from_period and from_root resume a walk at the next_period and next_root of an earlier response.
+	// Both empty start at the chain head.
+	FromPeriod string `protobuf:"bytes,3,opt,name=from_period,json=fromPeriod,proto3" json:"from_period,omitempty"`
+	FromRoot   string `protobuf:"bytes,4,opt,name=from_root,json=fromRoot,proto3" json:"from_root,omitempty"`
+	// limit caps the number of links visited. Zero uses the default limit.
+	Limit uint64 `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryVerifyChainRequest) Reset()         { *m = QueryVerifyChainRequest{} }
+func (m *QueryVerifyChainRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyChainRequest) ProtoMessage()    {}
+func (*QueryVerifyChainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{30}
+}
+func (m *QueryVerifyChainRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyChainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyChainRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyChainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyChainRequest.Merge(m, src)
+}
+func (m *QueryVerifyChainRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyChainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyChainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyChainRequest proto.InternalMessageInfo
+
+func (m *QueryVerifyChainRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryVerifyChainRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *QueryVerifyChainRequest) GetFromPeriod() string {
+	if m != nil {
+		return m.FromPeriod
+	}
+	return ""
+}
+
+func (m *QueryVerifyChainRequest) GetFromRoot() string {
+	if m != nil {
+		return m.FromRoot
+	}
+	return ""
+}
+
+func (m *QueryVerifyChainRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+// QueryVerifyChainResponse defines the QueryVerifyChainResponse message.
+type QueryVerifyChainResponse struct {
+	Head ChainHead `protobuf:"bytes,1,opt,name=head,proto3" json:"head"`
+	// links lists the visited sets from the newest to the oldest.
+	Links []ChainLink `protobuf:"bytes,2,rep,name=links,proto3" json:"links"`
+	// complete is true when the walk reached the start of the chain.
+	Complete bool `protobuf:"varint,3,opt,name=complete,proto3" json:"complete,omitempty"`
+	// next_period and next_root name the set to resume from when complete is false.
+	NextPeriod string `protobuf:"bytes,4,opt,name=next_period,json=nextPeriod,proto3" json:"next_period,omitempty"`
+	NextRoot   string `protobuf:"bytes,5,opt,name=next_root,json=nextRoot,proto3" json:"next_root,omitempty"`
+}
+
+func (m *QueryVerifyChainResponse) Reset()         { *m = QueryVerifyChainResponse{} }
+func (m *QueryVerifyChainResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryVerifyChainResponse) ProtoMessage()    {}
+func (*QueryVerifyChainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{31}
+}
+func (m *QueryVerifyChainResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryVerifyChainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryVerifyChainResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryVerifyChainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryVerifyChainResponse.Merge(m, src)
+}
+func (m *QueryVerifyChainResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryVerifyChainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryVerifyChainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryVerifyChainResponse proto.InternalMessageInfo
+
+func (m *QueryVerifyChainResponse) GetHead() ChainHead {
+	if m != nil {
+		return m.Head
+	}
+	return ChainHead{}
+}
+
+func (m *QueryVerifyChainResponse) GetLinks() []ChainLink {
+	if m != nil {
+		return m.Links
+	}
+	return nil
+}
+
+func (m *QueryVerifyChainResponse) GetComplete() bool {
+	if m != nil {
+		return m.Complete
+	}
+	return false
+}
+
+func (m *QueryVerifyChainResponse) GetNextPeriod() string {
+	if m != nil {
+		return m.NextPeriod
+	}
+	return ""
+}
+
+func (m *QueryVerifyChainResponse) GetNextRoot() string {
+	if m != nil {
+		return m.NextRoot
+	}
+	return ""
+}
+
+// QueryPeriodFormatRequest defines the QueryPeriodFormatRequest message.
+type QueryPeriodFormatRequest struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *QueryPeriodFormatRequest) Reset()         { *m = QueryPeriodFormatRequest{} }
+func (m *QueryPeriodFormatRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodFormatRequest) ProtoMessage()    {}
+func (*QueryPeriodFormatRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{32}
+}
+func (m *QueryPeriodFormatRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodFormatRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodFormatRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPeriodFormatRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodFormatRequest.Merge(m, src)
+}
+func (m *QueryPeriodFormatRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodFormatRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodFormatRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPeriodFormatRequest proto.InternalMessageInfo
+
+func (m *QueryPeriodFormatRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryPeriodFormatRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// QueryPeriodFormatResponse defines the QueryPeriodFormatResponse message.
+type QueryPeriodFormatResponse struct {
+	PeriodFormat TypePeriodFormat `protobuf:"bytes,1,opt,name=period_format,json=periodFormat,proto3" json:"period_format"`
+}
+
+func (m *QueryPeriodFormatResponse) Reset()         { *m = QueryPeriodFormatResponse{} }
+func (m *QueryPeriodFormatResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPeriodFormatResponse) ProtoMessage()    {}
+func (*QueryPeriodFormatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{33}
+}
+func (m *QueryPeriodFormatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPeriodFormatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPeriodFormatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPeriodFormatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPeriodFormatResponse.Merge(m, src)
+}
+func (m *QueryPeriodFormatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPeriodFormatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPeriodFormatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPeriodFormatResponse proto.InternalMessageInfo
+
+func (m *QueryPeriodFormatResponse) GetPeriodFormat() TypePeriodFormat {
+	if m != nil {
+		return m.PeriodFormat
+	}
+	return TypePeriodFormat{}
+}
+
+// QueryIntegritySetsByPeriodRangeRequest defines the QueryIntegritySetsByPeriodRangeRequest message.
+type QueryIntegritySetsByPeriodRangeRequest struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	// from and to are inclusive bounds written in the period format of the type. Either may be empty to
+	// leave that side open. Interval types also accept a single RFC 3339 timestamp, which bounds the
+	// interval start.
+	From string `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To   string `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	// pagination only supports key and limit.
+	Pagination *query.PageRequest `protobuf:"bytes,5,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) Reset() {
+	*m = QueryIntegritySetsByPeriodRangeRequest{}
+}
+func (m *QueryIntegritySetsByPeriodRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegritySetsByPeriodRangeRequest) ProtoMessage()    {}
+func (*QueryIntegritySetsByPeriodRangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{34}
+}
+func (m *QueryIntegritySetsByPeriodRangeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegritySetsByPeriodRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegritySetsByPeriodRangeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegritySetsByPeriodRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegritySetsByPeriodRangeRequest.Merge(m, src)
+}
+func (m *QueryIntegritySetsByPeriodRangeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegritySetsByPeriodRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegritySetsByPeriodRangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegritySetsByPeriodRangeRequest proto.InternalMessageInfo
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIntegritySetsByPeriodRangeResponse defines the QueryIntegritySetsByPeriodRangeResponse message.
+type QueryIntegritySetsByPeriodRangeResponse struct {
+	Sets       []IntegritySet      `protobuf:"bytes,1,rep,name=sets,proto3" json:"sets"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegritySetsByPeriodRangeResponse) Reset() {
+	*m = QueryIntegritySetsByPeriodRangeResponse{}
+}
+func (m *QueryIntegritySetsByPeriodRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegritySetsByPeriodRangeResponse) ProtoMessage()    {}
+func (*QueryIntegritySetsByPeriodRangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{35}
+}
+func (m *QueryIntegritySetsByPeriodRangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegritySetsByPeriodRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegritySetsByPeriodRangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegritySetsByPeriodRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegritySetsByPeriodRangeResponse.Merge(m, src)
+}
+func (m *QueryIntegritySetsByPeriodRangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegritySetsByPeriodRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegritySetsByPeriodRangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegritySetsByPeriodRangeResponse proto.InternalMessageInfo
+
+func (m *QueryIntegritySetsByPeriodRangeResponse) GetSets() []IntegritySet {
+	if m != nil {
+		return m.Sets
+	}
+	return nil
+}
+
+func (m *QueryIntegritySetsByPeriodRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIntegrityTypeRequest defines the QueryIntegrityTypeRequest message.
+type QueryIntegrityTypeRequest struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type   string `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+}
+
+func (m *QueryIntegrityTypeRequest) Reset()         { *m = QueryIntegrityTypeRequest{} }
+func (m *QueryIntegrityTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityTypeRequest) ProtoMessage()    {}
+func (*QueryIntegrityTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{36}
+}
+func (m *QueryIntegrityTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrityTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegrityTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityTypeRequest.Merge(m, src)
+}
+func (m *QueryIntegrityTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityTypeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegrityTypeRequest proto.InternalMessageInfo
+
+func (m *QueryIntegrityTypeRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryIntegrityTypeRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+// QueryIntegrityTypeResponse defines the QueryIntegrityTypeResponse message.
+type QueryIntegrityTypeResponse struct {
+	IntegrityType IntegrityType `protobuf:"bytes,1,opt,name=integrity_type,json=integrityType,proto3" json:"integrity_type"`
+}
+
+func (m *QueryIntegrityTypeResponse) Reset()         { *m = QueryIntegrityTypeResponse{} }
+func (m *QueryIntegrityTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityTypeResponse) ProtoMessage()    {}
+func (*QueryIntegrityTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{37}
+}
+func (m *QueryIntegrityTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrityTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegrityTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityTypeResponse.Merge(m, src)
+}
+func (m *QueryIntegrityTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegrityTypeResponse proto.InternalMessageInfo
+
+func (m *QueryIntegrityTypeResponse) GetIntegrityType() IntegrityType {
+	if m != nil {
+		return m.IntegrityType
+	}
+	return IntegrityType{}
+}
+
+// QueryIntegrityTypesRequest defines the QueryIntegrityTypesRequest message.
+type QueryIntegrityTypesRequest struct {
+	Tenant     string             `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegrityTypesRequest) Reset()         { *m = QueryIntegrityTypesRequest{} }
+func (m *QueryIntegrityTypesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityTypesRequest) ProtoMessage()    {}
+func (*QueryIntegrityTypesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{38}
+}
+func (m *QueryIntegrityTypesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrityTypesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityTypesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegrityTypesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityTypesRequest.Merge(m, src)
+}
+func (m *QueryIntegrityTypesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityTypesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityTypesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegrityTypesRequest proto.InternalMessageInfo
+
+func (m *QueryIntegrityTypesRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryIntegrityTypesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryIntegrityTypesResponse defines the QueryIntegrityTypesResponse message.
+type QueryIntegrityTypesResponse struct {
+	IntegrityTypes []IntegrityType     `protobuf:"bytes,1,rep,name=integrity_types,json=integrityTypes,proto3" json:"integrity_types"`
+	Pagination     *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryIntegrityTypesResponse) Reset()         { *m = QueryIntegrityTypesResponse{} }
+func (m *QueryIntegrityTypesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryIntegrityTypesResponse) ProtoMessage()    {}
+func (*QueryIntegrityTypesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{39}
+}
+func (m *QueryIntegrityTypesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryIntegrityTypesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryIntegrityTypesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryIntegrityTypesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryIntegrityTypesResponse.Merge(m, src)
+}
+func (m *QueryIntegrityTypesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryIntegrityTypesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryIntegrityTypesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryIntegrityTypesResponse proto.InternalMessageInfo
+
+func (m *QueryIntegrityTypesResponse) GetIntegrityTypes() []IntegrityType {
+	if m != nil {
+		return m.IntegrityTypes
+	}
+	return nil
+}
+
+func (m *QueryIntegrityTypesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTenantProposalRequest defines the QueryTenantProposalRequest message.
+type QueryTenantProposalRequest struct {
+	Tenant     string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ProposalId uint64 `protobuf:"varint,2,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *QueryTenantProposalRequest) Reset()         { *m = QueryTenantProposalRequest{} }
+func (m *QueryTenantProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantProposalRequest) ProtoMessage()    {}
+func (*QueryTenantProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{40}
+}
+func (m *QueryTenantProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantProposalRequest.Merge(m, src)
+}
+func (m *QueryTenantProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantProposalRequest proto.InternalMessageInfo
+
+func (m *QueryTenantProposalRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryTenantProposalRequest) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// QueryTenantProposalResponse defines the QueryTenantProposalResponse message.
+type QueryTenantProposalResponse struct {
+	Proposal TenantProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryTenantProposalResponse) Reset()         { *m = QueryTenantProposalResponse{} }
+func (m *QueryTenantProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantProposalResponse) ProtoMessage()    {}
+func (*QueryTenantProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{41}
+}
+func (m *QueryTenantProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantProposalResponse.Merge(m, src)
+}
+func (m *QueryTenantProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantProposalResponse proto.InternalMessageInfo
+
+func (m *QueryTenantProposalResponse) GetProposal() TenantProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return TenantProposal{}
+}
+
+// QueryTenantProposalsRequest defines the QueryTenantProposalsRequest message.
+type QueryTenantProposalsRequest struct {
+	Tenant     string             `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTenantProposalsRequest) Reset()         { *m = QueryTenantProposalsRequest{} }
+func (m *QueryTenantProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantProposalsRequest) ProtoMessage()    {}
+func (*QueryTenantProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{42}
+}
+func (m *QueryTenantProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantProposalsRequest.Merge(m, src)
+}
+func (m *QueryTenantProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryTenantProposalsRequest) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *QueryTenantProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTenantProposalsResponse defines the QueryTenantProposalsResponse message.
+type QueryTenantProposalsResponse struct {
+	Proposals  []TenantProposal    `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTenantProposalsResponse) Reset()         { *m = QueryTenantProposalsResponse{} }
+func (m *QueryTenantProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTenantProposalsResponse) ProtoMessage()    {}
+func (*QueryTenantProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b8da523759ced570, []int{43}
+}
+func (m *QueryTenantProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTenantProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTenantProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTenantProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTenantProposalsResponse.Merge(m, src)
+}
+func (m *QueryTenantProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTenantProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTenantProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTenantProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryTenantProposalsResponse) GetProposals() []TenantProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryTenantProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "kudora.integrity.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "kudora.integrity.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryTenantRequest)(nil), "kudora.integrity.v1.QueryTenantRequest")
+	proto.RegisterType((*QueryTenantResponse)(nil), "kudora.integrity.v1.QueryTenantResponse")
+	proto.RegisterType((*QueryIntegritySetRequest)(nil), "kudora.integrity.v1.QueryIntegritySetRequest")
+	proto.RegisterType((*QueryIntegritySetResponse)(nil), "kudora.integrity.v1.QueryIntegritySetResponse")
+	proto.RegisterType((*QueryIntegrityRecordRequest)(nil), "kudora.integrity.v1.QueryIntegrityRecordRequest")
+	proto.RegisterType((*QueryIntegrityRecordResponse)(nil), "kudora.integrity.v1.QueryIntegrityRecordResponse")
+	proto.RegisterType((*QueryIntegrityRecordProofRequest)(nil), "kudora.integrity.v1.QueryIntegrityRecordProofRequest")
+	proto.RegisterType((*QueryIntegrityRecordProofResponse)(nil), "kudora.integrity.v1.QueryIntegrityRecordProofResponse")
+	proto.RegisterType((*QueryIntegrityRecordAbsenceProofRequest)(nil), "kudora.integrity.v1.QueryIntegrityRecordAbsenceProofRequest")
+	proto.RegisterType((*QueryIntegrityRecordAbsenceProofResponse)(nil), "kudora.integrity.v1.QueryIntegrityRecordAbsenceProofResponse")
+	proto.RegisterType((*QueryIntegritySetsByTenantRequest)(nil), "kudora.integrity.v1.QueryIntegritySetsByTenantRequest")
+	proto.RegisterType((*QueryIntegritySetsByTenantResponse)(nil), "kudora.integrity.v1.QueryIntegritySetsByTenantResponse")
+	proto.RegisterType((*QueryIntegritySetsByTenantAndTypeRequest)(nil), "kudora.integrity.v1.QueryIntegritySetsByTenantAndTypeRequest")
+	proto.RegisterType((*QueryIntegritySetsByTenantAndTypeResponse)(nil), "kudora.integrity.v1.QueryIntegritySetsByTenantAndTypeResponse")
+	proto.RegisterType((*QueryIntegrityRecordsRequest)(nil), "kudora.integrity.v1.QueryIntegrityRecordsRequest")
+	proto.RegisterType((*QueryIntegrityRecordsResponse)(nil), "kudora.integrity.v1.QueryIntegrityRecordsResponse")
+	proto.RegisterType((*QueryTenantsRequest)(nil), "kudora.integrity.v1.QueryTenantsRequest")
+	proto.RegisterType((*QueryTenantsResponse)(nil), "kudora.integrity.v1.QueryTenantsResponse")
+	proto.RegisterType((*QueryTenantsByOwnerRequest)(nil), "kudora.integrity.v1.QueryTenantsByOwnerRequest")
+	proto.RegisterType((*QueryTenantsByOwnerResponse)(nil), "kudora.integrity.v1.QueryTenantsByOwnerResponse")
+	proto.RegisterType((*QueryPendingTenantTransfersToRequest)(nil), "kudora.integrity.v1.QueryPendingTenantTransfersToRequest")
+	proto.RegisterType((*QueryPendingTenantTransfersToResponse)(nil), "kudora.integrity.v1.QueryPendingTenantTransfersToResponse")
+	proto.RegisterType((*QueryTenantWriterRequest)(nil), "kudora.integrity.v1.QueryTenantWriterRequest")
+	proto.RegisterType((*QueryTenantWriterResponse)(nil), "kudora.integrity.v1.QueryTenantWriterResponse")
+	proto.RegisterType((*QueryTenantWritersRequest)(nil), "kudora.integrity.v1.QueryTenantWritersRequest")
+	proto.RegisterType((*QueryTenantWritersResponse)(nil), "kudora.integrity.v1.QueryTenantWritersResponse")
+	proto.RegisterType((*QueryChainHeadRequest)(nil), "kudora.integrity.v1.QueryChainHeadRequest")
+	proto.RegisterType((*QueryChainHeadResponse)(nil), "kudora.integrity.v1.QueryChainHeadResponse")
+	proto.RegisterType((*QueryVerifyChainRequest)(nil), "kudora.integrity.v1.QueryVerifyChainRequest")
+	proto.RegisterType((*QueryVerifyChainResponse)(nil), "kudora.integrity.v1.QueryVerifyChainResponse")
+	proto.RegisterType((*QueryPeriodFormatRequest)(nil), "kudora.integrity.v1.QueryPeriodFormatRequest")
+	proto.RegisterType((*QueryPeriodFormatResponse)(nil), "kudora.integrity.v1.QueryPeriodFormatResponse")
+	proto.RegisterType((*QueryIntegritySetsByPeriodRangeRequest)(nil), "kudora.integrity.v1.QueryIntegritySetsByPeriodRangeRequest")
+	proto.RegisterType((*QueryIntegritySetsByPeriodRangeResponse)(nil), "kudora.integrity.v1.QueryIntegritySetsByPeriodRangeResponse")
+	proto.RegisterType((*QueryIntegrityTypeRequest)(nil), "kudora.integrity.v1.QueryIntegrityTypeRequest")
+	proto.RegisterType((*QueryIntegrityTypeResponse)(nil), "kudora.integrity.v1.QueryIntegrityTypeResponse")
+	proto.RegisterType((*QueryIntegrityTypesRequest)(nil), "kudora.integrity.v1.QueryIntegrityTypesRequest")
+	proto.RegisterType((*QueryIntegrityTypesResponse)(nil), "kudora.integrity.v1.QueryIntegrityTypesResponse")
+	proto.RegisterType((*QueryTenantProposalRequest)(nil), "kudora.integrity.v1.QueryTenantProposalRequest")
+	proto.RegisterType((*QueryTenantProposalResponse)(nil), "kudora.integrity.v1.QueryTenantProposalResponse")
+	proto.RegisterType((*QueryTenantProposalsRequest)(nil), "kudora.integrity.v1.QueryTenantProposalsRequest")
+	proto.RegisterType((*QueryTenantProposalsResponse)(nil), "kudora.integrity.v1.QueryTenantProposalsResponse")
+}
+
+func init() { proto.RegisterFile("kudora/integrity/v1/query.proto", fileDescriptor_b8da523759ced570) }
+
+var fileDescriptor_b8da523759ced570 = []byte{
+	// 2157 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5a, 0xdb, 0x6f, 0x1c, 0x57,
+	0x19, 0xcf, 0xb1, 0xd7, 0xb7, 0xcf, 0x8e, 0x1d, 0x4e, 0xdc, 0xb2, 0x19, 0xa7, 0x8e, 0x3b, 0x71,
+	0xe2, 0x4b, 0xec, 0x1d, 0xdb, 0x6d, 0x13, 0x72, 0x71, 0x21, 0x1b, 0x9a, 0xc4, 0x10, 0x29, 0xee,
+	0xc6, 0x2d, 0x12, 0x02, 0x59, 0x63, 0xef, 0xf1, 0x66, 0x14, 0xef, 0xcc, 0x76, 0x66, 0xec, 0xc6,
+	0x32, 0x2b, 0x21, 0xe0, 0x85, 0x87, 0x4a, 0x08, 0x78, 0x00, 0x09, 0x71, 0x51, 0x05, 0x84, 0x8a,
+	0x96, 0x22, 0xfa, 0x50, 0x78, 0xe2, 0xb1, 0x6f, 0x44, 0x20, 0x24, 0x1e, 0x10, 0x42, 0x09, 0x12,
+	0x02, 0xf1, 0x47, 0xa0, 0x39, 0xe7, 0x9b, 0xf1, 0xcc, 0xee, 0xd9, 0xd9, 0xb3, 0x9b, 0x55, 0xe2,
+	0x97, 0x78, 0xe7, 0xe4, 0xbb, 0xfc, 0xbe, 0xdf, 0x77, 0xe6, 0x5c, 0x7e, 0xbb, 0x70, 0xea, 0xde,
+	0x4e, 0xd1, 0x71, 0x4d, 0xc3, 0xb2, 0x7d, 0x56, 0x72, 0x2d, 0x7f, 0xcf, 0xd8, 0x5d, 0x34, 0xde,
+	0xda, 0x61, 0xee, 0x5e, 0xae, 0xe2, 0x3a, 0xbe, 0x43, 0x8f, 0x0b, 0x83, 0x5c, 0x64, 0x90, 0xdb,
+	0x5d, 0xd4, 0x3e, 0x65, 0x96, 0x2d, 0xdb, 0x31, 0xf8, 0xbf, 0xc2, 0x4e, 0x9b, 0xdd, 0x74, 0xbc,
+	0xb2, 0xe3, 0x19, 0x1b, 0xa6, 0xc7, 0x44, 0x00, 0x63, 0x77, 0x71, 0x83, 0xf9, 0xe6, 0xa2, 0x51,
+	0x31, 0x4b, 0x96, 0x6d, 0xfa, 0x96, 0x63, 0xa3, 0xed, 0x09, 0x61, 0xbb, 0xce, 0x9f, 0x0c, 0xf1,
+	0x80, 0xff, 0x35, 0x5a, 0x72, 0x4a, 0x8e, 0x18, 0x0f, 0x3e, 0xe1, 0xe8, 0xc9, 0x92, 0xe3, 0x94,
+	0xb6, 0x99, 0x61, 0x56, 0x2c, 0xc3, 0xb4, 0x6d, 0xc7, 0xe7, 0xd1, 0x42, 0x1f, 0x69, 0x0d, 0x9b,
+	0x77, 0x4d, 0x2b, 0xcc, 0x37, 0x23, 0x33, 0x88, 0x1e, 0x02, 0x18, 0xce, 0x56, 0x58, 0x46, 0xba,
+	0xa9, 0xcb, 0x36, 0x1d, 0xb7, 0x88, 0xb6, 0x53, 0xe9, 0xb6, 0x1e, 0xf3, 0xd1, 0x70, 0x3a, 0xdd,
+	0xd0, 0xdf, 0xab, 0x30, 0xb4, 0x9c, 0x90, 0x59, 0x56, 0x4c, 0xd7, 0x2c, 0x7b, 0x69, 0x49, 0x2b,
+	0xcc, 0xb5, 0x9c, 0xe2, 0xfa, 0x96, 0xe3, 0x96, 0x4d, 0x3f, 0x2d, 0x94, 0xcf, 0x6c, 0xd3, 0xf6,
+	0xd3, 0x68, 0x11, 0x16, 0x01, 0x27, 0x15, 0xc7, 0x33, 0xb7, 0x85, 0xa9, 0x3e, 0x0a, 0xf4, 0xf5,
+	0xa0, 0xa7, 0xab, 0x1c, 0x4a, 0x81, 0xbd, 0xb5, 0xc3, 0x3c, 0x5f, 0x7f, 0x03, 0x8e, 0x27, 0x46,
+	0xbd, 0x8a, 0x63, 0x7b, 0x8c, 0xbe, 0x0a, 0xbd, 0x02, 0x72, 0x96, 0x4c, 0x90, 0xe9, 0xc1, 0xa5,
+	0xb1, 0x9c, 0x64, 0x0e, 0xe5, 0x84, 0x53, 0x7e, 0xe0, 0x93, 0x7f, 0x9c, 0x3a, 0xf2, 0xe0, 0xdf,
+	0x1f, 0xce, 0x92, 0x02, 0x7a, 0xe9, 0x73, 0x98, 0x6c, 0x8d, 0x43, 0xc1, 0x64, 0xf4, 0x79, 0xe8,
+	0x15, 0xd8, 0x78, 0xd4, 0x81, 0x02, 0x3e, 0xe9, 0xab, 0x70, 0x3c, 0x61, 0x8d, 0x20, 0x2e, 0x26,
+	0xcc, 0x1b, 0x81, 0x10, 0x4e, 0xf9, 0x4c, 0x00, 0x22, 0x8a, 0xf8, 0x33, 0x02, 0x59, 0x1e, 0x72,
+	0x25, 0x34, 0xbd, 0xc3, 0x9a, 0xc1, 0xa0, 0x14, 0x32, 0x41, 0x1f, 0xb3, 0x5d, 0x7c, 0x94, 0x7f,
+	0x0e, 0x6c, 0x45, 0x67, 0xb2, 0xdd, 0xc2, 0x56, 0x3c, 0xd1, 0x29, 0x18, 0x61, 0xf7, 0x37, 0xb7,
+	0x77, 0x8a, 0x0c, 0x27, 0x94, 0x97, 0xcd, 0x4c, 0x90, 0xe9, 0xfe, 0xc2, 0x30, 0x0e, 0x17, 0xc4,
+	0x28, 0xcd, 0x42, 0xdf, 0x2e, 0x73, 0x3d, 0xcb, 0xb1, 0xb3, 0x3d, 0x13, 0x64, 0x3a, 0x53, 0x08,
+	0x1f, 0xf5, 0x9f, 0x74, 0xc1, 0x09, 0x09, 0xc6, 0xa8, 0xf8, 0x6e, 0x8f, 0x85, 0x95, 0xbf, 0x28,
+	0xad, 0x3c, 0xee, 0x87, 0xf5, 0x07, 0x3e, 0xf4, 0xf3, 0xd0, 0x17, 0x62, 0xea, 0x9a, 0xe8, 0x9e,
+	0x1e, 0x5c, 0x9a, 0x4c, 0x77, 0x17, 0x50, 0x31, 0x42, 0xe8, 0x4a, 0x67, 0xe0, 0x18, 0x7e, 0x5c,
+	0xb7, 0x6c, 0x5e, 0x92, 0xe0, 0xa0, 0xbf, 0x30, 0x82, 0xe3, 0x2b, 0x38, 0x4c, 0xdf, 0x84, 0x41,
+	0x97, 0xed, 0x3a, 0x9b, 0xe2, 0x95, 0xce, 0x66, 0x78, 0xd2, 0x9c, 0x4a, 0xd2, 0x42, 0xe4, 0x86,
+	0xe9, 0xe3, 0x81, 0xf4, 0x77, 0x08, 0x8c, 0x25, 0x19, 0x0a, 0xbd, 0x3a, 0xd7, 0xc8, 0x63, 0xd0,
+	0xed, 0x9b, 0x25, 0xde, 0xbc, 0x81, 0x42, 0xf0, 0x31, 0xa5, 0x63, 0xef, 0x74, 0xc1, 0x49, 0x39,
+	0x9e, 0x27, 0x6f, 0x5a, 0x1e, 0x7a, 0x05, 0xad, 0x1c, 0x75, 0x6b, 0x3d, 0x43, 0xcf, 0x00, 0x79,
+	0x40, 0xdf, 0xbd, 0xa8, 0x53, 0xe1, 0x23, 0x5d, 0x03, 0x38, 0x20, 0x96, 0x17, 0xdb, 0x6e, 0x83,
+	0x62, 0x71, 0xf4, 0xef, 0x12, 0x98, 0x90, 0xf1, 0xb1, 0x1a, 0xac, 0xc6, 0xcf, 0xaa, 0x49, 0xff,
+	0x21, 0xf0, 0x62, 0x0a, 0xa8, 0xc3, 0xd1, 0xa9, 0xd7, 0xa0, 0x87, 0x6f, 0x59, 0xbc, 0xce, 0xc1,
+	0xa5, 0x19, 0x95, 0x10, 0xbc, 0x00, 0x8c, 0x23, 0xbc, 0xf5, 0x1f, 0x12, 0x98, 0x92, 0xd5, 0x7a,
+	0x75, 0xc3, 0x63, 0xf6, 0x26, 0x7b, 0xa6, 0x7d, 0xf8, 0x1d, 0x81, 0xe9, 0xe6, 0xd8, 0x9e, 0xbc,
+	0x1d, 0xb7, 0x42, 0x2a, 0x45, 0x37, 0x16, 0x54, 0xa8, 0x8c, 0x63, 0x48, 0x32, 0xfa, 0xcd, 0xba,
+	0xd9, 0x73, 0x87, 0xf9, 0x5e, 0x5e, 0x6d, 0x23, 0xa3, 0xd7, 0x01, 0x0e, 0x4e, 0x4a, 0x08, 0xe8,
+	0x6c, 0x0e, 0x4f, 0x47, 0xc1, 0xb1, 0x2a, 0x27, 0xce, 0x65, 0x78, 0xac, 0xca, 0xad, 0x9a, 0x25,
+	0x86, 0x31, 0x0b, 0x31, 0x4f, 0xfd, 0x3d, 0x02, 0x7a, 0x1a, 0x0a, 0x64, 0xed, 0x32, 0x64, 0x3c,
+	0xe6, 0x07, 0x7b, 0x74, 0x77, 0x2b, 0xb4, 0x71, 0x27, 0x7a, 0x43, 0x82, 0x75, 0xaa, 0x29, 0x56,
+	0x91, 0x39, 0x01, 0xf6, 0xc7, 0x75, 0x8d, 0x8e, 0x83, 0xbd, 0x6a, 0x17, 0xd7, 0xf6, 0x2a, 0xac,
+	0x9d, 0x59, 0x98, 0x64, 0xb3, 0xbb, 0x6d, 0x36, 0x7f, 0x4b, 0x60, 0x46, 0x01, 0xe0, 0xa1, 0x22,
+	0xf5, 0x3d, 0x22, 0xdf, 0x6a, 0xbc, 0x4e, 0xbe, 0xce, 0x49, 0x82, 0x33, 0x6d, 0x13, 0xfc, 0x01,
+	0x81, 0x17, 0x1a, 0x80, 0x45, 0x52, 0x63, 0x47, 0x12, 0xd2, 0xfe, 0x91, 0xa4, 0x63, 0xec, 0x7e,
+	0x35, 0x71, 0xe0, 0x8c, 0x38, 0x4d, 0xf2, 0x41, 0xda, 0xe6, 0xe3, 0x47, 0x04, 0x46, 0x93, 0xf1,
+	0xa3, 0xb9, 0xd5, 0x27, 0xda, 0x14, 0xd2, 0xa0, 0x70, 0xa4, 0x0d, 0x3d, 0x3a, 0x57, 0xfd, 0xf7,
+	0x09, 0x68, 0x71, 0x78, 0xf9, 0xbd, 0xdb, 0x6f, 0xdb, 0xcc, 0x0d, 0x59, 0xc8, 0x41, 0x8f, 0x13,
+	0x3c, 0x8b, 0x89, 0x95, 0xcf, 0xfe, 0xf9, 0xa3, 0xf9, 0x51, 0xcc, 0x72, 0xb5, 0x58, 0x74, 0x99,
+	0xe7, 0xdd, 0xf1, 0x5d, 0xcb, 0x2e, 0x15, 0x84, 0x59, 0xc7, 0x16, 0xbd, 0x77, 0xc3, 0xd3, 0x5e,
+	0x2d, 0xac, 0x43, 0x45, 0xde, 0xfb, 0x04, 0x26, 0xc5, 0x8d, 0x89, 0xd9, 0x45, 0xcb, 0x2e, 0x89,
+	0x74, 0x6b, 0xae, 0x69, 0x7b, 0x5b, 0xcc, 0xf5, 0xd6, 0x9c, 0x90, 0xc6, 0x65, 0x38, 0x5a, 0x11,
+	0x26, 0xeb, 0x6a, 0x74, 0x0e, 0xa1, 0xf9, 0xed, 0x8e, 0xb2, 0xfa, 0x3e, 0x81, 0x33, 0x4d, 0xf0,
+	0x1e, 0x2a, 0x7e, 0x8b, 0x78, 0x71, 0x13, 0x69, 0xbe, 0xe4, 0x5a, 0x3e, 0x73, 0x9b, 0xad, 0x79,
+	0x0b, 0xd0, 0xfb, 0x36, 0x37, 0xcc, 0x76, 0x35, 0xe1, 0x18, 0xed, 0xf4, 0xaf, 0xc0, 0x09, 0x49,
+	0x16, 0x24, 0xe2, 0xb3, 0x51, 0xb8, 0xb4, 0xf3, 0x48, 0xdc, 0x35, 0x3c, 0xdd, 0x61, 0xf4, 0x7d,
+	0x49, 0x74, 0xef, 0x69, 0x9d, 0x1d, 0x1e, 0x24, 0xdf, 0xee, 0x28, 0x3b, 0x16, 0x77, 0x15, 0xfa,
+	0x04, 0xca, 0xf4, 0x1d, 0x4e, 0x52, 0x5d, 0xe8, 0xd7, 0xb9, 0x5e, 0x5f, 0x83, 0xe7, 0x38, 0xd2,
+	0x6b, 0x81, 0xd0, 0x73, 0x93, 0x99, 0xed, 0x5c, 0xec, 0xf4, 0x02, 0x3c, 0x5f, 0x1b, 0x04, 0x4b,
+	0xfd, 0x0c, 0x64, 0xee, 0x32, 0xb3, 0x88, 0x5d, 0x1c, 0x97, 0xd6, 0x19, 0x79, 0x85, 0xdb, 0x78,
+	0xe0, 0x11, 0x9c, 0xab, 0x3f, 0xcd, 0x83, 0xbe, 0xc9, 0x5c, 0x6b, 0x4b, 0x84, 0x6e, 0x67, 0xe3,
+	0x3d, 0x05, 0x83, 0x5b, 0xae, 0x53, 0x5e, 0x4f, 0xec, 0xbe, 0x10, 0x0c, 0xad, 0xf2, 0x11, 0x3a,
+	0x06, 0x03, 0xdc, 0xc0, 0x75, 0x1c, 0x1f, 0x8f, 0xd5, 0xfd, 0xc1, 0x40, 0xc1, 0x71, 0x7c, 0x3a,
+	0x0a, 0x3d, 0xdb, 0x56, 0xd9, 0xf2, 0xf1, 0x64, 0x2d, 0x1e, 0xf4, 0xff, 0x86, 0xd2, 0x46, 0x02,
+	0xdb, 0x93, 0x96, 0x4c, 0x2f, 0x05, 0xc9, 0xec, 0x7b, 0xa1, 0x64, 0x90, 0xe2, 0x7a, 0xcb, 0xb2,
+	0xef, 0x85, 0x87, 0x66, 0xee, 0x42, 0x35, 0xe8, 0xdf, 0x74, 0xca, 0x95, 0x6d, 0xe6, 0x33, 0xbc,
+	0x78, 0x46, 0xcf, 0x01, 0x05, 0x36, 0xbb, 0xef, 0x87, 0x14, 0x88, 0x1a, 0x21, 0x18, 0x3a, 0xa0,
+	0x80, 0x1b, 0x70, 0x0a, 0x7a, 0x04, 0x05, 0xc1, 0x40, 0x40, 0x81, 0x7e, 0x1d, 0x6b, 0x15, 0xb6,
+	0xd7, 0xb9, 0x38, 0xd6, 0xce, 0x24, 0x29, 0xc3, 0x09, 0x49, 0x1c, 0x24, 0x6d, 0x35, 0x58, 0xa9,
+	0x63, 0xea, 0x1b, 0xb2, 0x77, 0x46, 0xfe, 0x62, 0xec, 0x55, 0x58, 0x3c, 0x0a, 0x32, 0x31, 0x54,
+	0x89, 0x8d, 0xe9, 0x7f, 0x24, 0x70, 0x56, 0x76, 0xe2, 0x14, 0x8e, 0x05, 0xd3, 0x2e, 0xb5, 0x75,
+	0x20, 0xa6, 0x90, 0x09, 0x26, 0x07, 0xce, 0x23, 0xfe, 0x99, 0x0e, 0x43, 0x97, 0xef, 0x20, 0xad,
+	0x5d, 0xbe, 0x53, 0xb3, 0x8c, 0xf4, 0xb4, 0xbd, 0x8c, 0xfc, 0xa6, 0xee, 0x6a, 0x29, 0x29, 0xe1,
+	0x50, 0x1d, 0x99, 0x6f, 0xd4, 0xca, 0x69, 0x6d, 0xde, 0x3b, 0xf4, 0x32, 0x68, 0xb2, 0x40, 0x58,
+	0xec, 0x6d, 0x18, 0x4e, 0xea, 0xbe, 0x38, 0x5d, 0xf4, 0xf4, 0xb2, 0x83, 0x18, 0x58, 0xf7, 0x51,
+	0x2b, 0x3e, 0xa8, 0x7f, 0x4d, 0x96, 0xee, 0xa9, 0x6d, 0x17, 0xbf, 0xaf, 0xd3, 0xd8, 0x30, 0x3d,
+	0x96, 0xfb, 0x3a, 0x8c, 0x24, 0xcb, 0x0d, 0xdb, 0xac, 0x5e, 0xef, 0x70, 0xa2, 0xde, 0x0e, 0x76,
+	0xfc, 0x8d, 0xc4, 0x4e, 0xb7, 0x8a, 0x7a, 0x77, 0x33, 0xe6, 0x4e, 0xc1, 0x60, 0x28, 0x8d, 0xaf,
+	0x5b, 0x42, 0xc4, 0xc9, 0x14, 0x20, 0x1c, 0x5a, 0x29, 0xea, 0x45, 0x18, 0x93, 0x86, 0x45, 0x46,
+	0x5e, 0x83, 0xfe, 0xd0, 0x18, 0x5b, 0x7f, 0x3a, 0x65, 0x0b, 0x0d, 0xdd, 0x91, 0x8b, 0xc8, 0x55,
+	0xaf, 0x4a, 0xb3, 0x3c, 0xb5, 0xbe, 0x7f, 0x18, 0x5e, 0x30, 0xeb, 0xf2, 0x63, 0x99, 0x37, 0x60,
+	0x20, 0xc4, 0x1a, 0xb6, 0xbc, 0x85, 0x3a, 0x0f, 0x7c, 0x3b, 0xd6, 0xee, 0xa5, 0xbf, 0x8f, 0x43,
+	0x0f, 0x87, 0x4c, 0xbf, 0x4e, 0xa0, 0x57, 0x7c, 0xf9, 0x40, 0xa7, 0xa4, 0x98, 0xea, 0xbf, 0xe9,
+	0xd0, 0xa6, 0x9b, 0x1b, 0x8a, 0x9c, 0xfa, 0xe9, 0x6f, 0xfc, 0xe5, 0x5f, 0xdf, 0xeb, 0x7a, 0x81,
+	0x8e, 0x19, 0x8d, 0xbf, 0xca, 0xa1, 0xdf, 0x26, 0xd0, 0x2b, 0x2a, 0x4f, 0x83, 0x90, 0x90, 0x8d,
+	0xb4, 0xe9, 0xe6, 0x86, 0x08, 0x61, 0x8e, 0x43, 0x38, 0x4b, 0x27, 0x8d, 0xc6, 0x5f, 0xf0, 0x18,
+	0xfb, 0xe2, 0x6f, 0x95, 0x3e, 0x20, 0x30, 0x14, 0x5f, 0x5f, 0xe9, 0x7c, 0xe3, 0x44, 0x92, 0x2f,
+	0x44, 0xb4, 0x9c, 0xaa, 0x39, 0xa2, 0xbb, 0xc8, 0xd1, 0xbd, 0x44, 0x17, 0xa5, 0xe8, 0x3c, 0x76,
+	0x00, 0xcd, 0xd8, 0x0f, 0x96, 0x8c, 0xaa, 0xb1, 0x2f, 0xb6, 0xc7, 0x2a, 0xfd, 0x03, 0x81, 0x91,
+	0x9a, 0x5b, 0x3e, 0x5d, 0x50, 0x48, 0x9f, 0x10, 0xfe, 0xb5, 0xc5, 0x16, 0x3c, 0x10, 0x73, 0x9e,
+	0x63, 0xbe, 0x42, 0x2f, 0x49, 0x31, 0x0b, 0x85, 0xa1, 0x21, 0x6c, 0x63, 0xdf, 0x37, 0x4b, 0x55,
+	0xfa, 0x27, 0x02, 0xa3, 0x32, 0x51, 0x96, 0xbe, 0xa2, 0x8c, 0x27, 0x2e, 0xc9, 0x6a, 0xe7, 0x5b,
+	0x75, 0xc3, 0x5a, 0x6e, 0xf2, 0x5a, 0xf2, 0xf4, 0x73, 0xed, 0xd7, 0x62, 0x70, 0xb9, 0x93, 0xfe,
+	0x8f, 0xc0, 0x58, 0x8a, 0x36, 0x4a, 0xaf, 0x28, 0x23, 0x94, 0x48, 0xce, 0xda, 0x72, 0x9b, 0xde,
+	0x58, 0xe6, 0x2a, 0x2f, 0xf3, 0x0b, 0xf4, 0xe6, 0x13, 0x94, 0x69, 0x8a, 0xc0, 0xf3, 0xa2, 0xdc,
+	0x8f, 0x08, 0x3c, 0x27, 0x15, 0x01, 0xe9, 0x79, 0xb5, 0x57, 0xa0, 0x56, 0x09, 0xd6, 0x2e, 0xb4,
+	0xec, 0x87, 0xc5, 0xcd, 0xf2, 0xe2, 0x26, 0xa9, 0xde, 0xe8, 0x1d, 0xf2, 0x0e, 0xde, 0xef, 0x87,
+	0x04, 0x4e, 0xa6, 0x69, 0x97, 0x74, 0xb9, 0x45, 0x14, 0x49, 0x51, 0x56, 0x7b, 0xb5, 0x5d, 0x77,
+	0xac, 0x65, 0x91, 0xd7, 0x72, 0x8e, 0xce, 0x34, 0xaf, 0x05, 0xdb, 0x44, 0x3f, 0x26, 0x70, 0xac,
+	0x56, 0x2d, 0xa4, 0xea, 0xaf, 0x75, 0xb4, 0xaa, 0x2f, 0xb5, 0xe2, 0x82, 0x70, 0x97, 0x39, 0xdc,
+	0x0b, 0xf4, 0x95, 0x94, 0x79, 0xe5, 0x35, 0x5e, 0xc2, 0xbe, 0x45, 0xa0, 0x0f, 0x25, 0x2a, 0xda,
+	0x74, 0x45, 0x8f, 0x80, 0xce, 0x28, 0x58, 0x22, 0xbe, 0x49, 0x8e, 0x6f, 0x9c, 0x9e, 0x4c, 0x59,
+	0xfc, 0x3d, 0xfa, 0x4b, 0x02, 0xc3, 0x49, 0xa5, 0x8c, 0x1a, 0x4d, 0x73, 0x24, 0xa5, 0x3e, 0x6d,
+	0x41, 0xdd, 0x01, 0xb1, 0x2d, 0x71, 0x6c, 0x73, 0x74, 0x36, 0x0d, 0x9b, 0xc1, 0x05, 0x2f, 0x63,
+	0x9f, 0xff, 0xa9, 0xd2, 0xbf, 0x12, 0xc8, 0x36, 0x52, 0x9f, 0xe8, 0xc5, 0x94, 0x6d, 0x39, 0x5d,
+	0x61, 0xd3, 0x2e, 0xb5, 0xe3, 0xaa, 0xb4, 0x1d, 0x84, 0x75, 0xa0, 0x22, 0x37, 0x8f, 0xf5, 0x24,
+	0xf4, 0xbc, 0x2a, 0xfd, 0x35, 0x81, 0xa1, 0xb8, 0x4e, 0x92, 0xb6, 0xed, 0x4a, 0xe4, 0x2c, 0x2d,
+	0xa7, 0x6a, 0xae, 0x34, 0x6f, 0x6b, 0x0e, 0x05, 0x06, 0xaa, 0x35, 0xc6, 0xbe, 0xf8, 0x50, 0xa5,
+	0xbf, 0x20, 0x70, 0x34, 0x1e, 0xd7, 0xa3, 0x8a, 0x00, 0xa2, 0x39, 0x6c, 0x28, 0xdb, 0x23, 0xe2,
+	0x97, 0x39, 0xe2, 0x1c, 0x9d, 0x6b, 0x05, 0x31, 0xfd, 0x01, 0x81, 0x81, 0x48, 0xa4, 0xa0, 0xb3,
+	0x8d, 0x93, 0xd6, 0xea, 0x46, 0xda, 0x39, 0x25, 0x5b, 0xa5, 0xa9, 0xcc, 0x7f, 0x7c, 0x54, 0xb7,
+	0x6c, 0xfd, 0x9c, 0xc0, 0x60, 0x4c, 0x77, 0xa1, 0x73, 0x8d, 0x13, 0xd6, 0x4b, 0x47, 0xda, 0xbc,
+	0xa2, 0xb5, 0xd2, 0x31, 0x4b, 0x0a, 0xd0, 0xd8, 0xe5, 0x71, 0xe8, 0xaf, 0x08, 0x0c, 0xc5, 0x55,
+	0x8a, 0xb4, 0xa9, 0x29, 0xd1, 0x56, 0xb4, 0x9c, 0xaa, 0x39, 0x42, 0xbd, 0xc4, 0xa1, 0xbe, 0x4c,
+	0x97, 0x8c, 0xc6, 0xbf, 0x6d, 0x9a, 0x17, 0xea, 0x4a, 0x1d, 0xa7, 0x0f, 0x09, 0x68, 0x8d, 0x45,
+	0x06, 0x7a, 0x59, 0x79, 0x73, 0xaa, 0x57, 0x57, 0xb4, 0x2b, 0xed, 0x39, 0x63, 0x55, 0x17, 0x78,
+	0x55, 0x8b, 0xd4, 0x50, 0xde, 0xd7, 0x0c, 0x97, 0x63, 0x7e, 0x97, 0xc0, 0xd1, 0xc4, 0x4d, 0x98,
+	0xaa, 0x1c, 0xb1, 0xe3, 0x5b, 0xb2, 0xa1, 0x6c, 0xaf, 0xb4, 0x07, 0x07, 0xe0, 0xea, 0x88, 0xff,
+	0x29, 0x81, 0xe1, 0x95, 0xe4, 0xd5, 0x5c, 0x35, 0xad, 0xa7, 0xb0, 0x83, 0xc8, 0x05, 0x05, 0xfd,
+	0x1c, 0x07, 0x7a, 0x86, 0x9e, 0x6e, 0x08, 0x34, 0x76, 0xf2, 0xf9, 0x38, 0xda, 0xe4, 0xc2, 0xfb,
+	0x65, 0xf3, 0x4d, 0xae, 0x46, 0x07, 0xd0, 0x16, 0xd4, 0x1d, 0x10, 0xe2, 0x35, 0x0e, 0x71, 0x99,
+	0x5e, 0x36, 0x14, 0x7e, 0x5e, 0x17, 0xa3, 0x35, 0x26, 0x2b, 0x54, 0xe9, 0x07, 0x04, 0x46, 0x92,
+	0xf1, 0x3d, 0xaa, 0x0c, 0xc5, 0x53, 0xb8, 0xe9, 0x34, 0xb8, 0xb8, 0xeb, 0xe7, 0x39, 0xfa, 0x05,
+	0x9a, 0x53, 0x41, 0x7f, 0xc0, 0x75, 0x7e, 0xe5, 0x93, 0x47, 0xe3, 0xe4, 0xe1, 0xa3, 0x71, 0xf2,
+	0xcf, 0x47, 0xe3, 0xe4, 0x3b, 0x8f, 0xc7, 0x8f, 0x3c, 0x7c, 0x3c, 0x7e, 0xe4, 0x6f, 0x8f, 0xc7,
+	0x8f, 0x7c, 0xd9, 0x28, 0x59, 0xfe, 0xdd, 0x9d, 0x8d, 0xdc, 0xa6, 0x53, 0x36, 0xbe, 0xc8, 0x63,
+	0xce, 0xdf, 0x32, 0x37, 0xbc, 0x30, 0xfe, 0xfd, 0x58, 0x06, 0xde, 0xbf, 0x8d, 0x5e, 0xfe, 0x93,
+	0xc3, 0x97, 0xfe, 0x3f, 0x00, 0xf2, 0xdc, 0x56, 0xde, 0x9b, 0x2a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Tenant returns one registered tenant.
+	Tenant(ctx context.Context, in *QueryTenantRequest, opts ...grpc.CallOption) (*QueryTenantResponse, error)
+	// IntegritySet returns one committed integrity set with all of its records,
+	// or only its header when exclude_records is set.
+	IntegritySet(ctx context.Context, in *QueryIntegritySetRequest, opts ...grpc.CallOption) (*QueryIntegritySetResponse, error)
+	// IntegrityRecord returns one encrypted record from a committed integrity set.
+	IntegrityRecord(ctx context.Context, in *QueryIntegrityRecordRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordResponse, error)
+	// IntegrityRecordProof returns one record together with its Merkle inclusion proof.
+	IntegrityRecordProof(ctx context.Context, in *QueryIntegrityRecordProofRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordProofResponse, error)
+	// IntegrityRecordAbsenceProof proves that a tag is not part of one committed integrity set.
+	IntegrityRecordAbsenceProof(ctx context.Context, in *QueryIntegrityRecordAbsenceProofRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordAbsenceProofResponse, error)
+	// IntegritySetsByTenant lists the integrity set headers committed by one tenant.
+	IntegritySetsByTenant(ctx context.Context, in *QueryIntegritySetsByTenantRequest, opts ...grpc.CallOption) (*QueryIntegritySetsByTenantResponse, error)
+	// IntegritySetsByTenantAndType lists the integrity set headers committed by one tenant for one type.
+	IntegritySetsByTenantAndType(ctx context.Context, in *QueryIntegritySetsByTenantAndTypeRequest, opts ...grpc.CallOption) (*QueryIntegritySetsByTenantAndTypeResponse, error)
+	// IntegrityRecords pages through the records of one committed integrity set in tag order.
+	IntegrityRecords(ctx context.Context, in *QueryIntegrityRecordsRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordsResponse, error)
+	// Tenants lists all registered tenants.
+	Tenants(ctx context.Context, in *QueryTenantsRequest, opts ...grpc.CallOption) (*QueryTenantsResponse, error)
+	// TenantsByOwner lists the tenants owned by one address.
+	TenantsByOwner(ctx context.Context, in *QueryTenantsByOwnerRequest, opts ...grpc.CallOption) (*QueryTenantsByOwnerResponse, error)
+	// PendingTenantTransfersTo lists the tenants whose pending owner is one address.
+	PendingTenantTransfersTo(ctx context.Context, in *QueryPendingTenantTransfersToRequest, opts ...grpc.CallOption) (*QueryPendingTenantTransfersToResponse, error)
+	// TenantWriter returns the writer grant of one address on a tenant.
+	TenantWriter(ctx context.Context, in *QueryTenantWriterRequest, opts ...grpc.CallOption) (*QueryTenantWriterResponse, error)
+	// TenantWriters lists the writer grants of a tenant.
+	TenantWriters(ctx context.Context, in *QueryTenantWritersRequest, opts ...grpc.CallOption) (*QueryTenantWritersResponse, error)
+	// ChainHead returns the most recently committed set of one tenant and type.
+	ChainHead(ctx context.Context, in *QueryChainHeadRequest, opts ...grpc.CallOption) (*QueryChainHeadResponse, error)
+	// VerifyChain walks the set chain of one tenant and type from its head towards its start and checks
+	// every link on the way.
+	VerifyChain(ctx context.Context, in *QueryVerifyChainRequest, opts ...grpc.CallOption) (*QueryVerifyChainResponse, error)
+	// PeriodFormat returns the period format a tenant declared for one of its types.
+	PeriodFormat(ctx context.Context, in *QueryPeriodFormatRequest, opts ...grpc.CallOption) (*QueryPeriodFormatResponse, error)
+	// IntegritySetsByPeriodRange lists the integrity set headers of one tenant and type whose periods fall
+	// between two bounds, in period order.
+	IntegritySetsByPeriodRange(ctx context.Context, in *QueryIntegritySetsByPeriodRangeRequest, opts ...grpc.CallOption) (*QueryIntegritySetsByPeriodRangeResponse, error)
+	// IntegrityType returns one registered integrity type of a tenant.
+	IntegrityType(ctx context.Context, in *QueryIntegrityTypeRequest, opts ...grpc.CallOption) (*QueryIntegrityTypeResponse, error)
+	// IntegrityTypes lists the registered integrity types of a tenant.
+	IntegrityTypes(ctx context.Context, in *QueryIntegrityTypesRequest, opts ...grpc.CallOption) (*QueryIntegrityTypesResponse, error)
+	// TenantProposal returns one open proposal of a tenant owned by signers.
+	TenantProposal(ctx context.Context, in *QueryTenantProposalRequest, opts ...grpc.CallOption) (*QueryTenantProposalResponse, error)
+	// TenantProposals lists the open proposals of a tenant owned by signers.
+	TenantProposals(ctx context.Context, in *QueryTenantProposalsRequest, opts ...grpc.CallOption) (*QueryTenantProposalsResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tenant(ctx context.Context, in *QueryTenantRequest, opts ...grpc.CallOption) (*QueryTenantResponse, error) {
+	out := new(QueryTenantResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/Tenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegritySet(ctx context.Context, in *QueryIntegritySetRequest, opts ...grpc.CallOption) (*QueryIntegritySetResponse, error) {
+	out := new(QueryIntegritySetResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegritySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegrityRecord(ctx context.Context, in *QueryIntegrityRecordRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordResponse, error) {
+	out := new(QueryIntegrityRecordResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegrityRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegrityRecordProof(ctx context.Context, in *QueryIntegrityRecordProofRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordProofResponse, error) {
+	out := new(QueryIntegrityRecordProofResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegrityRecordProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegrityRecordAbsenceProof(ctx context.Context, in *QueryIntegrityRecordAbsenceProofRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordAbsenceProofResponse, error) {
+	out := new(QueryIntegrityRecordAbsenceProofResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegrityRecordAbsenceProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegritySetsByTenant(ctx context.Context, in *QueryIntegritySetsByTenantRequest, opts ...grpc.CallOption) (*QueryIntegritySetsByTenantResponse, error) {
+	out := new(QueryIntegritySetsByTenantResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegritySetsByTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegritySetsByTenantAndType(ctx context.Context, in *QueryIntegritySetsByTenantAndTypeRequest, opts ...grpc.CallOption) (*QueryIntegritySetsByTenantAndTypeResponse, error) {
+	out := new(QueryIntegritySetsByTenantAndTypeResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegritySetsByTenantAndType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegrityRecords(ctx context.Context, in *QueryIntegrityRecordsRequest, opts ...grpc.CallOption) (*QueryIntegrityRecordsResponse, error) {
+	out := new(QueryIntegrityRecordsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegrityRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Tenants(ctx context.Context, in *QueryTenantsRequest, opts ...grpc.CallOption) (*QueryTenantsResponse, error) {
+	out := new(QueryTenantsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/Tenants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TenantsByOwner(ctx context.Context, in *QueryTenantsByOwnerRequest, opts ...grpc.CallOption) (*QueryTenantsByOwnerResponse, error) {
+	out := new(QueryTenantsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/TenantsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingTenantTransfersTo(ctx context.Context, in *QueryPendingTenantTransfersToRequest, opts ...grpc.CallOption) (*QueryPendingTenantTransfersToResponse, error) {
+	out := new(QueryPendingTenantTransfersToResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/PendingTenantTransfersTo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TenantWriter(ctx context.Context, in *QueryTenantWriterRequest, opts ...grpc.CallOption) (*QueryTenantWriterResponse, error) {
+	out := new(QueryTenantWriterResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/TenantWriter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TenantWriters(ctx context.Context, in *QueryTenantWritersRequest, opts ...grpc.CallOption) (*QueryTenantWritersResponse, error) {
+	out := new(QueryTenantWritersResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/TenantWriters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ChainHead(ctx context.Context, in *QueryChainHeadRequest, opts ...grpc.CallOption) (*QueryChainHeadResponse, error) {
+	out := new(QueryChainHeadResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/ChainHead", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VerifyChain(ctx context.Context, in *QueryVerifyChainRequest, opts ...grpc.CallOption) (*QueryVerifyChainResponse, error) {
+	out := new(QueryVerifyChainResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/VerifyChain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PeriodFormat(ctx context.Context, in *QueryPeriodFormatRequest, opts ...grpc.CallOption) (*QueryPeriodFormatResponse, error) {
+	out := new(QueryPeriodFormatResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/PeriodFormat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegritySetsByPeriodRange(ctx context.Context, in *QueryIntegritySetsByPeriodRangeRequest, opts ...grpc.CallOption) (*QueryIntegritySetsByPeriodRangeResponse, error) {
+	out := new(QueryIntegritySetsByPeriodRangeResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegritySetsByPeriodRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegrityType(ctx context.Context, in *QueryIntegrityTypeRequest, opts ...grpc.CallOption) (*QueryIntegrityTypeResponse, error) {
+	out := new(QueryIntegrityTypeResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegrityType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) IntegrityTypes(ctx context.Context, in *QueryIntegrityTypesRequest, opts ...grpc.CallOption) (*QueryIntegrityTypesResponse, error) {
+	out := new(QueryIntegrityTypesResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/IntegrityTypes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TenantProposal(ctx context.Context, in *QueryTenantProposalRequest, opts ...grpc.CallOption) (*QueryTenantProposalResponse, error) {
+	out := new(QueryTenantProposalResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/TenantProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TenantProposals(ctx context.Context, in *QueryTenantProposalsRequest, opts ...grpc.CallOption) (*QueryTenantProposalsResponse, error) {
+	out := new(QueryTenantProposalsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Query/TenantProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Tenant returns one registered tenant.
+	Tenant(context.Context, *QueryTenantRequest) (*QueryTenantResponse, error)
+	// IntegritySet returns one committed integrity set with all of its records,
+	// or only its header when exclude_records is set.
+	IntegritySet(context.Context, *QueryIntegritySetRequest) (*QueryIntegritySetResponse, error)
+	// IntegrityRecord returns one encrypted record from a committed integrity set.
+	IntegrityRecord(context.Context, *QueryIntegrityRecordRequest) (*QueryIntegrityRecordResponse, error)
+	// IntegrityRecordProof returns one record together with its Merkle inclusion proof.
+	IntegrityRecordProof(context.Context, *QueryIntegrityRecordProofRequest) (*QueryIntegrityRecordProofResponse, error)
+	// IntegrityRecordAbsenceProof proves that a tag is not part of one committed integrity set.
+	IntegrityRecordAbsenceProof(context.Context, *QueryIntegrityRecordAbsenceProofRequest) (*QueryIntegrityRecordAbsenceProofResponse, error)
+	// IntegritySetsByTenant lists the integrity set headers committed by one tenant.
+	IntegritySetsByTenant(context.Context, *QueryIntegritySetsByTenantRequest) (*QueryIntegritySetsByTenantResponse, error)
+	// IntegritySetsByTenantAndType lists the integrity set headers committed by one tenant for one type.
+	IntegritySetsByTenantAndType(context.Context, *QueryIntegritySetsByTenantAndTypeRequest) (*QueryIntegritySetsByTenantAndTypeResponse, error)
+	// IntegrityRecords pages through the records of one committed integrity set in tag order.
+	IntegrityRecords(context.Context, *QueryIntegrityRecordsRequest) (*QueryIntegrityRecordsResponse, error)
+	// Tenants lists all registered tenants.
+	Tenants(context.Context, *QueryTenantsRequest) (*QueryTenantsResponse, error)
+	// TenantsByOwner lists the tenants owned by one address.
+	TenantsByOwner(context.Context, *QueryTenantsByOwnerRequest) (*QueryTenantsByOwnerResponse, error)
+	// PendingTenantTransfersTo lists the tenants whose pending owner is one address.
+	PendingTenantTransfersTo(context.Context, *QueryPendingTenantTransfersToRequest) (*QueryPendingTenantTransfersToResponse, error)
+	// TenantWriter returns the writer grant of one address on a tenant.
+	TenantWriter(context.Context, *QueryTenantWriterRequest) (*QueryTenantWriterResponse, error)
+	// TenantWriters lists the writer grants of a tenant.
+	TenantWriters(context.Context, *QueryTenantWritersRequest) (*QueryTenantWritersResponse, error)
+	// ChainHead returns the most recently committed set of one tenant and type.
+	ChainHead(context.Context, *QueryChainHeadRequest) (*QueryChainHeadResponse, error)
+	// VerifyChain walks the set chain of one tenant and type from its head towards its start and checks
+	// every link on the way.
+	VerifyChain(context.Context, *QueryVerifyChainRequest) (*QueryVerifyChainResponse, error)
+	// PeriodFormat returns the period format a tenant declared for one of its types.
+	PeriodFormat(context.Context, *QueryPeriodFormatRequest) (*QueryPeriodFormatResponse, error)
+	// IntegritySetsByPeriodRange lists the integrity set headers of one tenant and type whose periods fall
+	// between two bounds, in period order.
+	IntegritySetsByPeriodRange(context.Context, *QueryIntegritySetsByPeriodRangeRequest) (*QueryIntegritySetsByPeriodRangeResponse, error)
+	// IntegrityType returns one registered integrity type of a tenant.
+	IntegrityType(context.Context, *QueryIntegrityTypeRequest) (*QueryIntegrityTypeResponse, error)
+	// IntegrityTypes lists the registered integrity types of a tenant.
+	IntegrityTypes(context.Context, *QueryIntegrityTypesRequest) (*QueryIntegrityTypesResponse, error)
+	// TenantProposal returns one open proposal of a tenant owned by signers.
+	TenantProposal(context.Context, *QueryTenantProposalRequest) (*QueryTenantProposalResponse, error)
+	// TenantProposals lists the open proposals of a tenant owned by signers.
+	TenantProposals(context.Context, *QueryTenantProposalsRequest) (*QueryTenantProposalsResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Tenant(ctx context.Context, req *QueryTenantRequest) (*QueryTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tenant not implemented")
+}
+func (*UnimplementedQueryServer) IntegritySet(ctx context.Context, req *QueryIntegritySetRequest) (*QueryIntegritySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegritySet not implemented")
+}
+func (*UnimplementedQueryServer) IntegrityRecord(ctx context.Context, req *QueryIntegrityRecordRequest) (*QueryIntegrityRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrityRecord not implemented")
+}
+func (*UnimplementedQueryServer) IntegrityRecordProof(ctx context.Context, req *QueryIntegrityRecordProofRequest) (*QueryIntegrityRecordProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrityRecordProof not implemented")
+}
+func (*UnimplementedQueryServer) IntegrityRecordAbsenceProof(ctx context.Context, req *QueryIntegrityRecordAbsenceProofRequest) (*QueryIntegrityRecordAbsenceProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrityRecordAbsenceProof not implemented")
+}
+func (*UnimplementedQueryServer) IntegritySetsByTenant(ctx context.Context, req *QueryIntegritySetsByTenantRequest) (*QueryIntegritySetsByTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegritySetsByTenant not implemented")
+}
+func (*UnimplementedQueryServer) IntegritySetsByTenantAndType(ctx context.Context, req *QueryIntegritySetsByTenantAndTypeRequest) (*QueryIntegritySetsByTenantAndTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegritySetsByTenantAndType not implemented")
+}
+func (*UnimplementedQueryServer) IntegrityRecords(ctx context.Context, req *QueryIntegrityRecordsRequest) (*QueryIntegrityRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrityRecords not implemented")
+}
+func (*UnimplementedQueryServer) Tenants(ctx context.Context, req *QueryTenantsRequest) (*QueryTenantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Tenants not implemented")
+}
+func (*UnimplementedQueryServer) TenantsByOwner(ctx context.Context, req *QueryTenantsByOwnerRequest) (*QueryTenantsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) PendingTenantTransfersTo(ctx context.Context, req *QueryPendingTenantTransfersToRequest) (*QueryPendingTenantTransfersToResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingTenantTransfersTo not implemented")
+}
+func (*UnimplementedQueryServer) TenantWriter(ctx context.Context, req *QueryTenantWriterRequest) (*QueryTenantWriterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantWriter not implemented")
+}
+func (*UnimplementedQueryServer) TenantWriters(ctx context.Context, req *QueryTenantWritersRequest) (*QueryTenantWritersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantWriters not implemented")
+}
+func (*UnimplementedQueryServer) ChainHead(ctx context.Context, req *QueryChainHeadRequest) (*QueryChainHeadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChainHead not implemented")
+}
+func (*UnimplementedQueryServer) VerifyChain(ctx context.Context, req *QueryVerifyChainRequest) (*QueryVerifyChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyChain not implemented")
+}
+func (*UnimplementedQueryServer) PeriodFormat(ctx context.Context, req *QueryPeriodFormatRequest) (*QueryPeriodFormatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PeriodFormat not implemented")
+}
+func (*UnimplementedQueryServer) IntegritySetsByPeriodRange(ctx context.Context, req *QueryIntegritySetsByPeriodRangeRequest) (*QueryIntegritySetsByPeriodRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegritySetsByPeriodRange not implemented")
+}
+func (*UnimplementedQueryServer) IntegrityType(ctx context.Context, req *QueryIntegrityTypeRequest) (*QueryIntegrityTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrityType not implemented")
+}
+func (*UnimplementedQueryServer) IntegrityTypes(ctx context.Context, req *QueryIntegrityTypesRequest) (*QueryIntegrityTypesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IntegrityTypes not implemented")
+}
+func (*UnimplementedQueryServer) TenantProposal(ctx context.Context, req *QueryTenantProposalRequest) (*QueryTenantProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantProposal not implemented")
+}
+func (*UnimplementedQueryServer) TenantProposals(ctx context.Context, req *QueryTenantProposalsRequest) (*QueryTenantProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TenantProposals not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/Tenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tenant(ctx, req.(*QueryTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegritySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegritySetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegritySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegritySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegritySet(ctx, req.(*QueryIntegritySetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegrityRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegrityRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegrityRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegrityRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegrityRecord(ctx, req.(*QueryIntegrityRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegrityRecordProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegrityRecordProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegrityRecordProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegrityRecordProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegrityRecordProof(ctx, req.(*QueryIntegrityRecordProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegrityRecordAbsenceProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegrityRecordAbsenceProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegrityRecordAbsenceProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegrityRecordAbsenceProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegrityRecordAbsenceProof(ctx, req.(*QueryIntegrityRecordAbsenceProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegritySetsByTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegritySetsByTenantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegritySetsByTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegritySetsByTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegritySetsByTenant(ctx, req.(*QueryIntegritySetsByTenantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegritySetsByTenantAndType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegritySetsByTenantAndTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegritySetsByTenantAndType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegritySetsByTenantAndType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegritySetsByTenantAndType(ctx, req.(*QueryIntegritySetsByTenantAndTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegrityRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegrityRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegrityRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegrityRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegrityRecords(ctx, req.(*QueryIntegrityRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Tenants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTenantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Tenants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/Tenants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Tenants(ctx, req.(*QueryTenantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TenantsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTenantsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TenantsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/TenantsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TenantsByOwner(ctx, req.(*QueryTenantsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingTenantTransfersTo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingTenantTransfersToRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingTenantTransfersTo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/PendingTenantTransfersTo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingTenantTransfersTo(ctx, req.(*QueryPendingTenantTransfersToRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TenantWriter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTenantWriterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TenantWriter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/TenantWriter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TenantWriter(ctx, req.(*QueryTenantWriterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TenantWriters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTenantWritersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TenantWriters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/TenantWriters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TenantWriters(ctx, req.(*QueryTenantWritersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ChainHead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChainHeadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChainHead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/ChainHead",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChainHead(ctx, req.(*QueryChainHeadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VerifyChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVerifyChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VerifyChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/VerifyChain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VerifyChain(ctx, req.(*QueryVerifyChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PeriodFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPeriodFormatRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PeriodFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/PeriodFormat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PeriodFormat(ctx, req.(*QueryPeriodFormatRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegritySetsByPeriodRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegritySetsByPeriodRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegritySetsByPeriodRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegritySetsByPeriodRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegritySetsByPeriodRange(ctx, req.(*QueryIntegritySetsByPeriodRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegrityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegrityTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegrityType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegrityType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegrityType(ctx, req.(*QueryIntegrityTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_IntegrityTypes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryIntegrityTypesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).IntegrityTypes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/IntegrityTypes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).IntegrityTypes(ctx, req.(*QueryIntegrityTypesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TenantProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTenantProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TenantProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/TenantProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TenantProposal(ctx, req.(*QueryTenantProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TenantProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTenantProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TenantProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Query/TenantProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TenantProposals(ctx, req.(*QueryTenantProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kudora.integrity.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Tenant",
+			Handler:    _Query_Tenant_Handler,
+		},
+		{
+			MethodName: "IntegritySet",
+			Handler:    _Query_IntegritySet_Handler,
+		},
+		{
+			MethodName: "IntegrityRecord",
+			Handler:    _Query_IntegrityRecord_Handler,
+		},
+		{
+			MethodName: "IntegrityRecordProof",
+			Handler:    _Query_IntegrityRecordProof_Handler,
+		},
+		{
+			MethodName: "IntegrityRecordAbsenceProof",
+			Handler:    _Query_IntegrityRecordAbsenceProof_Handler,
+		},
+		{
+			MethodName: "IntegritySetsByTenant",
+			Handler:    _Query_IntegritySetsByTenant_Handler,
+		},
+		{
+			MethodName: "IntegritySetsByTenantAndType",
+			Handler:    _Query_IntegritySetsByTenantAndType_Handler,
+		},
+		{
+			MethodName: "IntegrityRecords",
+			Handler:    _Query_IntegrityRecords_Handler,
+		},
+		{
+			MethodName: "Tenants",
+			Handler:    _Query_Tenants_Handler,
+		},
+		{
+			MethodName: "TenantsByOwner",
+			Handler:    _Query_TenantsByOwner_Handler,
+		},
+		{
+			MethodName: "PendingTenantTransfersTo",
+			Handler:    _Query_PendingTenantTransfersTo_Handler,
+		},
+		{
+			MethodName: "TenantWriter",
+			Handler:    _Query_TenantWriter_Handler,
+		},
+		{
+			MethodName: "TenantWriters",
+			Handler:    _Query_TenantWriters_Handler,
+		},
+		{
+			MethodName: "ChainHead",
+			Handler:    _Query_ChainHead_Handler,
+		},
+		{
+			MethodName: "VerifyChain",
+			Handler:    _Query_VerifyChain_Handler,
+		},
+		{
+			MethodName: "PeriodFormat",
+			Handler:    _Query_PeriodFormat_Handler,
+		},
+		{
+			MethodName: "IntegritySetsByPeriodRange",
+			Handler:    _Query_IntegritySetsByPeriodRange_Handler,
+		},
+		{
+			MethodName: "IntegrityType",
+			Handler:    _Query_IntegrityType_Handler,
+		},
+		{
+			MethodName: "IntegrityTypes",
+			Handler:    _Query_IntegrityTypes_Handler,
+		},
+		{
+			MethodName: "TenantProposal",
+			Handler:    _Query_TenantProposal_Handler,
+		},
+		{
+			MethodName: "TenantProposals",
+			Handler:    _Query_TenantProposals_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kudora/integrity/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Tenant.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegritySetRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegritySetRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegritySetRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ExcludeRecords {
+		i--
+		if m.ExcludeRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegritySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegritySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegritySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revocations) > 0 {
+		for iNdEx := len(m.Revocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.RecordsIncluded {
+		i--
+		if m.RecordsIncluded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Revocation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityRecordProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityRecordProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityRecordProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityRecordProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityRecordProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityRecordProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityRecordAbsenceProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityRecordAbsenceProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityRecordAbsenceProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Tag) > 0 {
+		i -= len(m.Tag)
+		copy(dAtA[i:], m.Tag)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tag)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityRecordAbsenceProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityRecordAbsenceProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityRecordAbsenceProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Set.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegritySetsByTenantRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegritySetsByTenantRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegritySetsByTenantRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegritySetsByTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegritySetsByTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegritySetsByTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sets) > 0 {
+		for iNdEx := len(m.Sets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sets) > 0 {
+		for iNdEx := len(m.Sets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenants) > 0 {
+		for iNdEx := len(m.Tenants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tenants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenants) > 0 {
+		for iNdEx := len(m.Tenants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tenants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTenantTransfersToRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTenantTransfersToRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTenantTransfersToRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingOwner) > 0 {
+		i -= len(m.PendingOwner)
+		copy(dAtA[i:], m.PendingOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PendingOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingTenantTransfersToResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingTenantTransfersToResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingTenantTransfersToResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenants) > 0 {
+		for iNdEx := len(m.Tenants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tenants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantWriterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantWriterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantWriterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Writer) > 0 {
+		i -= len(m.Writer)
+		copy(dAtA[i:], m.Writer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Writer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantWriterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantWriterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantWriterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Writer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantWritersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantWritersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantWritersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantWritersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantWritersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantWritersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Writers) > 0 {
+		for iNdEx := len(m.Writers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Writers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainHeadRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainHeadRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainHeadRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChainHeadResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChainHeadResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChainHeadResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyChainRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyChainRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyChainRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FromRoot) > 0 {
+		i -= len(m.FromRoot)
+		copy(dAtA[i:], m.FromRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromRoot)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FromPeriod) > 0 {
+		i -= len(m.FromPeriod)
+		copy(dAtA[i:], m.FromPeriod)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FromPeriod)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryVerifyChainResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryVerifyChainResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryVerifyChainResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NextRoot) > 0 {
+		i -= len(m.NextRoot)
+		copy(dAtA[i:], m.NextRoot)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextRoot)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.NextPeriod) > 0 {
+		i -= len(m.NextPeriod)
+		copy(dAtA[i:], m.NextPeriod)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NextPeriod)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Complete {
+		i--
+		if m.Complete {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Links) > 0 {
+		for iNdEx := len(m.Links) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Links[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Head.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodFormatRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodFormatRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodFormatRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPeriodFormatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPeriodFormatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPeriodFormatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PeriodFormat.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.To) > 0 {
+		i -= len(m.To)
+		copy(dAtA[i:], m.To)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.To)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegritySetsByPeriodRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegritySetsByPeriodRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegritySetsByPeriodRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sets) > 0 {
+		for iNdEx := len(m.Sets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.IntegrityType.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityTypesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityTypesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityTypesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryIntegrityTypesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryIntegrityTypesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryIntegrityTypesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IntegrityTypes) > 0 {
+		for iNdEx := len(m.IntegrityTypes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IntegrityTypes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTenantProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTenantProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTenantProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTenantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Tenant.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntegritySetRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ExcludeRecords {
+		n += 2
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryIntegritySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Set.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.RecordsIncluded {
+		n += 2
+	}
+	if len(m.Revocations) > 0 {
+		for _, e := range m.Revocations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryIntegrityRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryIntegrityRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Set.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Revoked {
+		n += 2
+	}
+	l = m.Revocation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntegrityRecordProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryIntegrityRecordProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Set.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntegrityRecordAbsenceProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Tag)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovQuery(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *QueryIntegrityRecordAbsenceProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.Set.Size()
 	n += 1 + l + sovQuery(uint64(l))
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
+	l = m.Proof.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntegritySetsByTenantRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegritySetsByTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sets) > 0 {
+		for _, e := range m.Sets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegritySetsByTenantAndTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sets) > 0 {
+		for _, e := range m.Sets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegrityRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegrityRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tenants) > 0 {
+		for _, e := range m.Tenants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tenants) > 0 {
+		for _, e := range m.Tenants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTenantTransfersToRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PendingOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingTenantTransfersToResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Tenants) > 0 {
+		for _, e := range m.Tenants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantWriterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Writer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantWriterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Writer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTenantWritersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantWritersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Writers) > 0 {
+		for _, e := range m.Writers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainHeadRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChainHeadResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Head.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryVerifyChainRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FromPeriod)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FromRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *QueryVerifyChainResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Head.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Links) > 0 {
+		for _, e := range m.Links {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Complete {
+		n += 2
+	}
+	l = len(m.NextPeriod)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NextRoot)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPeriodFormatRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPeriodFormatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PeriodFormat.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntegritySetsByPeriodRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.To)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegritySetsByPeriodRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sets) > 0 {
+		for _, e := range m.Sets {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegrityTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegrityTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IntegrityType.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryIntegrityTypesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryIntegrityTypesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.IntegrityTypes) > 0 {
+		for _, e := range m.IntegrityTypes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovQuery(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *QueryTenantProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTenantProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTenantProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
 			l = e.Size()
 			n += 1 + l + sovQuery(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *QueryIntegrityRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Period)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Tag)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Tenant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegritySetRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegritySetRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegritySetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludeRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ExcludeRecords = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegritySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegritySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegritySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, IntegrityRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordsIncluded", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordsIncluded = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revocations = append(m.Revocations, IntegrityRecordRevocation{})
+			if err := m.Revocations[len(m.Revocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrityRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrityRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrityRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrityRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrityRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrityRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revocation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Revocation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrityRecordProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrityRecordProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrityRecordProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrityRecordProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrityRecordProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrityRecordProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrityRecordAbsenceProofRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrityRecordAbsenceProofRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrityRecordAbsenceProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrityRecordAbsenceProofResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrityRecordAbsenceProofResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrityRecordAbsenceProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegritySetsByTenantRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegritySetsByTenantRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegritySetsByTenantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegritySetsByTenantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegritySetsByTenantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegritySetsByTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sets = append(m.Sets, IntegritySet{})
+			if err := m.Sets[len(m.Sets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegritySetsByTenantAndTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegritySetsByTenantAndTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegritySetsByTenantAndTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegritySetsByTenantAndTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegritySetsByTenantAndTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegritySetsByTenantAndTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sets = append(m.Sets, IntegritySet{})
+			if err := m.Sets[len(m.Sets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrityRecordsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrityRecordsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrityRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryIntegrityRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryIntegrityRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryIntegrityRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, IntegrityRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenants = append(m.Tenants, Tenant{})
+			if err := m.Tenants[len(m.Tenants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenants = append(m.Tenants, Tenant{})
+			if err := m.Tenants[len(m.Tenants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingTenantTransfersToRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingTenantTransfersToRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingTenantTransfersToRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingTenantTransfersToResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingTenantTransfersToResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingTenantTransfersToResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenants = append(m.Tenants, Tenant{})
+			if err := m.Tenants[len(m.Tenants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantWriterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantWriterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantWriterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantWriterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantWriterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantWriterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Writer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantWritersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantWritersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantWritersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantWritersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantWritersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantWritersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writers = append(m.Writers, TenantWriter{})
+			if err := m.Writers[len(m.Writers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainHeadRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainHeadRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainHeadRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryChainHeadResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryChainHeadResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryChainHeadResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyChainRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyChainRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyChainRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryVerifyChainResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryVerifyChainResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryVerifyChainResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Head", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Head.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Links", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Links = append(m.Links, ChainLink{})
+			if err := m.Links[len(m.Links)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complete", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Complete = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextPeriod = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NextRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodFormatRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodFormatRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodFormatRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPeriodFormatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPeriodFormatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPeriodFormatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodFormat", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodFormat.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryIntegrityRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Set.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	l = m.Record.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegritySetsByPeriodRangeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1156,12 +10853,176 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegritySetsByPeriodRangeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegritySetsByPeriodRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1183,7 +11044,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegritySetsByPeriodRangeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1206,15 +11067,49 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegritySetsByPeriodRangeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegritySetsByPeriodRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sets = append(m.Sets, IntegritySet{})
+			if err := m.Sets[len(m.Sets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1241,7 +11136,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1266,7 +11164,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTenantRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegrityTypeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1289,10 +11187,10 @@ func (m *QueryTenantRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTenantRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegrityTypeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTenantRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegrityTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1327,6 +11225,38 @@ func (m *QueryTenantRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1348,7 +11278,7 @@ func (m *QueryTenantRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTenantResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegrityTypeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1371,15 +11301,15 @@ func (m *QueryTenantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTenantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegrityTypeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegrityTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegrityType", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1406,7 +11336,7 @@ func (m *QueryTenantResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Tenant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.IntegrityType.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1431,7 +11361,7 @@ func (m *QueryTenantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIntegritySetRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegrityTypesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1454,10 +11384,10 @@ func (m *QueryIntegritySetRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIntegritySetRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegrityTypesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIntegritySetRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegrityTypesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1494,9 +11424,9 @@ func (m *QueryIntegritySetRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1506,55 +11436,27 @@ func (m *QueryIntegritySetRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Period = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1577,7 +11479,7 @@ func (m *QueryIntegritySetRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIntegritySetResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryIntegrityTypesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1600,15 +11502,15 @@ func (m *QueryIntegritySetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIntegritySetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryIntegrityTypesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIntegritySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryIntegrityTypesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IntegrityTypes", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1635,13 +11537,14 @@ func (m *QueryIntegritySetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.IntegrityTypes = append(m.IntegrityTypes, IntegrityType{})
+			if err := m.IntegrityTypes[len(m.IntegrityTypes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1668,8 +11571,10 @@ func (m *QueryIntegritySetResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, IntegrityRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1694,7 +11599,7 @@ func (m *QueryIntegritySetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIntegrityRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTenantProposalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1717,10 +11622,10 @@ func (m *QueryIntegrityRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIntegrityRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTenantProposalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIntegrityRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTenantProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1756,10 +11661,79 @@ func (m *QueryIntegrityRecordRequest) Unmarshal(dAtA []byte) error {
 			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantProposalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantProposalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1769,27 +11743,78 @@ func (m *QueryIntegrityRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Type = string(dAtA[iNdEx:postIndex])
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTenantProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTenantProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTenantProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1817,13 +11842,13 @@ func (m *QueryIntegrityRecordRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Period = string(dAtA[iNdEx:postIndex])
+			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1833,23 +11858,27 @@ func (m *QueryIntegrityRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1872,7 +11901,7 @@ func (m *QueryIntegrityRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryIntegrityRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTenantProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1895,15 +11924,15 @@ func (m *QueryIntegrityRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryIntegrityRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTenantProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryIntegrityRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTenantProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Set", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1930,13 +11959,14 @@ func (m *QueryIntegrityRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Set.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Proposals = append(m.Proposals, TenantProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1963,7 +11993,10 @@ func (m *QueryIntegrityRecordResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,30 @@
+package types
+
+import "strings"
+
+// RecordsOnChain reports whether the records of the set can be read and proven from chain state.
+func (s IntegritySet) RecordsOnChain() bool {
+	return s.StorageMode == StorageMode_STORAGE_MODE_ON_CHAIN
+}
+
+// NormalizeStorageURI trims an optional off-chain storage URI. An empty value means no URI.
+func NormalizeStorageURI(uri string) (string, error) {
+	uri = strings.TrimSpace(uri)
+	switch {
+	case len(uri) > MaxStorageURILength:
+		return "", ErrInvalidStorageReference.Wrapf("storage uri exceeds maximum length %d", MaxStorageURILength)
+	case strings.ContainsFunc(uri, func(r rune) bool { return r < 0x20 || r == 0x7f }):
+		return "", ErrInvalidStorageReference.Wrap("storage uri must not contain control characters")
+	default:
+		return uri, nil
+	}
+}
+
+// NormalizeStorageDigest normalizes an optional 32-byte digest of the off-chain record bundle. An empty
+// value means no digest.
+func NormalizeStorageDigest(digest string) (string, error) {
+	if strings.TrimSpace(digest) == "" {
+		return "", nil
+	}
+	return normalizeFixedLengthHex(digest, 32, ErrInvalidStorageReference, "storage digest")
+}
@@ -22,13 +22,62 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TenantStatus is the lifecycle state of a tenant.
+type TenantStatus int32
+
+const (
+	// TENANT_STATUS_ACTIVE tenants accept new sets. It is the zero value so that tenants registered before
+	// statuses existed stay active.
+	TenantStatus_TENANT_STATUS_ACTIVE TenantStatus = 0
+	// TENANT_STATUS_FROZEN tenants reject every set write until they are unfrozen.
+	TenantStatus_TENANT_STATUS_FROZEN TenantStatus = 1
+	// TENANT_STATUS_ARCHIVED tenants are retired for good. Their sets stay readable, but the tenant can no
+	// longer be written, updated or transferred, and its name is never released.
+	TenantStatus_TENANT_STATUS_ARCHIVED TenantStatus = 2
+)
+
+var TenantStatus_name = map[int32]string{
+	0: "TENANT_STATUS_ACTIVE",
+	1: "TENANT_STATUS_FROZEN",
+	2: "TENANT_STATUS_ARCHIVED",
+}
+
+var TenantStatus_value = map[string]int32{
+	"TENANT_STATUS_ACTIVE":   0,
+	"TENANT_STATUS_FROZEN":   1,
+	"TENANT_STATUS_ARCHIVED": 2,
+}
+
+func (x TenantStatus) String() string {
+	return proto.EnumName(TenantStatus_name, int32(x))
+}
+
+func (TenantStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_6db791150dff6778, []int{0}
+}
+
 // Tenant defines the Tenant message.
 type Tenant struct {
-	Tenant        string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
-	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	CreatedHeight uint64 `protobuf:"varint,3,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
-	CreatedTime   string `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
-	PendingOwner  string `protobuf:"bytes,5,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	Tenant        string       `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Owner         string       `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	CreatedHeight uint64       `protobuf:"varint,3,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	CreatedTime   string       `protobuf:"bytes,4,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+	PendingOwner  string       `protobuf:"bytes,5,opt,name=pending_owner,json=pendingOwner,proto3" json:"pending_owner,omitempty"`
+	Status        TenantStatus `protobuf:"varint,6,opt,name=status,proto3,enum=kudora.integrity.v1.TenantStatus" json:"status,omitempty"`
+	// status_changed_by is the owner or the module authority that last changed status.
+	StatusChangedBy     string `protobuf:"bytes,7,opt,name=status_changed_by,json=statusChangedBy,proto3" json:"status_changed_by,omitempty"`
+	StatusChangedHeight uint64 `protobuf:"varint,8,opt,name=status_changed_height,json=statusChangedHeight,proto3" json:"status_changed_height,omitempty"`
+	StatusChangedTime   string `protobuf:"bytes,9,opt,name=status_changed_time,json=statusChangedTime,proto3" json:"status_changed_time,omitempty"`
+	// pending_owner_expires_at is the RFC3339 block time at which the pending ownership offer lapses.
+	// Empty means no time limit.
+	PendingOwnerExpiresAt string `protobuf:"bytes,10,opt,name=pending_owner_expires_at,json=pendingOwnerExpiresAt,proto3" json:"pending_owner_expires_at,omitempty"`
+	// pending_owner_expires_height is the block height at which the pending ownership offer lapses.
+	// Zero means no height limit.
+	PendingOwnerExpiresHeight uint64 `protobuf:"varint,11,opt,name=pending_owner_expires_height,json=pendingOwnerExpiresHeight,proto3" json:"pending_owner_expires_height,omitempty"`
+	// signers, when set, own the tenant together. owner is then the tenant policy address, which has no key,
+	// and owner actions go through tenant proposals approved by threshold signers.
+	Signers   []string `protobuf:"bytes,12,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold uint32   `protobuf:"varint,13,opt,name=threshold,proto3" json:"threshold,omitempty"`
 }
 
 func (m *Tenant) Reset()         { *m = Tenant{} }
@@ -99,30 +148,203 @@ func (m *Tenant) GetPendingOwner() string {
 	return ""
 }
 
+func (m *Tenant) GetStatus() TenantStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TenantStatus_TENANT_STATUS_ACTIVE
+}
+
+func (m *Tenant) GetStatusChangedBy() string {
+	if m != nil {
+		return m.StatusChangedBy
+	}
+	return ""
+}
+
+func (m *Tenant) GetStatusChangedHeight() uint64 {
+	if m != nil {
+		return m.StatusChangedHeight
+	}
+	return 0
+}
+
+func (m *Tenant) GetStatusChangedTime() string {
+	if m != nil {
+		return m.StatusChangedTime
+	}
+	return ""
+}
+
+func (m *Tenant) GetPendingOwnerExpiresAt() string {
+	if m != nil {
+		return m.PendingOwnerExpiresAt
+	}
+	return ""
+}
+
+func (m *Tenant) GetPendingOwnerExpiresHeight() uint64 {
+	if m != nil {
+		return m.PendingOwnerExpiresHeight
+	}
+	return 0
+}
+
+func (m *Tenant) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *Tenant) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// TenantWriter is a grant that lets an address commit integrity sets on behalf of a tenant owner.
+type TenantWriter struct {
+	Tenant string `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Writer string `protobuf:"bytes,2,opt,name=writer,proto3" json:"writer,omitempty"`
+	// type_prefixes restricts the grant to integrity types starting with one of the prefixes.
+	// An empty list allows every type.
+	TypePrefixes []string `protobuf:"bytes,3,rep,name=type_prefixes,json=typePrefixes,proto3" json:"type_prefixes,omitempty"`
+	// expires_at is the RFC3339 block time at which the grant stops being valid. Empty means no expiry.
+	ExpiresAt     string `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	GrantedBy     string `protobuf:"bytes,5,opt,name=granted_by,json=grantedBy,proto3" json:"granted_by,omitempty"`
+	GrantedHeight uint64 `protobuf:"varint,6,opt,name=granted_height,json=grantedHeight,proto3" json:"granted_height,omitempty"`
+	GrantedTime   string `protobuf:"bytes,7,opt,name=granted_time,json=grantedTime,proto3" json:"granted_time,omitempty"`
+}
+
+func (m *TenantWriter) Reset()         { *m = TenantWriter{} }
+func (m *TenantWriter) String() string { return proto.CompactTextString(m) }
+func (*TenantWriter) ProtoMessage()    {}
+func (*TenantWriter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6db791150dff6778, []int{1}
+}
+func (m *TenantWriter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TenantWriter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TenantWriter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TenantWriter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenantWriter.Merge(m, src)
+}
+func (m *TenantWriter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TenantWriter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenantWriter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TenantWriter proto.InternalMessageInfo
+
+func (m *TenantWriter) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *TenantWriter) GetWriter() string {
+	if m != nil {
+		return m.Writer
+	}
+	return ""
+}
+
+func (m *TenantWriter) GetTypePrefixes() []string {
+	if m != nil {
+		return m.TypePrefixes
+	}
+	return nil
+}
+
+func (m *TenantWriter) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+func (m *TenantWriter) GetGrantedBy() string {
+	if m != nil {
+		return m.GrantedBy
+	}
+	return ""
+}
+
+func (m *TenantWriter) GetGrantedHeight() uint64 {
+	if m != nil {
+		return m.GrantedHeight
+	}
+	return 0
+}
+
+func (m *TenantWriter) GetGrantedTime() string {
+	if m != nil {
+		return m.GrantedTime
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("kudora.integrity.v1.TenantStatus", TenantStatus_name, TenantStatus_value)
 	proto.RegisterType((*Tenant)(nil), "kudora.integrity.v1.Tenant")
+	proto.RegisterType((*TenantWriter)(nil), "kudora.integrity.v1.TenantWriter")
 }
 
 func init() { proto.RegisterFile("kudora/integrity/v1/tenant.proto", fileDescriptor_6db791150dff6778) }
 
 var fileDescriptor_6db791150dff6778 = []byte{
-	// 255 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x90, 0x31, 0x4b, 0xc3, 0x40,
-	0x14, 0xc7, 0x73, 0xda, 0x06, 0x3c, 0x5b, 0x87, 0x53, 0x24, 0xd3, 0x11, 0x15, 0xa1, 0x53, 0x8e,
-	0xe2, 0xe0, 0xde, 0xc9, 0x4d, 0x2c, 0xc5, 0xc1, 0xa5, 0xa4, 0xcd, 0x23, 0x39, 0x34, 0xef, 0xc2,
-	0xf5, 0xb5, 0xda, 0x6f, 0xe1, 0x97, 0xf0, 0xbb, 0x38, 0x76, 0x74, 0x94, 0xe4, 0x8b, 0x88, 0x77,
-	0xa7, 0x74, 0xfc, 0xff, 0xf8, 0xf1, 0x1e, 0xfc, 0x78, 0xfa, 0xbc, 0x2e, 0x8c, 0xcd, 0x95, 0x46,
-	0x82, 0xd2, 0x6a, 0xda, 0xaa, 0xcd, 0x58, 0x11, 0x60, 0x8e, 0x94, 0x35, 0xd6, 0x90, 0x11, 0xa7,
-	0xde, 0xc8, 0xfe, 0x8d, 0x6c, 0x33, 0xbe, 0xfc, 0x60, 0x3c, 0x9e, 0x39, 0x4b, 0x9c, 0xf3, 0xd8,
-	0xfb, 0x09, 0x4b, 0xd9, 0xe8, 0x68, 0x1a, 0x96, 0x38, 0xe3, 0x7d, 0xf3, 0x8a, 0x60, 0x93, 0x03,
-	0x87, 0xfd, 0x10, 0xd7, 0xfc, 0x64, 0x69, 0x21, 0x27, 0x28, 0xe6, 0x15, 0xe8, 0xb2, 0xa2, 0xe4,
-	0x30, 0x65, 0xa3, 0xde, 0x74, 0x18, 0xe8, 0x9d, 0x83, 0xe2, 0x82, 0x0f, 0xfe, 0x34, 0xd2, 0x35,
-	0x24, 0x3d, 0x77, 0xe3, 0x38, 0xb0, 0x99, 0xae, 0x41, 0x5c, 0xf1, 0x61, 0x03, 0x58, 0x68, 0x2c,
-	0xe7, 0xfe, 0x4f, 0xdf, 0x39, 0x83, 0x00, 0xef, 0x7f, 0xd9, 0xe4, 0xe1, 0xb3, 0x95, 0x6c, 0xd7,
-	0x4a, 0xf6, 0xdd, 0x4a, 0xf6, 0xde, 0xc9, 0x68, 0xd7, 0xc9, 0xe8, 0xab, 0x93, 0xd1, 0xd3, 0x6d,
-	0xa9, 0xa9, 0x5a, 0x2f, 0xb2, 0xa5, 0xa9, 0xd5, 0x63, 0xfe, 0x02, 0x48, 0x1a, 0x27, 0x16, 0x0a,
-	0xa8, 0x61, 0x45, 0x16, 0x54, 0xe8, 0xf2, 0xb6, 0x57, 0x86, 0xb6, 0x0d, 0xac, 0x16, 0xb1, 0xcb,
-	0x72, 0xf3, 0x13, 0x00, 0x00, 0xff, 0xff, 0xe3, 0xa7, 0xdc, 0x78, 0x3a, 0x01, 0x00, 0x00,
+	// 555 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x53, 0xcd, 0x6e, 0xda, 0x4c,
+	0x14, 0xc5, 0xf9, 0x71, 0x3e, 0x5f, 0x4c, 0x3e, 0x32, 0x24, 0x68, 0x5a, 0x25, 0x96, 0x93, 0xaa,
+	0x12, 0x8a, 0x54, 0x5b, 0x49, 0x17, 0x55, 0x57, 0x15, 0x50, 0xaa, 0xa0, 0x56, 0xa4, 0x32, 0x6e,
+	0x2a, 0x65, 0x51, 0xcb, 0xc0, 0xd4, 0x1e, 0xb5, 0xd8, 0x68, 0x3c, 0x24, 0xf0, 0x16, 0x7d, 0x86,
+	0x3e, 0x4d, 0x97, 0x59, 0x76, 0x59, 0xc1, 0xb2, 0x2f, 0x51, 0x31, 0x33, 0x24, 0x40, 0xe9, 0xce,
+	0x73, 0xee, 0x39, 0xbe, 0x73, 0xee, 0x3d, 0x03, 0xf6, 0x97, 0x61, 0x2f, 0x65, 0xa1, 0x4b, 0x13,
+	0x4e, 0x22, 0x46, 0xf9, 0xd8, 0xbd, 0x39, 0x73, 0x39, 0x49, 0xc2, 0x84, 0x3b, 0x03, 0x96, 0xf2,
+	0x14, 0x95, 0x24, 0xc3, 0xb9, 0x67, 0x38, 0x37, 0x67, 0x27, 0xdf, 0xb7, 0x40, 0xf7, 0x05, 0x0b,
+	0x95, 0x41, 0x97, 0x7c, 0xac, 0xd9, 0x5a, 0xc5, 0xf0, 0xd4, 0x09, 0xed, 0xc3, 0x76, 0x7a, 0x9b,
+	0x10, 0x86, 0x37, 0x04, 0x2c, 0x0f, 0xe8, 0x29, 0xec, 0x76, 0x19, 0x09, 0x39, 0xe9, 0x05, 0x31,
+	0xa1, 0x51, 0xcc, 0xf1, 0xa6, 0xad, 0x55, 0xb6, 0xbc, 0x82, 0x42, 0x2f, 0x04, 0x88, 0x8e, 0xc1,
+	0x9c, 0xd3, 0x38, 0xed, 0x13, 0xbc, 0x25, 0xfe, 0x91, 0x57, 0x98, 0x4f, 0xfb, 0x04, 0x3d, 0x81,
+	0xc2, 0x80, 0x24, 0x3d, 0x9a, 0x44, 0x81, 0xec, 0xb3, 0x2d, 0x38, 0xa6, 0x02, 0x2f, 0x45, 0xbb,
+	0x97, 0xa0, 0x67, 0x3c, 0xe4, 0xc3, 0x0c, 0xeb, 0xb6, 0x56, 0xd9, 0x3d, 0x3f, 0x76, 0xd6, 0xb8,
+	0x71, 0xa4, 0x93, 0xb6, 0x20, 0x7a, 0x4a, 0x80, 0x4e, 0x61, 0x4f, 0x7e, 0x05, 0xdd, 0x38, 0x4c,
+	0x22, 0xd2, 0x0b, 0x3a, 0x63, 0xbc, 0x23, 0x7a, 0xfc, 0x2f, 0x0b, 0x75, 0x89, 0xd7, 0xc6, 0xe8,
+	0x1c, 0x0e, 0x56, 0xb8, 0xca, 0xdc, 0x7f, 0xc2, 0x5c, 0x69, 0x89, 0xaf, 0x2c, 0x3a, 0x50, 0x5a,
+	0xd1, 0x08, 0xa7, 0x86, 0xe8, 0xb0, 0xb7, 0xa4, 0x10, 0x7e, 0x5f, 0x00, 0x5e, 0xf2, 0x1b, 0x90,
+	0xd1, 0x80, 0x32, 0x92, 0x05, 0x21, 0xc7, 0x20, 0x44, 0x07, 0x8b, 0xd6, 0x1b, 0xb2, 0x5a, 0xe5,
+	0xe8, 0x15, 0x1c, 0xae, 0x17, 0xaa, 0x3b, 0xe6, 0xc5, 0x1d, 0x1f, 0xad, 0x11, 0xab, 0x9b, 0x62,
+	0xd8, 0xc9, 0x68, 0x94, 0x10, 0x96, 0x61, 0xd3, 0xde, 0xac, 0x18, 0xde, 0xfc, 0x88, 0x0e, 0xc1,
+	0xe0, 0x31, 0x23, 0x59, 0x9c, 0x7e, 0xed, 0xe1, 0x82, 0xad, 0x55, 0x0a, 0xde, 0x03, 0x70, 0xf2,
+	0x5b, 0x03, 0x53, 0x8e, 0xf6, 0x23, 0xa3, 0x9c, 0xb0, 0x7f, 0x46, 0xa5, 0x0c, 0xfa, 0xad, 0x60,
+	0xa8, 0xac, 0xa8, 0xd3, 0x6c, 0xc5, 0x7c, 0x3c, 0x20, 0xc1, 0x80, 0x91, 0xcf, 0x74, 0x44, 0x32,
+	0xbc, 0x29, 0xda, 0x9b, 0x33, 0xf0, 0xbd, 0xc2, 0xd0, 0x11, 0xc0, 0xc2, 0x24, 0x64, 0x50, 0x0c,
+	0x72, 0xef, 0xfe, 0x08, 0x20, 0x62, 0x61, 0xc2, 0xe5, 0xfe, 0x64, 0x46, 0x0c, 0x85, 0xd4, 0xc6,
+	0xb3, 0x3c, 0xce, 0xcb, 0x6a, 0x1c, 0xba, 0xcc, 0xa3, 0x42, 0x1f, 0xf2, 0x38, 0xa7, 0x89, 0x2d,
+	0xc9, 0x1c, 0xe4, 0x15, 0x36, 0xdb, 0xcf, 0xe9, 0x27, 0x30, 0x17, 0x73, 0x84, 0x30, 0xec, 0xfb,
+	0x8d, 0x56, 0xb5, 0xe5, 0x07, 0x6d, 0xbf, 0xea, 0x7f, 0x68, 0x07, 0xd5, 0xba, 0xdf, 0xbc, 0x6a,
+	0x14, 0x73, 0x7f, 0x57, 0xde, 0x78, 0x97, 0xd7, 0x8d, 0x56, 0x51, 0x43, 0x8f, 0xa1, 0xbc, 0xa2,
+	0xf1, 0xea, 0x17, 0xcd, 0xab, 0xc6, 0xeb, 0xe2, 0x46, 0xad, 0xf9, 0x63, 0x62, 0x69, 0x77, 0x13,
+	0x4b, 0xfb, 0x35, 0xb1, 0xb4, 0x6f, 0x53, 0x2b, 0x77, 0x37, 0xb5, 0x72, 0x3f, 0xa7, 0x56, 0xee,
+	0xda, 0x8d, 0x28, 0x8f, 0x87, 0x1d, 0xa7, 0x9b, 0xf6, 0xdd, 0xb7, 0x22, 0xde, 0xcf, 0xde, 0x85,
+	0x9d, 0xcc, 0x55, 0x4f, 0x7b, 0xb4, 0xf0, 0xb8, 0x67, 0x93, 0xcb, 0x3a, 0xba, 0x78, 0xd9, 0xcf,
+	0xff, 0x0c, 0x00, 0x51, 0xd9, 0x23, 0x02, 0xfd, 0x03, 0x00, 0x00,
 }
 
 func (m *Tenant) Marshal() (dAtA []byte, err error) {
@@ -145,6 +367,56 @@ func (m *Tenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTenant(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTenant(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.PendingOwnerExpiresHeight != 0 {
+		i = encodeVarintTenant(dAtA, i, uint64(m.PendingOwnerExpiresHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PendingOwnerExpiresAt) > 0 {
+		i -= len(m.PendingOwnerExpiresAt)
+		copy(dAtA[i:], m.PendingOwnerExpiresAt)
+		i = encodeVarintTenant(dAtA, i, uint64(len(m.PendingOwnerExpiresAt)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.StatusChangedTime) > 0 {
+		i -= len(m.StatusChangedTime)
+		copy(dAtA[i:], m.StatusChangedTime)
+		i = encodeVarintTenant(dAtA, i, uint64(len(m.StatusChangedTime)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.StatusChangedHeight != 0 {
+		i = encodeVarintTenant(dAtA, i, uint64(m.StatusChangedHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.StatusChangedBy) > 0 {
+		i -= len(m.StatusChangedBy)
+		copy(dAtA[i:], m.StatusChangedBy)
+		i = encodeVarintTenant(dAtA, i, uint64(len(m.StatusChangedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTenant(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.PendingOwner) > 0 {
 		i -= len(m.PendingOwner)
 		copy(dAtA[i:], m.PendingOwner)
@@ -181,6 +453,78 @@ func (m *Tenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TenantWriter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantWriter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantWriter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GrantedTime) > 0 {
+		i -= len(m.GrantedTime)
+		copy(dAtA[i:], m.GrantedTime)
+		i = encodeVarintTenant(dAtA, i, uint64(len(m.GrantedTime)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.GrantedHeight != 0 {
+		i = encodeVarintTenant(dAtA, i, uint64(m.GrantedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.GrantedBy) > 0 {
+		i -= len(m.GrantedBy)
+		copy(dAtA[i:], m.GrantedBy)
+		i = encodeVarintTenant(dAtA, i, uint64(len(m.GrantedBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintTenant(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.TypePrefixes) > 0 {
+		for iNdEx := len(m.TypePrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypePrefixes[iNdEx])
+			copy(dAtA[i:], m.TypePrefixes[iNdEx])
+			i = encodeVarintTenant(dAtA, i, uint64(len(m.TypePrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Writer) > 0 {
+		i -= len(m.Writer)
+		copy(dAtA[i:], m.Writer)
+		i = encodeVarintTenant(dAtA, i, uint64(len(m.Writer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTenant(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTenant(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTenant(v)
 	base := offset
@@ -217,6 +561,74 @@ func (m *Tenant) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTenant(uint64(l))
 	}
+	if m.Status != 0 {
+		n += 1 + sovTenant(uint64(m.Status))
+	}
+	l = len(m.StatusChangedBy)
+	if l > 0 {
+		n += 1 + l + sovTenant(uint64(l))
+	}
+	if m.StatusChangedHeight != 0 {
+		n += 1 + sovTenant(uint64(m.StatusChangedHeight))
+	}
+	l = len(m.StatusChangedTime)
+	if l > 0 {
+		n += 1 + l + sovTenant(uint64(l))
+	}
+	l = len(m.PendingOwnerExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovTenant(uint64(l))
+	}
+	if m.PendingOwnerExpiresHeight != 0 {
+		n += 1 + sovTenant(uint64(m.PendingOwnerExpiresHeight))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTenant(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTenant(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *TenantWriter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTenant(uint64(l))
+	}
+	l = len(m.Writer)
+	if l > 0 {
+		n += 1 + l + sovTenant(uint64(l))
+	}
+	if len(m.TypePrefixes) > 0 {
+		for _, s := range m.TypePrefixes {
+			l = len(s)
+			n += 1 + l + sovTenant(uint64(l))
+		}
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovTenant(uint64(l))
+	}
+	l = len(m.GrantedBy)
+	if l > 0 {
+		n += 1 + l + sovTenant(uint64(l))
+	}
+	if m.GrantedHeight != 0 {
+		n += 1 + sovTenant(uint64(m.GrantedHeight))
+	}
+	l = len(m.GrantedTime)
+	if l > 0 {
+		n += 1 + l + sovTenant(uint64(l))
+	}
 	return n
 }
 
@@ -402,6 +814,471 @@ func (m *Tenant) Unmarshal(dAtA []byte) error {
 			}
 			m.PendingOwner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TenantStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusChangedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusChangedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusChangedHeight", wireType)
+			}
+			m.StatusChangedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusChangedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusChangedTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StatusChangedTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwnerExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwnerExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwnerExpiresHeight", wireType)
+			}
+			m.PendingOwnerExpiresHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PendingOwnerExpiresHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TenantWriter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TenantWriter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TenantWriter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypePrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypePrefixes = append(m.TypePrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedHeight", wireType)
+			}
+			m.GrantedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GrantedTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTenant(dAtA[iNdEx:])
@@ -0,0 +1,133 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// ApprovalCount counts the approvals of the proposal given by current signers of tenant.
+func (p TenantProposal) ApprovalCount(tenant Tenant) uint32 {
+	var count uint32
+	for _, approval := range p.Approvals {
+		if tenant.IsSigner(approval) {
+			count++
+		}
+	}
+	return count
+}
+
+// TenantActionMsg is implemented by every message a TenantAction can wrap.
+type TenantActionMsg interface {
+	sdk.Msg
+	ValidateBasic() error
+	GetTenant() string
+}
+
+// Msg returns a copy of the wrapped message with its creator set to creator.
+func (a TenantAction) Msg(creator string) (TenantActionMsg, error) {
+	switch action := a.Action.(type) {
+	case *TenantAction_CommitIntegritySet:
+		if action.CommitIntegritySet != nil {
+			msg := *action.CommitIntegritySet
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_SupersedeIntegritySet:
+		if action.SupersedeIntegritySet != nil {
+			msg := *action.SupersedeIntegritySet
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_RevokeIntegrityRecords:
+		if action.RevokeIntegrityRecords != nil {
+			msg := *action.RevokeIntegrityRecords
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_TransferTenantOwnership:
+		if action.TransferTenantOwnership != nil {
+			msg := *action.TransferTenantOwnership
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_CancelTenantOwnershipTransfer:
+		if action.CancelTenantOwnershipTransfer != nil {
+			msg := *action.CancelTenantOwnershipTransfer
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_SetTenantSigners:
+		if action.SetTenantSigners != nil {
+			msg := *action.SetTenantSigners
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_GrantTenantWriter:
+		if action.GrantTenantWriter != nil {
+			msg := *action.GrantTenantWriter
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_RevokeTenantWriter:
+		if action.RevokeTenantWriter != nil {
+			msg := *action.RevokeTenantWriter
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_FreezeTenant:
+		if action.FreezeTenant != nil {
+			msg := *action.FreezeTenant
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_UnfreezeTenant:
+		if action.UnfreezeTenant != nil {
+			msg := *action.UnfreezeTenant
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_ArchiveTenant:
+		if action.ArchiveTenant != nil {
+			msg := *action.ArchiveTenant
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_SetPeriodFormat:
+		if action.SetPeriodFormat != nil {
+			msg := *action.SetPeriodFormat
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_RegisterIntegrityType:
+		if action.RegisterIntegrityType != nil {
+			msg := *action.RegisterIntegrityType
+			msg.Creator = creator
+			return &msg, nil
+		}
+	case *TenantAction_CancelIntegritySet:
+		if action.CancelIntegritySet != nil {
+			msg := *action.CancelIntegritySet
+			msg.Creator = creator
+			return &msg, nil
+		}
+	}
+
+	return nil, ErrInvalidTenantAction.Wrap("action must wrap one tenant message")
+}
+
+// ValidateTenantAction checks the action wrapped by a proposal of tenant as if owner had signed it.
+func ValidateTenantAction(tenant, owner string, action TenantAction) (TenantActionMsg, error) {
+	msg, err := action.Msg(owner)
+	if err != nil {
+		return nil, err
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	actionTenant, err := NormalizeTenant(msg.GetTenant())
+	if err != nil {
+		return nil, err
+	}
+	if actionTenant != tenant {
+		return nil, ErrInvalidTenantAction.Wrapf("action targets tenant %s instead of %s", actionTenant, tenant)
+	}
+
+	return msg, nil
+}
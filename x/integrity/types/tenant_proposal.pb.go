@@ -0,0 +1,609 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudora/integrity/v1/tenant_proposal.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TenantProposal is an open owner action of a tenant owned by signers. It is removed once executed.
+type TenantProposal struct {
+	Tenant   string       `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Id       uint64       `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	Action   TenantAction `protobuf:"bytes,3,opt,name=action,proto3" json:"action"`
+	Proposer string       `protobuf:"bytes,4,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// approvals lists the signers that approved the proposal, the proposer first.
+	Approvals     []string `protobuf:"bytes,5,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	CreatedHeight uint64   `protobuf:"varint,6,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	CreatedTime   string   `protobuf:"bytes,7,opt,name=created_time,json=createdTime,proto3" json:"created_time,omitempty"`
+}
+
+func (m *TenantProposal) Reset()         { *m = TenantProposal{} }
+func (m *TenantProposal) String() string { return proto.CompactTextString(m) }
+func (*TenantProposal) ProtoMessage()    {}
+func (*TenantProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18d31ab28e07e6e8, []int{0}
+}
+func (m *TenantProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TenantProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TenantProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TenantProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenantProposal.Merge(m, src)
+}
+func (m *TenantProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *TenantProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenantProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TenantProposal proto.InternalMessageInfo
+
+func (m *TenantProposal) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *TenantProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *TenantProposal) GetAction() TenantAction {
+	if m != nil {
+		return m.Action
+	}
+	return TenantAction{}
+}
+
+func (m *TenantProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *TenantProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *TenantProposal) GetCreatedHeight() uint64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *TenantProposal) GetCreatedTime() string {
+	if m != nil {
+		return m.CreatedTime
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*TenantProposal)(nil), "kudora.integrity.v1.TenantProposal")
+}
+
+func init() {
+	proto.RegisterFile("kudora/integrity/v1/tenant_proposal.proto", fileDescriptor_18d31ab28e07e6e8)
+}
+
+var fileDescriptor_18d31ab28e07e6e8 = []byte{
+	// 319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xc1, 0x4e, 0x02, 0x31,
+	0x10, 0x86, 0xb7, 0x80, 0xab, 0x14, 0xe5, 0x50, 0x8d, 0x69, 0x08, 0x59, 0x17, 0x13, 0x93, 0xf5,
+	0xe0, 0x36, 0xe8, 0x03, 0x18, 0x39, 0x69, 0xf4, 0x60, 0x36, 0x9c, 0xbc, 0x90, 0xc2, 0x36, 0x4b,
+	0x23, 0x6c, 0x9b, 0x6e, 0x21, 0xf0, 0x16, 0x3e, 0x16, 0x47, 0x8e, 0x9e, 0x8c, 0x61, 0x9f, 0xc3,
+	0xc4, 0xd8, 0x56, 0xf4, 0xc0, 0xad, 0xf3, 0xcf, 0xdf, 0xf9, 0x66, 0x7e, 0x78, 0xf9, 0x3a, 0x4b,
+	0x85, 0xa2, 0x84, 0xe7, 0x9a, 0x65, 0x8a, 0xeb, 0x25, 0x99, 0x77, 0x89, 0x66, 0x39, 0xcd, 0xf5,
+	0x40, 0x2a, 0x21, 0x45, 0x41, 0x27, 0xb1, 0x54, 0x42, 0x0b, 0x74, 0x6c, 0xad, 0xf1, 0xd6, 0x1a,
+	0xcf, 0xbb, 0xad, 0x93, 0x4c, 0x64, 0xc2, 0xf4, 0xc9, 0xcf, 0xcb, 0x5a, 0x5b, 0xed, 0x9d, 0x53,
+	0x17, 0xb6, 0x7b, 0xfe, 0x05, 0x60, 0xb3, 0x6f, 0x10, 0xcf, 0x8e, 0x80, 0x4e, 0xa1, 0x6f, 0xa1,
+	0x18, 0x84, 0x20, 0xaa, 0x27, 0xae, 0x42, 0x4d, 0x58, 0xe1, 0x29, 0xae, 0x84, 0x20, 0xaa, 0x25,
+	0x15, 0x9e, 0xa2, 0x5b, 0xe8, 0xd3, 0x91, 0xe6, 0x22, 0xc7, 0xd5, 0x10, 0x44, 0x8d, 0xeb, 0x4e,
+	0xbc, 0x63, 0xa9, 0xd8, 0x0e, 0xbf, 0x33, 0xc6, 0x5e, 0x6d, 0xf5, 0x71, 0xe6, 0x25, 0xee, 0x1b,
+	0x6a, 0xc1, 0x03, 0x7b, 0x16, 0x53, 0xb8, 0x66, 0x50, 0xdb, 0x1a, 0xb5, 0x61, 0x9d, 0x4a, 0xa9,
+	0xc4, 0x9c, 0x4e, 0x0a, 0xbc, 0x17, 0x56, 0xa3, 0x7a, 0xf2, 0x27, 0xa0, 0x0b, 0xd8, 0x1c, 0x29,
+	0x46, 0x35, 0x4b, 0x07, 0x63, 0xc6, 0xb3, 0xb1, 0xc6, 0xbe, 0x59, 0xeb, 0xc8, 0xa9, 0xf7, 0x46,
+	0x44, 0x1d, 0x78, 0xf8, 0x6b, 0xd3, 0x7c, 0xca, 0xf0, 0xbe, 0x81, 0x34, 0x9c, 0xd6, 0xe7, 0x53,
+	0xd6, 0x7b, 0x58, 0x6d, 0x02, 0xb0, 0xde, 0x04, 0xe0, 0x73, 0x13, 0x80, 0xb7, 0x32, 0xf0, 0xd6,
+	0x65, 0xe0, 0xbd, 0x97, 0x81, 0xf7, 0x42, 0x32, 0xae, 0xc7, 0xb3, 0x61, 0x3c, 0x12, 0x53, 0xf2,
+	0x68, 0x0e, 0xbb, 0x7a, 0xa2, 0xc3, 0x82, 0xb8, 0x38, 0x17, 0xff, 0x02, 0xd5, 0x4b, 0xc9, 0x8a,
+	0xa1, 0x6f, 0x12, 0xbd, 0xf9, 0x1e, 0x00, 0x98, 0x47, 0xa2, 0x8f, 0xc7, 0x01, 0x00, 0x00,
+}
+
+func (m *TenantProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CreatedTime) > 0 {
+		i -= len(m.CreatedTime)
+		copy(dAtA[i:], m.CreatedTime)
+		i = encodeVarintTenantProposal(dAtA, i, uint64(len(m.CreatedTime)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintTenantProposal(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintTenantProposal(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTenantProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTenantProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTenantProposal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTenantProposal(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTenantProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTenantProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TenantProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTenantProposal(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTenantProposal(uint64(m.Id))
+	}
+	l = m.Action.Size()
+	n += 1 + l + sovTenantProposal(uint64(l))
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTenantProposal(uint64(l))
+	}
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovTenantProposal(uint64(l))
+		}
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovTenantProposal(uint64(m.CreatedHeight))
+	}
+	l = len(m.CreatedTime)
+	if l > 0 {
+		n += 1 + l + sovTenantProposal(uint64(l))
+	}
+	return n
+}
+
+func sovTenantProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTenantProposal(x uint64) (n int) {
+	return sovTenantProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TenantProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTenantProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TenantProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TenantProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedTime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatedTime = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTenantProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTenantProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTenantProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTenantProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTenantProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTenantProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTenantProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTenantProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTenantProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTenantProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTenantProposal = fmt.Errorf("proto: unexpected end of group")
+)
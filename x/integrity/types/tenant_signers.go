@@ -0,0 +1,57 @@
+package types
+
+import (
+	"slices"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// TenantPolicyAddress derives the keyless address that owns a tenant once it is handed to signers.
+func TenantPolicyAddress(tenant string) []byte {
+	return address.Module(ModuleName, []byte("tenant"), []byte(tenant))
+}
+
+// NormalizeTenantSigners checks a signer set and its threshold and returns the signers in lowercase bech32
+// form, sorted. Two spellings of one address, such as its upper and lower case forms, count as duplicates.
+func NormalizeTenantSigners(signers []string, threshold uint32) ([]string, error) {
+	if len(signers) == 0 {
+		return nil, ErrInvalidTenantSigners.Wrap("at least one signer is required")
+	}
+	if len(signers) > MaxTenantSigners {
+		return nil, ErrInvalidTenantSigners.Wrapf("at most %d signers are allowed", MaxTenantSigners)
+	}
+
+	normalized := make([]string, 0, len(signers))
+	seen := make(map[string]struct{}, len(signers))
+	for _, signer := range signers {
+		signer, err := NormalizeOwnerAddress(signer, "signer")
+		if err != nil {
+			return nil, err
+		}
+		hrp, addressBytes, err := bech32.DecodeAndConvert(signer)
+		if err != nil {
+			return nil, ErrInvalidTenantSigners.Wrapf("invalid signer address %s: %s", signer, err)
+		}
+		if _, duplicate := seen[string(addressBytes)]; duplicate {
+			return nil, ErrInvalidTenantSigners.Wrapf("duplicate signer %s", signer)
+		}
+		seen[string(addressBytes)] = struct{}{}
+		canonical, err := bech32.ConvertAndEncode(hrp, addressBytes)
+		if err != nil {
+			return nil, ErrInvalidTenantSigners.Wrapf("invalid signer address %s: %s", signer, err)
+		}
+		normalized = append(normalized, canonical)
+	}
+	slices.Sort(normalized)
+	if threshold == 0 || int(threshold) > len(normalized) {
+		return nil, ErrInvalidTenantSigners.Wrapf("threshold must be between 1 and %d", len(normalized))
+	}
+
+	return normalized, nil
+}
+
+// IsSigner reports whether address is one of the signers of the tenant.
+func (t Tenant) IsSigner(address string) bool {
+	return slices.Contains(t.Signers, address)
+}
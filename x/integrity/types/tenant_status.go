@@ -0,0 +1,21 @@
+package types
+
+// Validate rejects tenant statuses this chain does not know.
+func (s TenantStatus) Validate() error {
+	if _, ok := TenantStatus_name[int32(s)]; !ok {
+		return ErrInvalidTenantStatus.Wrapf("unknown tenant status %d", s)
+	}
+	return nil
+}
+
+// CheckWritable rejects set writes on tenants that are not active.
+func (t Tenant) CheckWritable() error {
+	switch t.Status {
+	case TenantStatus_TENANT_STATUS_ACTIVE:
+		return nil
+	case TenantStatus_TENANT_STATUS_FROZEN:
+		return ErrTenantFrozen.Wrapf("tenant %s accepts no set writes while frozen", t.Tenant)
+	default:
+		return ErrTenantArchived.Wrapf("tenant %s is archived", t.Tenant)
+	}
+}
@@ -0,0 +1,36 @@
+package types
+
+import (
+	"strings"
+	"time"
+)
+
+// NormalizeTransferExpiresAt parses the optional RFC3339 expiry of an ownership offer and returns it in
+// UTC. An empty value means no time limit.
+func NormalizeTransferExpiresAt(expiresAt string) (string, error) {
+	expiresAt = strings.TrimSpace(expiresAt)
+	if expiresAt == "" {
+		return "", nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, expiresAt)
+	if err != nil {
+		return "", ErrInvalidTransferExpiry.Wrapf("expires at must be an RFC3339 time: %s", err)
+	}
+	return parsed.UTC().Format(time.RFC3339Nano), nil
+}
+
+// PendingOwnerExpired reports whether the pending ownership offer of the tenant has lapsed at blockTime
+// or blockHeight.
+func (t Tenant) PendingOwnerExpired(blockTime time.Time, blockHeight uint64) bool {
+	if t.PendingOwnerExpiresHeight != 0 && blockHeight >= t.PendingOwnerExpiresHeight {
+		return true
+	}
+	if t.PendingOwnerExpiresAt == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339Nano, t.PendingOwnerExpiresAt)
+	if err != nil {
+		return true
+	}
+	return !blockTime.Before(expiresAt)
+}
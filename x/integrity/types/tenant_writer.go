@@ -0,0 +1,66 @@
+package types
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// NormalizeTypePrefixes normalizes writer type prefixes with the integrity type format rules, then
+// sorts and deduplicates them. An empty list means the grant covers every type.
+func NormalizeTypePrefixes(prefixes []string) ([]string, error) {
+	if len(prefixes) > MaxTenantWriterTypePrefixes {
+		return nil, ErrInvalidTenantWriter.Wrapf("at most %d type prefixes are allowed", MaxTenantWriterTypePrefixes)
+	}
+
+	normalized := make([]string, 0, len(prefixes))
+	for _, prefix := range prefixes {
+		prefix, err := NormalizeIntegrityType(prefix)
+		if err != nil {
+			return nil, err
+		}
+		normalized = append(normalized, prefix)
+	}
+	slices.Sort(normalized)
+
+	return slices.Compact(normalized), nil
+}
+
+// NormalizeExpiresAt parses an optional RFC3339 expiry and returns it in UTC. An empty value means
+// no expiry.
+func NormalizeExpiresAt(expiresAt string) (string, error) {
+	expiresAt = strings.TrimSpace(expiresAt)
+	if expiresAt == "" {
+		return "", nil
+	}
+	parsed, err := time.Parse(time.RFC3339Nano, expiresAt)
+	if err != nil {
+		return "", ErrInvalidTenantWriter.Wrapf("expires at must be an RFC3339 time: %s", err)
+	}
+	return parsed.UTC().Format(time.RFC3339Nano), nil
+}
+
+// IsExpired reports whether the grant is no longer valid at blockTime.
+func (w TenantWriter) IsExpired(blockTime time.Time) bool {
+	if w.ExpiresAt == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339Nano, w.ExpiresAt)
+	if err != nil {
+		return true
+	}
+	return !blockTime.Before(expiresAt)
+}
+
+// AllowsType reports whether the grant covers integrityType.
+func (w TenantWriter) AllowsType(integrityType string) bool {
+	if len(w.TypePrefixes) == 0 {
+		return true
+	}
+	for _, prefix := range w.TypePrefixes {
+		if strings.HasPrefix(integrityType, prefix) {
+			return true
+		}
+	}
+	return false
+}
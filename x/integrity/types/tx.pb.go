@@ -219,6 +219,11 @@ type MsgTransferTenantOwnership struct {
 	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Tenant   string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
 	NewOwner string `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	// expires_at is an optional RFC3339 block time at which the offer lapses.
+	ExpiresAt string `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// expires_height is an optional block height at which the offer lapses. When both are set, the offer
+	// lapses at whichever comes first.
+	ExpiresHeight uint64 `protobuf:"varint,5,opt,name=expires_height,json=expiresHeight,proto3" json:"expires_height,omitempty"`
 }
 
 func (m *MsgTransferTenantOwnership) Reset()         { *m = MsgTransferTenantOwnership{} }
@@ -275,6 +280,20 @@ func (m *MsgTransferTenantOwnership) GetNewOwner() string {
 	return ""
 }
 
+func (m *MsgTransferTenantOwnership) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
+func (m *MsgTransferTenantOwnership) GetExpiresHeight() uint64 {
+	if m != nil {
+		return m.ExpiresHeight
+	}
+	return 0
+}
+
 // MsgTransferTenantOwnershipResponse defines the MsgTransferTenantOwnershipResponse message.
 type MsgTransferTenantOwnershipResponse struct {
 }
@@ -502,6 +521,13 @@ type MsgCommitIntegritySet struct {
 	Period  string            `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
 	Root    string            `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
 	Records []IntegrityRecord `protobuf:"bytes,6,rep,name=records,proto3" json:"records"`
+	// hash_scheme selects the Merkle hashing scheme root was built with. It defaults to HASH_SCHEME_V1.
+	HashScheme HashScheme `protobuf:"varint,7,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	// hash_algorithm selects the hash function of root. It must be allowed by the params.
+	HashAlgorithm HashAlgorithm `protobuf:"varint,8,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=kudora.integrity.v1.HashAlgorithm" json:"hash_algorithm,omitempty"`
+	// previous_root optionally names the root of the chain head of its tenant and type, which the set
+	// links to either way. A set without a chain head starts the chain.
+	PreviousRoot string `protobuf:"bytes,9,opt,name=previous_root,json=previousRoot,proto3" json:"previous_root,omitempty"`
 }
 
 func (m *MsgCommitIntegritySet) Reset()         { *m = MsgCommitIntegritySet{} }
@@ -579,6 +605,27 @@ func (m *MsgCommitIntegritySet) GetRecords() []IntegrityRecord {
 	return nil
 }
 
+func (m *MsgCommitIntegritySet) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_V1
+}
+
+func (m *MsgCommitIntegritySet) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_HASH_ALGORITHM_SHA256
+}
+
+func (m *MsgCommitIntegritySet) GetPreviousRoot() string {
+	if m != nil {
+		return m.PreviousRoot
+	}
+	return ""
+}
+
 // MsgCommitIntegritySetResponse defines the MsgCommitIntegritySetResponse message.
 type MsgCommitIntegritySetResponse struct {
 }
@@ -616,946 +663,12722 @@ func (m *MsgCommitIntegritySetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCommitIntegritySetResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "kudora.integrity.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kudora.integrity.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgRegisterTenant)(nil), "kudora.integrity.v1.MsgRegisterTenant")
-	proto.RegisterType((*MsgRegisterTenantResponse)(nil), "kudora.integrity.v1.MsgRegisterTenantResponse")
-	proto.RegisterType((*MsgTransferTenantOwnership)(nil), "kudora.integrity.v1.MsgTransferTenantOwnership")
-	proto.RegisterType((*MsgTransferTenantOwnershipResponse)(nil), "kudora.integrity.v1.MsgTransferTenantOwnershipResponse")
-	proto.RegisterType((*MsgAcceptTenantOwnership)(nil), "kudora.integrity.v1.MsgAcceptTenantOwnership")
-	proto.RegisterType((*MsgAcceptTenantOwnershipResponse)(nil), "kudora.integrity.v1.MsgAcceptTenantOwnershipResponse")
-	proto.RegisterType((*MsgCancelTenantOwnershipTransfer)(nil), "kudora.integrity.v1.MsgCancelTenantOwnershipTransfer")
-	proto.RegisterType((*MsgCancelTenantOwnershipTransferResponse)(nil), "kudora.integrity.v1.MsgCancelTenantOwnershipTransferResponse")
-	proto.RegisterType((*MsgCommitIntegritySet)(nil), "kudora.integrity.v1.MsgCommitIntegritySet")
-	proto.RegisterType((*MsgCommitIntegritySetResponse)(nil), "kudora.integrity.v1.MsgCommitIntegritySetResponse")
+// IntegritySetPayload is one set committed by MsgCommitIntegritySets.
+type IntegritySetPayload struct {
+	Tenant        string            `protobuf:"bytes,1,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type          string            `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Period        string            `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`
+	Root          string            `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	Records       []IntegrityRecord `protobuf:"bytes,5,rep,name=records,proto3" json:"records"`
+	HashScheme    HashScheme        `protobuf:"varint,6,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	HashAlgorithm HashAlgorithm     `protobuf:"varint,7,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=kudora.integrity.v1.HashAlgorithm" json:"hash_algorithm,omitempty"`
+	PreviousRoot  string            `protobuf:"bytes,8,opt,name=previous_root,json=previousRoot,proto3" json:"previous_root,omitempty"`
+}
+
+func (m *IntegritySetPayload) Reset()         { *m = IntegritySetPayload{} }
+func (m *IntegritySetPayload) String() string { return proto.CompactTextString(m) }
+func (*IntegritySetPayload) ProtoMessage()    {}
+func (*IntegritySetPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{12}
+}
+func (m *IntegritySetPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IntegritySetPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IntegritySetPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IntegritySetPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IntegritySetPayload.Merge(m, src)
+}
+func (m *IntegritySetPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *IntegritySetPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_IntegritySetPayload.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("kudora/integrity/v1/tx.proto", fileDescriptor_52ea9faa3d85ec16) }
+var xxx_messageInfo_IntegritySetPayload proto.InternalMessageInfo
 
-var fileDescriptor_52ea9faa3d85ec16 = []byte{
-	// 706 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4d, 0x4f, 0xd5, 0x4c,
-	0x14, 0xc7, 0x6f, 0x1f, 0xe0, 0xf2, 0xdc, 0x03, 0xd1, 0x50, 0x41, 0x4a, 0x91, 0xcb, 0x4d, 0x43,
-	0xcc, 0x4d, 0x23, 0x6d, 0xb8, 0x06, 0x49, 0x48, 0x34, 0xe1, 0xea, 0xc6, 0xc5, 0x8d, 0x5a, 0xd0,
-	0x85, 0x1b, 0x52, 0xda, 0x71, 0x6e, 0x23, 0x9d, 0x69, 0x66, 0x86, 0xb7, 0x44, 0x13, 0xa3, 0x3b,
-	0x57, 0x6e, 0xfc, 0x0e, 0x6e, 0x4c, 0x58, 0xf8, 0x01, 0x5c, 0xb2, 0x24, 0xae, 0x5c, 0x19, 0x03,
-	0x0b, 0x56, 0xae, 0xdd, 0x9a, 0xbe, 0x22, 0xa5, 0xe5, 0xc5, 0xc0, 0xa6, 0xe9, 0xcc, 0xf9, 0x9d,
-	0x73, 0xfe, 0x67, 0x32, 0xe7, 0x0c, 0xdc, 0x78, 0xb9, 0xe6, 0x52, 0x66, 0x9b, 0x1e, 0x11, 0x08,
-	0x33, 0x4f, 0x6c, 0x99, 0xeb, 0x33, 0xa6, 0xd8, 0x34, 0x02, 0x46, 0x05, 0x95, 0xaf, 0xc5, 0x56,
-	0x23, 0xb3, 0x1a, 0xeb, 0x33, 0xea, 0x90, 0xed, 0x7b, 0x84, 0x9a, 0xd1, 0x37, 0xe6, 0xd4, 0x51,
-	0x87, 0x72, 0x9f, 0x72, 0xd3, 0xe7, 0x38, 0xf4, 0xf7, 0x39, 0x4e, 0x0c, 0x63, 0xb1, 0x61, 0x39,
-	0x5a, 0x99, 0xf1, 0x22, 0x31, 0x0d, 0x63, 0x8a, 0x69, 0xbc, 0x1f, 0xfe, 0x25, 0xbb, 0x7a, 0x91,
-	0x9e, 0x6c, 0xb1, 0xcc, 0x90, 0x43, 0x99, 0x9b, 0xb0, 0x8d, 0x22, 0x36, 0xb0, 0x99, 0xed, 0x27,
-	0x39, 0xb4, 0xaf, 0x12, 0x5c, 0xed, 0x70, 0xfc, 0x34, 0x70, 0x6d, 0x81, 0x1e, 0x47, 0x16, 0xf9,
-	0x0e, 0xd4, 0xec, 0x35, 0xd1, 0xa5, 0xa1, 0x83, 0x22, 0x35, 0xa4, 0x66, 0xad, 0xad, 0x7c, 0xfb,
-	0x32, 0x3d, 0x9c, 0x88, 0x5b, 0x70, 0x5d, 0x86, 0x38, 0x5f, 0x14, 0xcc, 0x23, 0xd8, 0x3a, 0x44,
-	0xe5, 0x7b, 0x50, 0x8d, 0x63, 0x2b, 0xff, 0x35, 0xa4, 0xe6, 0x40, 0x6b, 0xdc, 0x28, 0x38, 0x1c,
-	0x23, 0x4e, 0xd2, 0xae, 0xed, 0xfc, 0x98, 0xac, 0x7c, 0x3a, 0xd8, 0xd6, 0x25, 0x2b, 0xf1, 0x9a,
-	0x9f, 0x7d, 0x7b, 0xb0, 0xad, 0x1f, 0xc6, 0x7b, 0x7f, 0xb0, 0xad, 0x6b, 0x49, 0x01, 0x9b, 0x7f,
-	0x95, 0x90, 0x93, 0xab, 0x8d, 0xc1, 0x68, 0x6e, 0xcb, 0x42, 0x3c, 0xa0, 0x84, 0x23, 0xcd, 0x87,
-	0xa1, 0x0e, 0xc7, 0x16, 0xc2, 0x1e, 0x17, 0x88, 0x2d, 0x21, 0x62, 0x13, 0x21, 0xb7, 0xa0, 0xdf,
-	0x61, 0xc8, 0x16, 0x94, 0x9d, 0x5a, 0x5c, 0x0a, 0xca, 0xd7, 0xa1, 0x2a, 0x22, 0xef, 0xa8, 0xb4,
-	0x9a, 0x95, 0xac, 0xe6, 0x07, 0x43, 0xc9, 0x29, 0xa5, 0x8d, 0xc3, 0xd8, 0xb1, 0x74, 0x99, 0x96,
-	0xcf, 0x12, 0xa8, 0x1d, 0x8e, 0x97, 0x98, 0x4d, 0xf8, 0x8b, 0xd4, 0xfa, 0x68, 0x83, 0x20, 0xc6,
-	0xbb, 0x5e, 0x70, 0x91, 0xaa, 0xe4, 0x59, 0xa8, 0x11, 0xb4, 0xb1, 0x4c, 0xc3, 0xe0, 0x4a, 0xcf,
-	0x29, 0xd1, 0xfe, 0x27, 0x68, 0x23, 0x92, 0x91, 0x2b, 0x66, 0x0a, 0xb4, 0x72, 0xb9, 0x59, 0x55,
-	0x02, 0x94, 0x0e, 0xc7, 0x0b, 0x8e, 0x83, 0x02, 0x71, 0x89, 0x25, 0xe5, 0xb4, 0x69, 0xd0, 0x28,
-	0xcb, 0x9a, 0x29, 0x7b, 0x15, 0x31, 0xf7, 0x6d, 0xe2, 0xa0, 0xd5, 0x1c, 0x93, 0x16, 0x75, 0x89,
-	0x0a, 0x75, 0x68, 0x9e, 0x96, 0x3d, 0x53, 0xfa, 0x5b, 0x82, 0x91, 0x10, 0xa6, 0xbe, 0xef, 0x89,
-	0x87, 0xe9, 0x3d, 0x5f, 0x44, 0x17, 0x7a, 0x55, 0x65, 0x19, 0x7a, 0xc5, 0x56, 0x80, 0xe2, 0xfb,
-	0x60, 0x45, 0xff, 0x21, 0x1b, 0x20, 0xe6, 0x51, 0x57, 0xe9, 0x8d, 0xd9, 0x78, 0x15, 0xb2, 0x8c,
-	0x52, 0xa1, 0xf4, 0xc5, 0x6c, 0xf8, 0x2f, 0x3f, 0x80, 0xfe, 0x78, 0xb6, 0x70, 0xa5, 0xda, 0xe8,
-	0x69, 0x0e, 0xb4, 0xa6, 0x0a, 0xdb, 0x3b, 0xd3, 0x6f, 0x45, 0x70, 0xbb, 0x37, 0xec, 0x73, 0x2b,
-	0x75, 0xcd, 0x9d, 0xd2, 0x24, 0x4c, 0x14, 0x16, 0x9e, 0x1e, 0x4d, 0xeb, 0x57, 0x1f, 0xf4, 0x74,
-	0x38, 0x96, 0x57, 0x60, 0xf0, 0xc8, 0x88, 0x2a, 0xce, 0x9d, 0x1b, 0x03, 0xea, 0xad, 0xb3, 0x50,
-	0x69, 0x2e, 0xb9, 0x0b, 0x57, 0x72, 0x93, 0xe2, 0x66, 0x99, 0xff, 0x51, 0x4e, 0x35, 0xce, 0xc6,
-	0x65, 0x99, 0xde, 0x49, 0x30, 0x5a, 0x36, 0x07, 0xcc, 0xb2, 0x58, 0x25, 0x0e, 0xea, 0xdc, 0x39,
-	0x1d, 0x32, 0x15, 0xaf, 0x61, 0xa4, 0xb8, 0x6f, 0xa7, 0xcb, 0x22, 0x16, 0xe2, 0xea, 0xec, 0xb9,
-	0xf0, 0x2c, 0xfd, 0x47, 0x09, 0x26, 0x4e, 0xee, 0xce, 0xd2, 0xc0, 0x27, 0xba, 0xa9, 0x77, 0xff,
-	0xc9, 0x2d, 0xd3, 0x25, 0x40, 0x2e, 0xe8, 0x44, 0xbd, 0x34, 0xe8, 0x31, 0x56, 0x6d, 0x9d, 0x9d,
-	0x4d, 0xb3, 0xaa, 0x7d, 0x6f, 0xc2, 0xa7, 0xb0, 0xfd, 0x64, 0x67, 0xaf, 0x2e, 0xed, 0xee, 0xd5,
-	0xa5, 0x9f, 0x7b, 0x75, 0xe9, 0xc3, 0x7e, 0xbd, 0xb2, 0xbb, 0x5f, 0xaf, 0x7c, 0xdf, 0xaf, 0x57,
-	0x9e, 0xcf, 0x61, 0x4f, 0x74, 0xd7, 0x56, 0x0c, 0x87, 0xfa, 0xe6, 0x33, 0x7b, 0x15, 0x11, 0xe1,
-	0x91, 0x36, 0x43, 0x2e, 0xf2, 0x11, 0x17, 0x0c, 0x99, 0x05, 0x0f, 0x65, 0xd8, 0xe2, 0x7c, 0xa5,
-	0x1a, 0x3d, 0xf4, 0xb7, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xc1, 0xc6, 0xf7, 0x95, 0xc8, 0x08,
-	0x00, 0x00,
+func (m *IntegritySetPayload) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *IntegritySetPayload) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *IntegritySetPayload) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// RegisterTenant defines the RegisterTenant RPC.
-	RegisterTenant(ctx context.Context, in *MsgRegisterTenant, opts ...grpc.CallOption) (*MsgRegisterTenantResponse, error)
-	// TransferTenantOwnership defines the TransferTenantOwnership RPC.
-	TransferTenantOwnership(ctx context.Context, in *MsgTransferTenantOwnership, opts ...grpc.CallOption) (*MsgTransferTenantOwnershipResponse, error)
-	// AcceptTenantOwnership defines the AcceptTenantOwnership RPC.
-	AcceptTenantOwnership(ctx context.Context, in *MsgAcceptTenantOwnership, opts ...grpc.CallOption) (*MsgAcceptTenantOwnershipResponse, error)
-	// CancelTenantOwnershipTransfer defines the CancelTenantOwnershipTransfer RPC.
-	CancelTenantOwnershipTransfer(ctx context.Context, in *MsgCancelTenantOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelTenantOwnershipTransferResponse, error)
-	// CommitIntegritySet defines the CommitIntegritySet RPC.
-	CommitIntegritySet(ctx context.Context, in *MsgCommitIntegritySet, opts ...grpc.CallOption) (*MsgCommitIntegritySetResponse, error)
+func (m *IntegritySetPayload) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *IntegritySetPayload) GetRecords() []IntegrityRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *IntegritySetPayload) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_V1
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IntegritySetPayload) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
 	}
-	return out, nil
+	return HashAlgorithm_HASH_ALGORITHM_SHA256
 }
 
-func (c *msgClient) RegisterTenant(ctx context.Context, in *MsgRegisterTenant, opts ...grpc.CallOption) (*MsgRegisterTenantResponse, error) {
-	out := new(MsgRegisterTenantResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/RegisterTenant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IntegritySetPayload) GetPreviousRoot() string {
+	if m != nil {
+		return m.PreviousRoot
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) TransferTenantOwnership(ctx context.Context, in *MsgTransferTenantOwnership, opts ...grpc.CallOption) (*MsgTransferTenantOwnershipResponse, error) {
-	out := new(MsgTransferTenantOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/TransferTenantOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgCommitIntegritySets defines the MsgCommitIntegritySets message.
+type MsgCommitIntegritySets struct {
+	Creator string                `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Sets    []IntegritySetPayload `protobuf:"bytes,2,rep,name=sets,proto3" json:"sets"`
+}
+
+func (m *MsgCommitIntegritySets) Reset()         { *m = MsgCommitIntegritySets{} }
+func (m *MsgCommitIntegritySets) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitIntegritySets) ProtoMessage()    {}
+func (*MsgCommitIntegritySets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{13}
+}
+func (m *MsgCommitIntegritySets) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitIntegritySets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitIntegritySets.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCommitIntegritySets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitIntegritySets.Merge(m, src)
+}
+func (m *MsgCommitIntegritySets) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitIntegritySets) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitIntegritySets.DiscardUnknown(m)
 }
 
-func (c *msgClient) AcceptTenantOwnership(ctx context.Context, in *MsgAcceptTenantOwnership, opts ...grpc.CallOption) (*MsgAcceptTenantOwnershipResponse, error) {
-	out := new(MsgAcceptTenantOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/AcceptTenantOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCommitIntegritySets proto.InternalMessageInfo
+
+func (m *MsgCommitIntegritySets) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CancelTenantOwnershipTransfer(ctx context.Context, in *MsgCancelTenantOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelTenantOwnershipTransferResponse, error) {
-	out := new(MsgCancelTenantOwnershipTransferResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/CancelTenantOwnershipTransfer", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgCommitIntegritySets) GetSets() []IntegritySetPayload {
+	if m != nil {
+		return m.Sets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) CommitIntegritySet(ctx context.Context, in *MsgCommitIntegritySet, opts ...grpc.CallOption) (*MsgCommitIntegritySetResponse, error) {
-	out := new(MsgCommitIntegritySetResponse)
-	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/CommitIntegritySet", in, out, opts...)
-	if err != nil {
-		return nil, err
+// MsgCommitIntegritySetsResponse defines the MsgCommitIntegritySetsResponse message.
+type MsgCommitIntegritySetsResponse struct {
+	SetCount uint64 `protobuf:"varint,1,opt,name=set_count,json=setCount,proto3" json:"set_count,omitempty"`
+}
+
+func (m *MsgCommitIntegritySetsResponse) Reset()         { *m = MsgCommitIntegritySetsResponse{} }
+func (m *MsgCommitIntegritySetsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitIntegritySetsResponse) ProtoMessage()    {}
+func (*MsgCommitIntegritySetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{14}
+}
+func (m *MsgCommitIntegritySetsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitIntegritySetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitIntegritySetsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgCommitIntegritySetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitIntegritySetsResponse.Merge(m, src)
+}
+func (m *MsgCommitIntegritySetsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitIntegritySetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitIntegritySetsResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// RegisterTenant defines the RegisterTenant RPC.
-	RegisterTenant(context.Context, *MsgRegisterTenant) (*MsgRegisterTenantResponse, error)
-	// TransferTenantOwnership defines the TransferTenantOwnership RPC.
-	TransferTenantOwnership(context.Context, *MsgTransferTenantOwnership) (*MsgTransferTenantOwnershipResponse, error)
-	// AcceptTenantOwnership defines the AcceptTenantOwnership RPC.
-	AcceptTenantOwnership(context.Context, *MsgAcceptTenantOwnership) (*MsgAcceptTenantOwnershipResponse, error)
-	// CancelTenantOwnershipTransfer defines the CancelTenantOwnershipTransfer RPC.
-	CancelTenantOwnershipTransfer(context.Context, *MsgCancelTenantOwnershipTransfer) (*MsgCancelTenantOwnershipTransferResponse, error)
-	// CommitIntegritySet defines the CommitIntegritySet RPC.
-	CommitIntegritySet(context.Context, *MsgCommitIntegritySet) (*MsgCommitIntegritySetResponse, error)
+var xxx_messageInfo_MsgCommitIntegritySetsResponse proto.InternalMessageInfo
+
+func (m *MsgCommitIntegritySetsResponse) GetSetCount() uint64 {
+	if m != nil {
+		return m.SetCount
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgGrantTenantWriter defines the MsgGrantTenantWriter message.
+type MsgGrantTenantWriter struct {
+	Creator      string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant       string   `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Writer       string   `protobuf:"bytes,3,opt,name=writer,proto3" json:"writer,omitempty"`
+	TypePrefixes []string `protobuf:"bytes,4,rep,name=type_prefixes,json=typePrefixes,proto3" json:"type_prefixes,omitempty"`
+	ExpiresAt    string   `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgGrantTenantWriter) Reset()         { *m = MsgGrantTenantWriter{} }
+func (m *MsgGrantTenantWriter) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantTenantWriter) ProtoMessage()    {}
+func (*MsgGrantTenantWriter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{15}
 }
-func (*UnimplementedMsgServer) RegisterTenant(ctx context.Context, req *MsgRegisterTenant) (*MsgRegisterTenantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegisterTenant not implemented")
+func (m *MsgGrantTenantWriter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) TransferTenantOwnership(ctx context.Context, req *MsgTransferTenantOwnership) (*MsgTransferTenantOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TransferTenantOwnership not implemented")
+func (m *MsgGrantTenantWriter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantTenantWriter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) AcceptTenantOwnership(ctx context.Context, req *MsgAcceptTenantOwnership) (*MsgAcceptTenantOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AcceptTenantOwnership not implemented")
+func (m *MsgGrantTenantWriter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantTenantWriter.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CancelTenantOwnershipTransfer(ctx context.Context, req *MsgCancelTenantOwnershipTransfer) (*MsgCancelTenantOwnershipTransferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelTenantOwnershipTransfer not implemented")
+func (m *MsgGrantTenantWriter) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) CommitIntegritySet(ctx context.Context, req *MsgCommitIntegritySet) (*MsgCommitIntegritySetResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CommitIntegritySet not implemented")
+func (m *MsgGrantTenantWriter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantTenantWriter.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgGrantTenantWriter proto.InternalMessageInfo
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgGrantTenantWriter) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return ""
+}
+
+func (m *MsgGrantTenantWriter) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Msg/UpdateParams",
+	return ""
+}
+
+func (m *MsgGrantTenantWriter) GetWriter() string {
+	if m != nil {
+		return m.Writer
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return ""
+}
+
+func (m *MsgGrantTenantWriter) GetTypePrefixes() []string {
+	if m != nil {
+		return m.TypePrefixes
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_RegisterTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRegisterTenant)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RegisterTenant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Msg/RegisterTenant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RegisterTenant(ctx, req.(*MsgRegisterTenant))
+func (m *MsgGrantTenantWriter) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_TransferTenantOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgTransferTenantOwnership)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).TransferTenantOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Msg/TransferTenantOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).TransferTenantOwnership(ctx, req.(*MsgTransferTenantOwnership))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgGrantTenantWriterResponse defines the MsgGrantTenantWriterResponse message.
+type MsgGrantTenantWriterResponse struct {
 }
 
-func _Msg_AcceptTenantOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgAcceptTenantOwnership)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).AcceptTenantOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Msg/AcceptTenantOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).AcceptTenantOwnership(ctx, req.(*MsgAcceptTenantOwnership))
+func (m *MsgGrantTenantWriterResponse) Reset()         { *m = MsgGrantTenantWriterResponse{} }
+func (m *MsgGrantTenantWriterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantTenantWriterResponse) ProtoMessage()    {}
+func (*MsgGrantTenantWriterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{16}
+}
+func (m *MsgGrantTenantWriterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgGrantTenantWriterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgGrantTenantWriterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgGrantTenantWriterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantTenantWriterResponse.Merge(m, src)
+}
+func (m *MsgGrantTenantWriterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgGrantTenantWriterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantTenantWriterResponse.DiscardUnknown(m)
 }
 
-func _Msg_CancelTenantOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelTenantOwnershipTransfer)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelTenantOwnershipTransfer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Msg/CancelTenantOwnershipTransfer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelTenantOwnershipTransfer(ctx, req.(*MsgCancelTenantOwnershipTransfer))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgGrantTenantWriterResponse proto.InternalMessageInfo
+
+// MsgRevokeTenantWriter defines the MsgRevokeTenantWriter message.
+type MsgRevokeTenantWriter struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Writer  string `protobuf:"bytes,3,opt,name=writer,proto3" json:"writer,omitempty"`
 }
 
-func _Msg_CommitIntegritySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCommitIntegritySet)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CommitIntegritySet(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudora.integrity.v1.Msg/CommitIntegritySet",
+func (m *MsgRevokeTenantWriter) Reset()         { *m = MsgRevokeTenantWriter{} }
+func (m *MsgRevokeTenantWriter) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTenantWriter) ProtoMessage()    {}
+func (*MsgRevokeTenantWriter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{17}
+}
+func (m *MsgRevokeTenantWriter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTenantWriter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTenantWriter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CommitIntegritySet(ctx, req.(*MsgCommitIntegritySet))
+}
+func (m *MsgRevokeTenantWriter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTenantWriter.Merge(m, src)
+}
+func (m *MsgRevokeTenantWriter) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTenantWriter) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTenantWriter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeTenantWriter proto.InternalMessageInfo
+
+func (m *MsgRevokeTenantWriter) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kudora.integrity.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "RegisterTenant",
-			Handler:    _Msg_RegisterTenant_Handler,
-		},
-		{
-			MethodName: "TransferTenantOwnership",
-			Handler:    _Msg_TransferTenantOwnership_Handler,
-		},
-		{
-			MethodName: "AcceptTenantOwnership",
-			Handler:    _Msg_AcceptTenantOwnership_Handler,
-		},
-		{
-			MethodName: "CancelTenantOwnershipTransfer",
-			Handler:    _Msg_CancelTenantOwnershipTransfer_Handler,
-		},
-		{
-			MethodName: "CommitIntegritySet",
-			Handler:    _Msg_CommitIntegritySet_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kudora/integrity/v1/tx.proto",
+func (m *MsgRevokeTenantWriter) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRevokeTenantWriter) GetWriter() string {
+	if m != nil {
+		return m.Writer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRevokeTenantWriterResponse defines the MsgRevokeTenantWriterResponse message.
+type MsgRevokeTenantWriterResponse struct {
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgRevokeTenantWriterResponse) Reset()         { *m = MsgRevokeTenantWriterResponse{} }
+func (m *MsgRevokeTenantWriterResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeTenantWriterResponse) ProtoMessage()    {}
+func (*MsgRevokeTenantWriterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{18}
+}
+func (m *MsgRevokeTenantWriterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeTenantWriterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeTenantWriterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgRevokeTenantWriterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeTenantWriterResponse.Merge(m, src)
+}
+func (m *MsgRevokeTenantWriterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeTenantWriterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeTenantWriterResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgRevokeTenantWriterResponse proto.InternalMessageInfo
+
+// MsgSupersedeIntegritySet defines the MsgSupersedeIntegritySet message.
+type MsgSupersedeIntegritySet struct {
+	Creator string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string            `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type    string            `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Period  string            `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Root    string            `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	Records []IntegrityRecord `protobuf:"bytes,6,rep,name=records,proto3" json:"records"`
+	// reason_hash is a 32-byte hash of the off-chain reason for the correction.
+	ReasonHash    string        `protobuf:"bytes,7,opt,name=reason_hash,json=reasonHash,proto3" json:"reason_hash,omitempty"`
+	HashScheme    HashScheme    `protobuf:"varint,8,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	HashAlgorithm HashAlgorithm `protobuf:"varint,9,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=kudora.integrity.v1.HashAlgorithm" json:"hash_algorithm,omitempty"`
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSupersedeIntegritySet) Reset()         { *m = MsgSupersedeIntegritySet{} }
+func (m *MsgSupersedeIntegritySet) String() string { return proto.CompactTextString(m) }
+func (*MsgSupersedeIntegritySet) ProtoMessage()    {}
+func (*MsgSupersedeIntegritySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{19}
+}
+func (m *MsgSupersedeIntegritySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSupersedeIntegritySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSupersedeIntegritySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSupersedeIntegritySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSupersedeIntegritySet.Merge(m, src)
+}
+func (m *MsgSupersedeIntegritySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSupersedeIntegritySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSupersedeIntegritySet.DiscardUnknown(m)
 }
 
-func (m *MsgRegisterTenant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSupersedeIntegritySet proto.InternalMessageInfo
+
+func (m *MsgSupersedeIntegritySet) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgRegisterTenant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSupersedeIntegritySet) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
 }
 
-func (m *MsgRegisterTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSupersedeIntegritySet) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgRegisterTenantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSupersedeIntegritySet) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRegisterTenantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return ""
 }
 
-func (m *MsgRegisterTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSupersedeIntegritySet) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
 }
 
-func (m *MsgTransferTenantOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSupersedeIntegritySet) GetRecords() []IntegrityRecord {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgTransferTenantOwnership) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSupersedeIntegritySet) GetReasonHash() string {
+	if m != nil {
+		return m.ReasonHash
+	}
+	return ""
 }
 
-func (m *MsgTransferTenantOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSupersedeIntegritySet) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
 	}
-	return len(dAtA) - i, nil
+	return HashScheme_HASH_SCHEME_V1
 }
 
-func (m *MsgTransferTenantOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSupersedeIntegritySet) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
 	}
-	return dAtA[:n], nil
+	return HashAlgorithm_HASH_ALGORITHM_SHA256
 }
 
-func (m *MsgTransferTenantOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSupersedeIntegritySetResponse defines the MsgSupersedeIntegritySetResponse message.
+type MsgSupersedeIntegritySetResponse struct {
+	Version uint64 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 }
 
-func (m *MsgTransferTenantOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSupersedeIntegritySetResponse) Reset()         { *m = MsgSupersedeIntegritySetResponse{} }
+func (m *MsgSupersedeIntegritySetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSupersedeIntegritySetResponse) ProtoMessage()    {}
+func (*MsgSupersedeIntegritySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{20}
 }
-
-func (m *MsgAcceptTenantOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSupersedeIntegritySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSupersedeIntegritySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSupersedeIntegritySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgAcceptTenantOwnership) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSupersedeIntegritySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSupersedeIntegritySetResponse.Merge(m, src)
 }
-
-func (m *MsgAcceptTenantOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *MsgSupersedeIntegritySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSupersedeIntegritySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSupersedeIntegritySetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgAcceptTenantOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSupersedeIntegritySetResponse proto.InternalMessageInfo
+
+func (m *MsgSupersedeIntegritySetResponse) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgAcceptTenantOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgOpenIntegritySet defines the MsgOpenIntegritySet message.
+type MsgOpenIntegritySet struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Period  string `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	// hash_scheme selects the Merkle hashing scheme of the rolling and sealed roots.
+	HashScheme    HashScheme    `protobuf:"varint,5,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	HashAlgorithm HashAlgorithm `protobuf:"varint,6,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=kudora.integrity.v1.HashAlgorithm" json:"hash_algorithm,omitempty"`
 }
 
-func (m *MsgAcceptTenantOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgOpenIntegritySet) Reset()         { *m = MsgOpenIntegritySet{} }
+func (m *MsgOpenIntegritySet) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenIntegritySet) ProtoMessage()    {}
+func (*MsgOpenIntegritySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{21}
 }
-
-func (m *MsgCancelTenantOwnershipTransfer) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenIntegritySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenIntegritySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenIntegritySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCancelTenantOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenIntegritySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenIntegritySet.Merge(m, src)
+}
+func (m *MsgOpenIntegritySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenIntegritySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenIntegritySet.DiscardUnknown(m)
 }
 
-func (m *MsgCancelTenantOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgOpenIntegritySet proto.InternalMessageInfo
+
+func (m *MsgOpenIntegritySet) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCancelTenantOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenIntegritySet) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCancelTenantOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenIntegritySet) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *MsgCancelTenantOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgOpenIntegritySet) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
 }
 
-func (m *MsgCommitIntegritySet) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenIntegritySet) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
 	}
-	return dAtA[:n], nil
+	return HashScheme_HASH_SCHEME_V1
 }
 
-func (m *MsgCommitIntegritySet) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenIntegritySet) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_HASH_ALGORITHM_SHA256
 }
 
-func (m *MsgCommitIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.Root) > 0 {
-		i -= len(m.Root)
-		copy(dAtA[i:], m.Root)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Period) > 0 {
-		i -= len(m.Period)
-		copy(dAtA[i:], m.Period)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Type) > 0 {
-		i -= len(m.Type)
-		copy(dAtA[i:], m.Type)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Tenant) > 0 {
-		i -= len(m.Tenant)
-		copy(dAtA[i:], m.Tenant)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgOpenIntegritySetResponse defines the MsgOpenIntegritySetResponse message.
+type MsgOpenIntegritySetResponse struct {
 }
 
-func (m *MsgCommitIntegritySetResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgOpenIntegritySetResponse) Reset()         { *m = MsgOpenIntegritySetResponse{} }
+func (m *MsgOpenIntegritySetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgOpenIntegritySetResponse) ProtoMessage()    {}
+func (*MsgOpenIntegritySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{22}
+}
+func (m *MsgOpenIntegritySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgOpenIntegritySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgOpenIntegritySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCommitIntegritySetResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgOpenIntegritySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgOpenIntegritySetResponse.Merge(m, src)
+}
+func (m *MsgOpenIntegritySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgOpenIntegritySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgOpenIntegritySetResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCommitIntegritySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_MsgOpenIntegritySetResponse proto.InternalMessageInfo
+
+// MsgAppendIntegrityRecords defines the MsgAppendIntegrityRecords message.
+type MsgAppendIntegrityRecords struct {
+	Creator string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string            `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type    string            `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Period  string            `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Records []IntegrityRecord `protobuf:"bytes,5,rep,name=records,proto3" json:"records"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgAppendIntegrityRecords) Reset()         { *m = MsgAppendIntegrityRecords{} }
+func (m *MsgAppendIntegrityRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendIntegrityRecords) ProtoMessage()    {}
+func (*MsgAppendIntegrityRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{23}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAppendIntegrityRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppendIntegrityRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppendIntegrityRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgAppendIntegrityRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendIntegrityRecords.Merge(m, src)
+}
+func (m *MsgAppendIntegrityRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppendIntegrityRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendIntegrityRecords.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgAppendIntegrityRecords proto.InternalMessageInfo
+
+func (m *MsgAppendIntegrityRecords) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgRegisterTenant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAppendIntegrityRecords) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	return n
+	return ""
 }
 
-func (m *MsgRegisterTenantResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAppendIntegrityRecords) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgTransferTenantOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAppendIntegrityRecords) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	return n
+	return ""
 }
 
-func (m *MsgTransferTenantOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAppendIntegrityRecords) GetRecords() []IntegrityRecord {
+	if m != nil {
+		return m.Records
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *MsgAcceptTenantOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgAppendIntegrityRecordsResponse defines the MsgAppendIntegrityRecordsResponse message.
+type MsgAppendIntegrityRecordsResponse struct {
+	// root is the rolling root over every record appended so far.
+	Root        string `protobuf:"bytes,1,opt,name=root,proto3" json:"root,omitempty"`
+	RecordCount uint64 `protobuf:"varint,2,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
 }
 
-func (m *MsgAcceptTenantOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAppendIntegrityRecordsResponse) Reset()         { *m = MsgAppendIntegrityRecordsResponse{} }
+func (m *MsgAppendIntegrityRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAppendIntegrityRecordsResponse) ProtoMessage()    {}
+func (*MsgAppendIntegrityRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{24}
+}
+func (m *MsgAppendIntegrityRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAppendIntegrityRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAppendIntegrityRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgAppendIntegrityRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAppendIntegrityRecordsResponse.Merge(m, src)
+}
+func (m *MsgAppendIntegrityRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAppendIntegrityRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAppendIntegrityRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCancelTenantOwnershipTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgAppendIntegrityRecordsResponse proto.InternalMessageInfo
+
+func (m *MsgAppendIntegrityRecordsResponse) GetRoot() string {
+	if m != nil {
+		return m.Root
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCancelTenantOwnershipTransferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgAppendIntegrityRecordsResponse) GetRecordCount() uint64 {
+	if m != nil {
+		return m.RecordCount
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgCommitIntegritySet) Size() (n int) {
-	if m == nil {
-		return 0
+// MsgSealIntegritySet defines the MsgSealIntegritySet message.
+type MsgSealIntegritySet struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Period  string `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Root    string `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+}
+
+func (m *MsgSealIntegritySet) Reset()         { *m = MsgSealIntegritySet{} }
+func (m *MsgSealIntegritySet) String() string { return proto.CompactTextString(m) }
+func (*MsgSealIntegritySet) ProtoMessage()    {}
+func (*MsgSealIntegritySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{25}
+}
+func (m *MsgSealIntegritySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSealIntegritySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSealIntegritySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgSealIntegritySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSealIntegritySet.Merge(m, src)
+}
+func (m *MsgSealIntegritySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSealIntegritySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSealIntegritySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSealIntegritySet proto.InternalMessageInfo
+
+func (m *MsgSealIntegritySet) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.Tenant)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSealIntegritySet) GetTenant() string {
+	if m != nil {
+		return m.Tenant
 	}
-	l = len(m.Type)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSealIntegritySet) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	l = len(m.Period)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSealIntegritySet) GetPeriod() string {
+	if m != nil {
+		return m.Period
 	}
-	l = len(m.Root)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgSealIntegritySet) GetRoot() string {
+	if m != nil {
+		return m.Root
 	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgSealIntegritySetResponse defines the MsgSealIntegritySetResponse message.
+type MsgSealIntegritySetResponse struct {
+}
+
+func (m *MsgSealIntegritySetResponse) Reset()         { *m = MsgSealIntegritySetResponse{} }
+func (m *MsgSealIntegritySetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSealIntegritySetResponse) ProtoMessage()    {}
+func (*MsgSealIntegritySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{26}
+}
+func (m *MsgSealIntegritySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSealIntegritySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSealIntegritySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSealIntegritySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSealIntegritySetResponse.Merge(m, src)
+}
+func (m *MsgSealIntegritySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSealIntegritySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSealIntegritySetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSealIntegritySetResponse proto.InternalMessageInfo
+
+// MsgCancelIntegritySet discards an open set together with the records appended to it. The signer must be
+// the tenant owner.
+type MsgCancelIntegritySet struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type    string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Period  string `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+}
+
+func (m *MsgCancelIntegritySet) Reset()         { *m = MsgCancelIntegritySet{} }
+func (m *MsgCancelIntegritySet) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelIntegritySet) ProtoMessage()    {}
+func (*MsgCancelIntegritySet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{27}
+}
+func (m *MsgCancelIntegritySet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelIntegritySet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelIntegritySet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelIntegritySet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelIntegritySet.Merge(m, src)
+}
+func (m *MsgCancelIntegritySet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelIntegritySet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelIntegritySet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelIntegritySet proto.InternalMessageInfo
+
+func (m *MsgCancelIntegritySet) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelIntegritySet) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgCancelIntegritySet) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MsgCancelIntegritySet) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+// MsgCancelIntegritySetResponse defines the MsgCancelIntegritySetResponse message.
+type MsgCancelIntegritySetResponse struct {
+}
+
+func (m *MsgCancelIntegritySetResponse) Reset()         { *m = MsgCancelIntegritySetResponse{} }
+func (m *MsgCancelIntegritySetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelIntegritySetResponse) ProtoMessage()    {}
+func (*MsgCancelIntegritySetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{28}
+}
+func (m *MsgCancelIntegritySetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelIntegritySetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelIntegritySetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelIntegritySetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelIntegritySetResponse.Merge(m, src)
+}
+func (m *MsgCancelIntegritySetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelIntegritySetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelIntegritySetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelIntegritySetResponse proto.InternalMessageInfo
+
+// MsgAnchorIntegrityRoot defines the MsgAnchorIntegrityRoot message.
+type MsgAnchorIntegrityRoot struct {
+	Creator       string        `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant        string        `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type          string        `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Period        string        `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Root          string        `protobuf:"bytes,5,opt,name=root,proto3" json:"root,omitempty"`
+	RecordCount   uint64        `protobuf:"varint,6,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+	StorageUri    string        `protobuf:"bytes,7,opt,name=storage_uri,json=storageUri,proto3" json:"storage_uri,omitempty"`
+	StorageDigest string        `protobuf:"bytes,8,opt,name=storage_digest,json=storageDigest,proto3" json:"storage_digest,omitempty"`
+	HashScheme    HashScheme    `protobuf:"varint,9,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	HashAlgorithm HashAlgorithm `protobuf:"varint,10,opt,name=hash_algorithm,json=hashAlgorithm,proto3,enum=kudora.integrity.v1.HashAlgorithm" json:"hash_algorithm,omitempty"`
+}
+
+func (m *MsgAnchorIntegrityRoot) Reset()         { *m = MsgAnchorIntegrityRoot{} }
+func (m *MsgAnchorIntegrityRoot) String() string { return proto.CompactTextString(m) }
+func (*MsgAnchorIntegrityRoot) ProtoMessage()    {}
+func (*MsgAnchorIntegrityRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{29}
+}
+func (m *MsgAnchorIntegrityRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnchorIntegrityRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnchorIntegrityRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnchorIntegrityRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnchorIntegrityRoot.Merge(m, src)
+}
+func (m *MsgAnchorIntegrityRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnchorIntegrityRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnchorIntegrityRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnchorIntegrityRoot proto.InternalMessageInfo
+
+func (m *MsgAnchorIntegrityRoot) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAnchorIntegrityRoot) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgAnchorIntegrityRoot) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MsgAnchorIntegrityRoot) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *MsgAnchorIntegrityRoot) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *MsgAnchorIntegrityRoot) GetRecordCount() uint64 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+func (m *MsgAnchorIntegrityRoot) GetStorageUri() string {
+	if m != nil {
+		return m.StorageUri
+	}
+	return ""
+}
+
+func (m *MsgAnchorIntegrityRoot) GetStorageDigest() string {
+	if m != nil {
+		return m.StorageDigest
+	}
+	return ""
+}
+
+func (m *MsgAnchorIntegrityRoot) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_V1
+}
+
+func (m *MsgAnchorIntegrityRoot) GetHashAlgorithm() HashAlgorithm {
+	if m != nil {
+		return m.HashAlgorithm
+	}
+	return HashAlgorithm_HASH_ALGORITHM_SHA256
+}
+
+// MsgAnchorIntegrityRootResponse defines the MsgAnchorIntegrityRootResponse message.
+type MsgAnchorIntegrityRootResponse struct {
+}
+
+func (m *MsgAnchorIntegrityRootResponse) Reset()         { *m = MsgAnchorIntegrityRootResponse{} }
+func (m *MsgAnchorIntegrityRootResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAnchorIntegrityRootResponse) ProtoMessage()    {}
+func (*MsgAnchorIntegrityRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{30}
+}
+func (m *MsgAnchorIntegrityRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAnchorIntegrityRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAnchorIntegrityRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAnchorIntegrityRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAnchorIntegrityRootResponse.Merge(m, src)
+}
+func (m *MsgAnchorIntegrityRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAnchorIntegrityRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAnchorIntegrityRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAnchorIntegrityRootResponse proto.InternalMessageInfo
+
+// MsgSetPeriodFormat declares the period format of one integrity type of a tenant. The format can only
+// change while the type has no sets. PERIOD_FORMAT_UNSPECIFIED removes the declaration.
+type MsgSetPeriodFormat struct {
+	Creator string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string       `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type    string       `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Format  PeriodFormat `protobuf:"varint,4,opt,name=format,proto3,enum=kudora.integrity.v1.PeriodFormat" json:"format,omitempty"`
+}
+
+func (m *MsgSetPeriodFormat) Reset()         { *m = MsgSetPeriodFormat{} }
+func (m *MsgSetPeriodFormat) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPeriodFormat) ProtoMessage()    {}
+func (*MsgSetPeriodFormat) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{31}
+}
+func (m *MsgSetPeriodFormat) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPeriodFormat) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPeriodFormat.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPeriodFormat) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPeriodFormat.Merge(m, src)
+}
+func (m *MsgSetPeriodFormat) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPeriodFormat) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPeriodFormat.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPeriodFormat proto.InternalMessageInfo
+
+func (m *MsgSetPeriodFormat) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetPeriodFormat) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgSetPeriodFormat) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MsgSetPeriodFormat) GetFormat() PeriodFormat {
+	if m != nil {
+		return m.Format
+	}
+	return PeriodFormat_PERIOD_FORMAT_UNSPECIFIED
+}
+
+// MsgSetPeriodFormatResponse defines the MsgSetPeriodFormatResponse message.
+type MsgSetPeriodFormatResponse struct {
+}
+
+func (m *MsgSetPeriodFormatResponse) Reset()         { *m = MsgSetPeriodFormatResponse{} }
+func (m *MsgSetPeriodFormatResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPeriodFormatResponse) ProtoMessage()    {}
+func (*MsgSetPeriodFormatResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{32}
+}
+func (m *MsgSetPeriodFormatResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPeriodFormatResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPeriodFormatResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPeriodFormatResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPeriodFormatResponse.Merge(m, src)
+}
+func (m *MsgSetPeriodFormatResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPeriodFormatResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPeriodFormatResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPeriodFormatResponse proto.InternalMessageInfo
+
+// MsgRegisterIntegrityType registers an integrity type of a tenant, or updates it when it is already
+// registered.
+type MsgRegisterIntegrityType struct {
+	Creator      string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant       string       `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type         string       `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Description  string       `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	SchemaHash   string       `protobuf:"bytes,5,opt,name=schema_hash,json=schemaHash,proto3" json:"schema_hash,omitempty"`
+	PeriodFormat PeriodFormat `protobuf:"varint,6,opt,name=period_format,json=periodFormat,proto3,enum=kudora.integrity.v1.PeriodFormat" json:"period_format,omitempty"`
+	HashScheme   HashScheme   `protobuf:"varint,7,opt,name=hash_scheme,json=hashScheme,proto3,enum=kudora.integrity.v1.HashScheme" json:"hash_scheme,omitempty"`
+	Deprecated   bool         `protobuf:"varint,8,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+}
+
+func (m *MsgRegisterIntegrityType) Reset()         { *m = MsgRegisterIntegrityType{} }
+func (m *MsgRegisterIntegrityType) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterIntegrityType) ProtoMessage()    {}
+func (*MsgRegisterIntegrityType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{33}
+}
+func (m *MsgRegisterIntegrityType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterIntegrityType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterIntegrityType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterIntegrityType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterIntegrityType.Merge(m, src)
+}
+func (m *MsgRegisterIntegrityType) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterIntegrityType) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterIntegrityType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterIntegrityType proto.InternalMessageInfo
+
+func (m *MsgRegisterIntegrityType) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRegisterIntegrityType) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgRegisterIntegrityType) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MsgRegisterIntegrityType) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *MsgRegisterIntegrityType) GetSchemaHash() string {
+	if m != nil {
+		return m.SchemaHash
+	}
+	return ""
+}
+
+func (m *MsgRegisterIntegrityType) GetPeriodFormat() PeriodFormat {
+	if m != nil {
+		return m.PeriodFormat
+	}
+	return PeriodFormat_PERIOD_FORMAT_UNSPECIFIED
+}
+
+func (m *MsgRegisterIntegrityType) GetHashScheme() HashScheme {
+	if m != nil {
+		return m.HashScheme
+	}
+	return HashScheme_HASH_SCHEME_V1
+}
+
+func (m *MsgRegisterIntegrityType) GetDeprecated() bool {
+	if m != nil {
+		return m.Deprecated
+	}
+	return false
+}
+
+// MsgRegisterIntegrityTypeResponse defines the MsgRegisterIntegrityTypeResponse message.
+type MsgRegisterIntegrityTypeResponse struct {
+}
+
+func (m *MsgRegisterIntegrityTypeResponse) Reset()         { *m = MsgRegisterIntegrityTypeResponse{} }
+func (m *MsgRegisterIntegrityTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterIntegrityTypeResponse) ProtoMessage()    {}
+func (*MsgRegisterIntegrityTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{34}
+}
+func (m *MsgRegisterIntegrityTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterIntegrityTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterIntegrityTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterIntegrityTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterIntegrityTypeResponse.Merge(m, src)
+}
+func (m *MsgRegisterIntegrityTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterIntegrityTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterIntegrityTypeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterIntegrityTypeResponse proto.InternalMessageInfo
+
+// MsgRevokeIntegrityRecords marks records of the latest version of a set as revoked without changing the set.
+type MsgRevokeIntegrityRecords struct {
+	Creator    string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant     string   `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Type       string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	Period     string   `protobuf:"bytes,4,opt,name=period,proto3" json:"period,omitempty"`
+	Tags       []string `protobuf:"bytes,5,rep,name=tags,proto3" json:"tags,omitempty"`
+	ReasonCode uint32   `protobuf:"varint,6,opt,name=reason_code,json=reasonCode,proto3" json:"reason_code,omitempty"`
+}
+
+func (m *MsgRevokeIntegrityRecords) Reset()         { *m = MsgRevokeIntegrityRecords{} }
+func (m *MsgRevokeIntegrityRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeIntegrityRecords) ProtoMessage()    {}
+func (*MsgRevokeIntegrityRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{35}
+}
+func (m *MsgRevokeIntegrityRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeIntegrityRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeIntegrityRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeIntegrityRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeIntegrityRecords.Merge(m, src)
+}
+func (m *MsgRevokeIntegrityRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeIntegrityRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeIntegrityRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeIntegrityRecords proto.InternalMessageInfo
+
+func (m *MsgRevokeIntegrityRecords) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevokeIntegrityRecords) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgRevokeIntegrityRecords) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *MsgRevokeIntegrityRecords) GetPeriod() string {
+	if m != nil {
+		return m.Period
+	}
+	return ""
+}
+
+func (m *MsgRevokeIntegrityRecords) GetTags() []string {
+	if m != nil {
+		return m.Tags
+	}
+	return nil
+}
+
+func (m *MsgRevokeIntegrityRecords) GetReasonCode() uint32 {
+	if m != nil {
+		return m.ReasonCode
+	}
+	return 0
+}
+
+// MsgRevokeIntegrityRecordsResponse defines the MsgRevokeIntegrityRecordsResponse message.
+type MsgRevokeIntegrityRecordsResponse struct {
+}
+
+func (m *MsgRevokeIntegrityRecordsResponse) Reset()         { *m = MsgRevokeIntegrityRecordsResponse{} }
+func (m *MsgRevokeIntegrityRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeIntegrityRecordsResponse) ProtoMessage()    {}
+func (*MsgRevokeIntegrityRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{36}
+}
+func (m *MsgRevokeIntegrityRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeIntegrityRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeIntegrityRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeIntegrityRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeIntegrityRecordsResponse.Merge(m, src)
+}
+func (m *MsgRevokeIntegrityRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeIntegrityRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeIntegrityRecordsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeIntegrityRecordsResponse proto.InternalMessageInfo
+
+// MsgFreezeTenant stops every set write of a tenant. The signer is the tenant owner or the module authority.
+type MsgFreezeTenant struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (m *MsgFreezeTenant) Reset()         { *m = MsgFreezeTenant{} }
+func (m *MsgFreezeTenant) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeTenant) ProtoMessage()    {}
+func (*MsgFreezeTenant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{37}
+}
+func (m *MsgFreezeTenant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeTenant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeTenant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeTenant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeTenant.Merge(m, src)
+}
+func (m *MsgFreezeTenant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeTenant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeTenant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeTenant proto.InternalMessageInfo
+
+func (m *MsgFreezeTenant) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgFreezeTenant) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+// MsgFreezeTenantResponse defines the MsgFreezeTenantResponse message.
+type MsgFreezeTenantResponse struct {
+}
+
+func (m *MsgFreezeTenantResponse) Reset()         { *m = MsgFreezeTenantResponse{} }
+func (m *MsgFreezeTenantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeTenantResponse) ProtoMessage()    {}
+func (*MsgFreezeTenantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{38}
+}
+func (m *MsgFreezeTenantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeTenantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeTenantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeTenantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeTenantResponse.Merge(m, src)
+}
+func (m *MsgFreezeTenantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeTenantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeTenantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFreezeTenantResponse proto.InternalMessageInfo
+
+// MsgUnfreezeTenant makes a frozen tenant active again. A freeze by the module authority can only be lifted
+// by the module authority.
+type MsgUnfreezeTenant struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (m *MsgUnfreezeTenant) Reset()         { *m = MsgUnfreezeTenant{} }
+func (m *MsgUnfreezeTenant) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeTenant) ProtoMessage()    {}
+func (*MsgUnfreezeTenant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{39}
+}
+func (m *MsgUnfreezeTenant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeTenant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeTenant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeTenant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeTenant.Merge(m, src)
+}
+func (m *MsgUnfreezeTenant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeTenant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeTenant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeTenant proto.InternalMessageInfo
+
+func (m *MsgUnfreezeTenant) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnfreezeTenant) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+// MsgUnfreezeTenantResponse defines the MsgUnfreezeTenantResponse message.
+type MsgUnfreezeTenantResponse struct {
+}
+
+func (m *MsgUnfreezeTenantResponse) Reset()         { *m = MsgUnfreezeTenantResponse{} }
+func (m *MsgUnfreezeTenantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnfreezeTenantResponse) ProtoMessage()    {}
+func (*MsgUnfreezeTenantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{40}
+}
+func (m *MsgUnfreezeTenantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnfreezeTenantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnfreezeTenantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnfreezeTenantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnfreezeTenantResponse.Merge(m, src)
+}
+func (m *MsgUnfreezeTenantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnfreezeTenantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnfreezeTenantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnfreezeTenantResponse proto.InternalMessageInfo
+
+// MsgArchiveTenant retires a tenant for good. The signer is the tenant owner or the module authority.
+type MsgArchiveTenant struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+}
+
+func (m *MsgArchiveTenant) Reset()         { *m = MsgArchiveTenant{} }
+func (m *MsgArchiveTenant) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveTenant) ProtoMessage()    {}
+func (*MsgArchiveTenant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{41}
+}
+func (m *MsgArchiveTenant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveTenant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveTenant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgArchiveTenant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveTenant.Merge(m, src)
+}
+func (m *MsgArchiveTenant) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveTenant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveTenant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArchiveTenant proto.InternalMessageInfo
+
+func (m *MsgArchiveTenant) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgArchiveTenant) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+// MsgArchiveTenantResponse defines the MsgArchiveTenantResponse message.
+type MsgArchiveTenantResponse struct {
+}
+
+func (m *MsgArchiveTenantResponse) Reset()         { *m = MsgArchiveTenantResponse{} }
+func (m *MsgArchiveTenantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgArchiveTenantResponse) ProtoMessage()    {}
+func (*MsgArchiveTenantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{42}
+}
+func (m *MsgArchiveTenantResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgArchiveTenantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgArchiveTenantResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgArchiveTenantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgArchiveTenantResponse.Merge(m, src)
+}
+func (m *MsgArchiveTenantResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgArchiveTenantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgArchiveTenantResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgArchiveTenantResponse proto.InternalMessageInfo
+
+// MsgSetTenantSigners hands ownership of a tenant to a set of signers with an approval threshold. The signer
+// is the tenant owner, which for a tenant that already has signers means an executed tenant proposal.
+type MsgSetTenantSigners struct {
+	Creator   string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant    string   `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Signers   []string `protobuf:"bytes,3,rep,name=signers,proto3" json:"signers,omitempty"`
+	Threshold uint32   `protobuf:"varint,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *MsgSetTenantSigners) Reset()         { *m = MsgSetTenantSigners{} }
+func (m *MsgSetTenantSigners) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTenantSigners) ProtoMessage()    {}
+func (*MsgSetTenantSigners) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{43}
+}
+func (m *MsgSetTenantSigners) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTenantSigners) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTenantSigners.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTenantSigners) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTenantSigners.Merge(m, src)
+}
+func (m *MsgSetTenantSigners) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTenantSigners) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTenantSigners.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTenantSigners proto.InternalMessageInfo
+
+func (m *MsgSetTenantSigners) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgSetTenantSigners) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgSetTenantSigners) GetSigners() []string {
+	if m != nil {
+		return m.Signers
+	}
+	return nil
+}
+
+func (m *MsgSetTenantSigners) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// MsgSetTenantSignersResponse defines the MsgSetTenantSignersResponse message.
+type MsgSetTenantSignersResponse struct {
+}
+
+func (m *MsgSetTenantSignersResponse) Reset()         { *m = MsgSetTenantSignersResponse{} }
+func (m *MsgSetTenantSignersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetTenantSignersResponse) ProtoMessage()    {}
+func (*MsgSetTenantSignersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{44}
+}
+func (m *MsgSetTenantSignersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetTenantSignersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetTenantSignersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetTenantSignersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetTenantSignersResponse.Merge(m, src)
+}
+func (m *MsgSetTenantSignersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetTenantSignersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetTenantSignersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetTenantSignersResponse proto.InternalMessageInfo
+
+// TenantAction is an owner action that the signers of a tenant can propose. The creator of the wrapped
+// message is ignored and replaced by the tenant owner on execution.
+type TenantAction struct {
+	// Types that are valid to be assigned to Action:
+	//	*TenantAction_CommitIntegritySet
+	//	*TenantAction_SupersedeIntegritySet
+	//	*TenantAction_RevokeIntegrityRecords
+	//	*TenantAction_TransferTenantOwnership
+	//	*TenantAction_CancelTenantOwnershipTransfer
+	//	*TenantAction_SetTenantSigners
+	//	*TenantAction_GrantTenantWriter
+	//	*TenantAction_RevokeTenantWriter
+	//	*TenantAction_FreezeTenant
+	//	*TenantAction_UnfreezeTenant
+	//	*TenantAction_ArchiveTenant
+	//	*TenantAction_SetPeriodFormat
+	//	*TenantAction_RegisterIntegrityType
+	//	*TenantAction_CancelIntegritySet
+	Action isTenantAction_Action `protobuf_oneof:"action"`
+}
+
+func (m *TenantAction) Reset()         { *m = TenantAction{} }
+func (m *TenantAction) String() string { return proto.CompactTextString(m) }
+func (*TenantAction) ProtoMessage()    {}
+func (*TenantAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{45}
+}
+func (m *TenantAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TenantAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TenantAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TenantAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TenantAction.Merge(m, src)
+}
+func (m *TenantAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *TenantAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_TenantAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TenantAction proto.InternalMessageInfo
+
+type isTenantAction_Action interface {
+	isTenantAction_Action()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type TenantAction_CommitIntegritySet struct {
+	CommitIntegritySet *MsgCommitIntegritySet `protobuf:"bytes,1,opt,name=commit_integrity_set,json=commitIntegritySet,proto3,oneof" json:"commit_integrity_set,omitempty"`
+}
+type TenantAction_SupersedeIntegritySet struct {
+	SupersedeIntegritySet *MsgSupersedeIntegritySet `protobuf:"bytes,2,opt,name=supersede_integrity_set,json=supersedeIntegritySet,proto3,oneof" json:"supersede_integrity_set,omitempty"`
+}
+type TenantAction_RevokeIntegrityRecords struct {
+	RevokeIntegrityRecords *MsgRevokeIntegrityRecords `protobuf:"bytes,3,opt,name=revoke_integrity_records,json=revokeIntegrityRecords,proto3,oneof" json:"revoke_integrity_records,omitempty"`
+}
+type TenantAction_TransferTenantOwnership struct {
+	TransferTenantOwnership *MsgTransferTenantOwnership `protobuf:"bytes,4,opt,name=transfer_tenant_ownership,json=transferTenantOwnership,proto3,oneof" json:"transfer_tenant_ownership,omitempty"`
+}
+type TenantAction_CancelTenantOwnershipTransfer struct {
+	CancelTenantOwnershipTransfer *MsgCancelTenantOwnershipTransfer `protobuf:"bytes,5,opt,name=cancel_tenant_ownership_transfer,json=cancelTenantOwnershipTransfer,proto3,oneof" json:"cancel_tenant_ownership_transfer,omitempty"`
+}
+type TenantAction_SetTenantSigners struct {
+	SetTenantSigners *MsgSetTenantSigners `protobuf:"bytes,6,opt,name=set_tenant_signers,json=setTenantSigners,proto3,oneof" json:"set_tenant_signers,omitempty"`
+}
+type TenantAction_GrantTenantWriter struct {
+	GrantTenantWriter *MsgGrantTenantWriter `protobuf:"bytes,7,opt,name=grant_tenant_writer,json=grantTenantWriter,proto3,oneof" json:"grant_tenant_writer,omitempty"`
+}
+type TenantAction_RevokeTenantWriter struct {
+	RevokeTenantWriter *MsgRevokeTenantWriter `protobuf:"bytes,8,opt,name=revoke_tenant_writer,json=revokeTenantWriter,proto3,oneof" json:"revoke_tenant_writer,omitempty"`
+}
+type TenantAction_FreezeTenant struct {
+	FreezeTenant *MsgFreezeTenant `protobuf:"bytes,9,opt,name=freeze_tenant,json=freezeTenant,proto3,oneof" json:"freeze_tenant,omitempty"`
+}
+type TenantAction_UnfreezeTenant struct {
+	UnfreezeTenant *MsgUnfreezeTenant `protobuf:"bytes,10,opt,name=unfreeze_tenant,json=unfreezeTenant,proto3,oneof" json:"unfreeze_tenant,omitempty"`
+}
+type TenantAction_ArchiveTenant struct {
+	ArchiveTenant *MsgArchiveTenant `protobuf:"bytes,11,opt,name=archive_tenant,json=archiveTenant,proto3,oneof" json:"archive_tenant,omitempty"`
+}
+type TenantAction_SetPeriodFormat struct {
+	SetPeriodFormat *MsgSetPeriodFormat `protobuf:"bytes,12,opt,name=set_period_format,json=setPeriodFormat,proto3,oneof" json:"set_period_format,omitempty"`
+}
+type TenantAction_RegisterIntegrityType struct {
+	RegisterIntegrityType *MsgRegisterIntegrityType `protobuf:"bytes,13,opt,name=register_integrity_type,json=registerIntegrityType,proto3,oneof" json:"register_integrity_type,omitempty"`
+}
+type TenantAction_CancelIntegritySet struct {
+	CancelIntegritySet *MsgCancelIntegritySet `protobuf:"bytes,14,opt,name=cancel_integrity_set,json=cancelIntegritySet,proto3,oneof" json:"cancel_integrity_set,omitempty"`
+}
+
+func (*TenantAction_CommitIntegritySet) isTenantAction_Action()            {}
+func (*TenantAction_SupersedeIntegritySet) isTenantAction_Action()         {}
+func (*TenantAction_RevokeIntegrityRecords) isTenantAction_Action()        {}
+func (*TenantAction_TransferTenantOwnership) isTenantAction_Action()       {}
+func (*TenantAction_CancelTenantOwnershipTransfer) isTenantAction_Action() {}
+func (*TenantAction_SetTenantSigners) isTenantAction_Action()              {}
+func (*TenantAction_GrantTenantWriter) isTenantAction_Action()             {}
+func (*TenantAction_RevokeTenantWriter) isTenantAction_Action()            {}
+func (*TenantAction_FreezeTenant) isTenantAction_Action()                  {}
+func (*TenantAction_UnfreezeTenant) isTenantAction_Action()                {}
+func (*TenantAction_ArchiveTenant) isTenantAction_Action()                 {}
+func (*TenantAction_SetPeriodFormat) isTenantAction_Action()               {}
+func (*TenantAction_RegisterIntegrityType) isTenantAction_Action()         {}
+func (*TenantAction_CancelIntegritySet) isTenantAction_Action()            {}
+
+func (m *TenantAction) GetAction() isTenantAction_Action {
+	if m != nil {
+		return m.Action
+	}
+	return nil
+}
+
+func (m *TenantAction) GetCommitIntegritySet() *MsgCommitIntegritySet {
+	if x, ok := m.GetAction().(*TenantAction_CommitIntegritySet); ok {
+		return x.CommitIntegritySet
+	}
+	return nil
+}
+
+func (m *TenantAction) GetSupersedeIntegritySet() *MsgSupersedeIntegritySet {
+	if x, ok := m.GetAction().(*TenantAction_SupersedeIntegritySet); ok {
+		return x.SupersedeIntegritySet
+	}
+	return nil
+}
+
+func (m *TenantAction) GetRevokeIntegrityRecords() *MsgRevokeIntegrityRecords {
+	if x, ok := m.GetAction().(*TenantAction_RevokeIntegrityRecords); ok {
+		return x.RevokeIntegrityRecords
+	}
+	return nil
+}
+
+func (m *TenantAction) GetTransferTenantOwnership() *MsgTransferTenantOwnership {
+	if x, ok := m.GetAction().(*TenantAction_TransferTenantOwnership); ok {
+		return x.TransferTenantOwnership
+	}
+	return nil
+}
+
+func (m *TenantAction) GetCancelTenantOwnershipTransfer() *MsgCancelTenantOwnershipTransfer {
+	if x, ok := m.GetAction().(*TenantAction_CancelTenantOwnershipTransfer); ok {
+		return x.CancelTenantOwnershipTransfer
+	}
+	return nil
+}
+
+func (m *TenantAction) GetSetTenantSigners() *MsgSetTenantSigners {
+	if x, ok := m.GetAction().(*TenantAction_SetTenantSigners); ok {
+		return x.SetTenantSigners
+	}
+	return nil
+}
+
+func (m *TenantAction) GetGrantTenantWriter() *MsgGrantTenantWriter {
+	if x, ok := m.GetAction().(*TenantAction_GrantTenantWriter); ok {
+		return x.GrantTenantWriter
+	}
+	return nil
+}
+
+func (m *TenantAction) GetRevokeTenantWriter() *MsgRevokeTenantWriter {
+	if x, ok := m.GetAction().(*TenantAction_RevokeTenantWriter); ok {
+		return x.RevokeTenantWriter
+	}
+	return nil
+}
+
+func (m *TenantAction) GetFreezeTenant() *MsgFreezeTenant {
+	if x, ok := m.GetAction().(*TenantAction_FreezeTenant); ok {
+		return x.FreezeTenant
+	}
+	return nil
+}
+
+func (m *TenantAction) GetUnfreezeTenant() *MsgUnfreezeTenant {
+	if x, ok := m.GetAction().(*TenantAction_UnfreezeTenant); ok {
+		return x.UnfreezeTenant
+	}
+	return nil
+}
+
+func (m *TenantAction) GetArchiveTenant() *MsgArchiveTenant {
+	if x, ok := m.GetAction().(*TenantAction_ArchiveTenant); ok {
+		return x.ArchiveTenant
+	}
+	return nil
+}
+
+func (m *TenantAction) GetSetPeriodFormat() *MsgSetPeriodFormat {
+	if x, ok := m.GetAction().(*TenantAction_SetPeriodFormat); ok {
+		return x.SetPeriodFormat
+	}
+	return nil
+}
+
+func (m *TenantAction) GetRegisterIntegrityType() *MsgRegisterIntegrityType {
+	if x, ok := m.GetAction().(*TenantAction_RegisterIntegrityType); ok {
+		return x.RegisterIntegrityType
+	}
+	return nil
+}
+
+func (m *TenantAction) GetCancelIntegritySet() *MsgCancelIntegritySet {
+	if x, ok := m.GetAction().(*TenantAction_CancelIntegritySet); ok {
+		return x.CancelIntegritySet
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*TenantAction) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*TenantAction_CommitIntegritySet)(nil),
+		(*TenantAction_SupersedeIntegritySet)(nil),
+		(*TenantAction_RevokeIntegrityRecords)(nil),
+		(*TenantAction_TransferTenantOwnership)(nil),
+		(*TenantAction_CancelTenantOwnershipTransfer)(nil),
+		(*TenantAction_SetTenantSigners)(nil),
+		(*TenantAction_GrantTenantWriter)(nil),
+		(*TenantAction_RevokeTenantWriter)(nil),
+		(*TenantAction_FreezeTenant)(nil),
+		(*TenantAction_UnfreezeTenant)(nil),
+		(*TenantAction_ArchiveTenant)(nil),
+		(*TenantAction_SetPeriodFormat)(nil),
+		(*TenantAction_RegisterIntegrityType)(nil),
+		(*TenantAction_CancelIntegritySet)(nil),
+	}
+}
+
+// MsgProposeTenantAction opens a tenant proposal. The signer must be one of the tenant signers and counts as
+// its first approval.
+type MsgProposeTenantAction struct {
+	Creator string       `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant  string       `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	Action  TenantAction `protobuf:"bytes,3,opt,name=action,proto3" json:"action"`
+}
+
+func (m *MsgProposeTenantAction) Reset()         { *m = MsgProposeTenantAction{} }
+func (m *MsgProposeTenantAction) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeTenantAction) ProtoMessage()    {}
+func (*MsgProposeTenantAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{46}
+}
+func (m *MsgProposeTenantAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeTenantAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeTenantAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeTenantAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeTenantAction.Merge(m, src)
+}
+func (m *MsgProposeTenantAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeTenantAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeTenantAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeTenantAction proto.InternalMessageInfo
+
+func (m *MsgProposeTenantAction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgProposeTenantAction) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgProposeTenantAction) GetAction() TenantAction {
+	if m != nil {
+		return m.Action
+	}
+	return TenantAction{}
+}
+
+// MsgProposeTenantActionResponse defines the MsgProposeTenantActionResponse message.
+type MsgProposeTenantActionResponse struct {
+	ProposalId uint64 `protobuf:"varint,1,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgProposeTenantActionResponse) Reset()         { *m = MsgProposeTenantActionResponse{} }
+func (m *MsgProposeTenantActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeTenantActionResponse) ProtoMessage()    {}
+func (*MsgProposeTenantActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{47}
+}
+func (m *MsgProposeTenantActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeTenantActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeTenantActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeTenantActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeTenantActionResponse.Merge(m, src)
+}
+func (m *MsgProposeTenantActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeTenantActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeTenantActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeTenantActionResponse proto.InternalMessageInfo
+
+func (m *MsgProposeTenantActionResponse) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgApproveTenantAction adds the approval of a tenant signer to an open tenant proposal.
+type MsgApproveTenantAction struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant     string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ProposalId uint64 `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgApproveTenantAction) Reset()         { *m = MsgApproveTenantAction{} }
+func (m *MsgApproveTenantAction) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveTenantAction) ProtoMessage()    {}
+func (*MsgApproveTenantAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{48}
+}
+func (m *MsgApproveTenantAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveTenantAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveTenantAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveTenantAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveTenantAction.Merge(m, src)
+}
+func (m *MsgApproveTenantAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveTenantAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveTenantAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveTenantAction proto.InternalMessageInfo
+
+func (m *MsgApproveTenantAction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgApproveTenantAction) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgApproveTenantAction) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgApproveTenantActionResponse defines the MsgApproveTenantActionResponse message.
+type MsgApproveTenantActionResponse struct {
+}
+
+func (m *MsgApproveTenantActionResponse) Reset()         { *m = MsgApproveTenantActionResponse{} }
+func (m *MsgApproveTenantActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgApproveTenantActionResponse) ProtoMessage()    {}
+func (*MsgApproveTenantActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{49}
+}
+func (m *MsgApproveTenantActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgApproveTenantActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgApproveTenantActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgApproveTenantActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgApproveTenantActionResponse.Merge(m, src)
+}
+func (m *MsgApproveTenantActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgApproveTenantActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgApproveTenantActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgApproveTenantActionResponse proto.InternalMessageInfo
+
+// MsgExecuteTenantAction runs the action of a tenant proposal once threshold signers have approved it. The
+// signer must be one of the tenant signers.
+type MsgExecuteTenantAction struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Tenant     string `protobuf:"bytes,2,opt,name=tenant,proto3" json:"tenant,omitempty"`
+	ProposalId uint64 `protobuf:"varint,3,opt,name=proposal_id,json=proposalId,proto3" json:"proposal_id,omitempty"`
+}
+
+func (m *MsgExecuteTenantAction) Reset()         { *m = MsgExecuteTenantAction{} }
+func (m *MsgExecuteTenantAction) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteTenantAction) ProtoMessage()    {}
+func (*MsgExecuteTenantAction) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{50}
+}
+func (m *MsgExecuteTenantAction) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteTenantAction) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteTenantAction.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteTenantAction) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteTenantAction.Merge(m, src)
+}
+func (m *MsgExecuteTenantAction) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteTenantAction) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteTenantAction.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteTenantAction proto.InternalMessageInfo
+
+func (m *MsgExecuteTenantAction) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgExecuteTenantAction) GetTenant() string {
+	if m != nil {
+		return m.Tenant
+	}
+	return ""
+}
+
+func (m *MsgExecuteTenantAction) GetProposalId() uint64 {
+	if m != nil {
+		return m.ProposalId
+	}
+	return 0
+}
+
+// MsgExecuteTenantActionResponse defines the MsgExecuteTenantActionResponse message.
+type MsgExecuteTenantActionResponse struct {
+}
+
+func (m *MsgExecuteTenantActionResponse) Reset()         { *m = MsgExecuteTenantActionResponse{} }
+func (m *MsgExecuteTenantActionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgExecuteTenantActionResponse) ProtoMessage()    {}
+func (*MsgExecuteTenantActionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52ea9faa3d85ec16, []int{51}
+}
+func (m *MsgExecuteTenantActionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgExecuteTenantActionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgExecuteTenantActionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgExecuteTenantActionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgExecuteTenantActionResponse.Merge(m, src)
+}
+func (m *MsgExecuteTenantActionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgExecuteTenantActionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgExecuteTenantActionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgExecuteTenantActionResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "kudora.integrity.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kudora.integrity.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRegisterTenant)(nil), "kudora.integrity.v1.MsgRegisterTenant")
+	proto.RegisterType((*MsgRegisterTenantResponse)(nil), "kudora.integrity.v1.MsgRegisterTenantResponse")
+	proto.RegisterType((*MsgTransferTenantOwnership)(nil), "kudora.integrity.v1.MsgTransferTenantOwnership")
+	proto.RegisterType((*MsgTransferTenantOwnershipResponse)(nil), "kudora.integrity.v1.MsgTransferTenantOwnershipResponse")
+	proto.RegisterType((*MsgAcceptTenantOwnership)(nil), "kudora.integrity.v1.MsgAcceptTenantOwnership")
+	proto.RegisterType((*MsgAcceptTenantOwnershipResponse)(nil), "kudora.integrity.v1.MsgAcceptTenantOwnershipResponse")
+	proto.RegisterType((*MsgCancelTenantOwnershipTransfer)(nil), "kudora.integrity.v1.MsgCancelTenantOwnershipTransfer")
+	proto.RegisterType((*MsgCancelTenantOwnershipTransferResponse)(nil), "kudora.integrity.v1.MsgCancelTenantOwnershipTransferResponse")
+	proto.RegisterType((*MsgCommitIntegritySet)(nil), "kudora.integrity.v1.MsgCommitIntegritySet")
+	proto.RegisterType((*MsgCommitIntegritySetResponse)(nil), "kudora.integrity.v1.MsgCommitIntegritySetResponse")
+	proto.RegisterType((*IntegritySetPayload)(nil), "kudora.integrity.v1.IntegritySetPayload")
+	proto.RegisterType((*MsgCommitIntegritySets)(nil), "kudora.integrity.v1.MsgCommitIntegritySets")
+	proto.RegisterType((*MsgCommitIntegritySetsResponse)(nil), "kudora.integrity.v1.MsgCommitIntegritySetsResponse")
+	proto.RegisterType((*MsgGrantTenantWriter)(nil), "kudora.integrity.v1.MsgGrantTenantWriter")
+	proto.RegisterType((*MsgGrantTenantWriterResponse)(nil), "kudora.integrity.v1.MsgGrantTenantWriterResponse")
+	proto.RegisterType((*MsgRevokeTenantWriter)(nil), "kudora.integrity.v1.MsgRevokeTenantWriter")
+	proto.RegisterType((*MsgRevokeTenantWriterResponse)(nil), "kudora.integrity.v1.MsgRevokeTenantWriterResponse")
+	proto.RegisterType((*MsgSupersedeIntegritySet)(nil), "kudora.integrity.v1.MsgSupersedeIntegritySet")
+	proto.RegisterType((*MsgSupersedeIntegritySetResponse)(nil), "kudora.integrity.v1.MsgSupersedeIntegritySetResponse")
+	proto.RegisterType((*MsgOpenIntegritySet)(nil), "kudora.integrity.v1.MsgOpenIntegritySet")
+	proto.RegisterType((*MsgOpenIntegritySetResponse)(nil), "kudora.integrity.v1.MsgOpenIntegritySetResponse")
+	proto.RegisterType((*MsgAppendIntegrityRecords)(nil), "kudora.integrity.v1.MsgAppendIntegrityRecords")
+	proto.RegisterType((*MsgAppendIntegrityRecordsResponse)(nil), "kudora.integrity.v1.MsgAppendIntegrityRecordsResponse")
+	proto.RegisterType((*MsgSealIntegritySet)(nil), "kudora.integrity.v1.MsgSealIntegritySet")
+	proto.RegisterType((*MsgSealIntegritySetResponse)(nil), "kudora.integrity.v1.MsgSealIntegritySetResponse")
+	proto.RegisterType((*MsgCancelIntegritySet)(nil), "kudora.integrity.v1.MsgCancelIntegritySet")
+	proto.RegisterType((*MsgCancelIntegritySetResponse)(nil), "kudora.integrity.v1.MsgCancelIntegritySetResponse")
+	proto.RegisterType((*MsgAnchorIntegrityRoot)(nil), "kudora.integrity.v1.MsgAnchorIntegrityRoot")
+	proto.RegisterType((*MsgAnchorIntegrityRootResponse)(nil), "kudora.integrity.v1.MsgAnchorIntegrityRootResponse")
+	proto.RegisterType((*MsgSetPeriodFormat)(nil), "kudora.integrity.v1.MsgSetPeriodFormat")
+	proto.RegisterType((*MsgSetPeriodFormatResponse)(nil), "kudora.integrity.v1.MsgSetPeriodFormatResponse")
+	proto.RegisterType((*MsgRegisterIntegrityType)(nil), "kudora.integrity.v1.MsgRegisterIntegrityType")
+	proto.RegisterType((*MsgRegisterIntegrityTypeResponse)(nil), "kudora.integrity.v1.MsgRegisterIntegrityTypeResponse")
+	proto.RegisterType((*MsgRevokeIntegrityRecords)(nil), "kudora.integrity.v1.MsgRevokeIntegrityRecords")
+	proto.RegisterType((*MsgRevokeIntegrityRecordsResponse)(nil), "kudora.integrity.v1.MsgRevokeIntegrityRecordsResponse")
+	proto.RegisterType((*MsgFreezeTenant)(nil), "kudora.integrity.v1.MsgFreezeTenant")
+	proto.RegisterType((*MsgFreezeTenantResponse)(nil), "kudora.integrity.v1.MsgFreezeTenantResponse")
+	proto.RegisterType((*MsgUnfreezeTenant)(nil), "kudora.integrity.v1.MsgUnfreezeTenant")
+	proto.RegisterType((*MsgUnfreezeTenantResponse)(nil), "kudora.integrity.v1.MsgUnfreezeTenantResponse")
+	proto.RegisterType((*MsgArchiveTenant)(nil), "kudora.integrity.v1.MsgArchiveTenant")
+	proto.RegisterType((*MsgArchiveTenantResponse)(nil), "kudora.integrity.v1.MsgArchiveTenantResponse")
+	proto.RegisterType((*MsgSetTenantSigners)(nil), "kudora.integrity.v1.MsgSetTenantSigners")
+	proto.RegisterType((*MsgSetTenantSignersResponse)(nil), "kudora.integrity.v1.MsgSetTenantSignersResponse")
+	proto.RegisterType((*TenantAction)(nil), "kudora.integrity.v1.TenantAction")
+	proto.RegisterType((*MsgProposeTenantAction)(nil), "kudora.integrity.v1.MsgProposeTenantAction")
+	proto.RegisterType((*MsgProposeTenantActionResponse)(nil), "kudora.integrity.v1.MsgProposeTenantActionResponse")
+	proto.RegisterType((*MsgApproveTenantAction)(nil), "kudora.integrity.v1.MsgApproveTenantAction")
+	proto.RegisterType((*MsgApproveTenantActionResponse)(nil), "kudora.integrity.v1.MsgApproveTenantActionResponse")
+	proto.RegisterType((*MsgExecuteTenantAction)(nil), "kudora.integrity.v1.MsgExecuteTenantAction")
+	proto.RegisterType((*MsgExecuteTenantActionResponse)(nil), "kudora.integrity.v1.MsgExecuteTenantActionResponse")
+}
+
+func init() { proto.RegisterFile("kudora/integrity/v1/tx.proto", fileDescriptor_52ea9faa3d85ec16) }
+
+var fileDescriptor_52ea9faa3d85ec16 = []byte{
+	// 2399 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x9f, 0xf6, 0xd8, 0x63, 0xcf, 0x1b, 0x8f, 0x93, 0x74, 0x3e, 0x3c, 0xe9, 0x24, 0x93, 0xc9,
+	0xe4, 0x6b, 0xd6, 0x89, 0x3d, 0xc9, 0x58, 0x0e, 0xb0, 0x62, 0x01, 0x4f, 0x96, 0x30, 0x11, 0x58,
+	0x1b, 0xb5, 0x13, 0x21, 0xed, 0x65, 0xd4, 0xe9, 0xa9, 0xf4, 0xb4, 0xe2, 0xe9, 0x6e, 0xaa, 0x7a,
+	0x6c, 0x07, 0x2d, 0xd2, 0x02, 0x42, 0x08, 0x24, 0x24, 0x24, 0xc4, 0x81, 0xc3, 0x82, 0x10, 0x07,
+	0x02, 0x17, 0xa2, 0x85, 0x13, 0x12, 0x12, 0xc7, 0x3d, 0xae, 0x38, 0x20, 0x4e, 0x08, 0x25, 0x02,
+	0xff, 0x01, 0x88, 0x3b, 0xaa, 0xea, 0xee, 0x72, 0x7f, 0x54, 0x8f, 0x7b, 0xc2, 0x98, 0xb5, 0xf6,
+	0x62, 0xb9, 0xab, 0x7e, 0x55, 0xef, 0x57, 0xf5, 0x5e, 0xbd, 0xf7, 0xea, 0xd5, 0xc0, 0xf9, 0xa7,
+	0xc3, 0x9e, 0x8d, 0xb5, 0xa6, 0x69, 0xb9, 0xc8, 0xc0, 0xa6, 0xfb, 0xac, 0xb9, 0x7d, 0xbb, 0xe9,
+	0xee, 0xae, 0x38, 0xd8, 0x76, 0x6d, 0xf9, 0xa4, 0xd7, 0xbb, 0xc2, 0x7b, 0x57, 0xb6, 0x6f, 0x2b,
+	0x27, 0xb4, 0x81, 0x69, 0xd9, 0x4d, 0xf6, 0xd7, 0xc3, 0x29, 0x8b, 0xba, 0x4d, 0x06, 0x36, 0x69,
+	0x0e, 0x88, 0x41, 0xc7, 0x0f, 0x88, 0xe1, 0x77, 0x9c, 0xf5, 0x3a, 0xba, 0xec, 0xab, 0xe9, 0x7d,
+	0xf8, 0x5d, 0xa7, 0x0c, 0xdb, 0xb0, 0xbd, 0x76, 0xfa, 0x9f, 0xdf, 0xba, 0x24, 0xe2, 0xc3, 0x3f,
+	0xba, 0x18, 0xe9, 0x36, 0xee, 0xf9, 0xd8, 0xeb, 0xa3, 0xb1, 0x04, 0xb9, 0x3e, 0xb0, 0x31, 0x1a,
+	0xe8, 0x3e, 0x73, 0x90, 0x8f, 0xac, 0x89, 0x90, 0x8e, 0x86, 0xb5, 0x01, 0x19, 0x25, 0xd4, 0x41,
+	0xd8, 0xb4, 0x7b, 0xdd, 0x27, 0x36, 0x1e, 0x68, 0xbe, 0xd0, 0xfa, 0x9f, 0x25, 0x38, 0xb6, 0x41,
+	0x8c, 0x47, 0x4e, 0x4f, 0x73, 0xd1, 0x03, 0x36, 0x85, 0x7c, 0x07, 0x8a, 0xda, 0xd0, 0xed, 0xdb,
+	0x74, 0x5c, 0x45, 0xaa, 0x49, 0x8d, 0x62, 0xbb, 0xf2, 0x97, 0x3f, 0x2c, 0x9f, 0xf2, 0x37, 0x66,
+	0xbd, 0xd7, 0xc3, 0x88, 0x90, 0x4d, 0x17, 0x9b, 0x96, 0xa1, 0xee, 0x43, 0xe5, 0x2f, 0x40, 0xc1,
+	0x23, 0x51, 0x99, 0xaa, 0x49, 0x8d, 0x52, 0xeb, 0xdc, 0x8a, 0x40, 0x31, 0x2b, 0x9e, 0x90, 0x76,
+	0xf1, 0xa3, 0xbf, 0x5f, 0xcc, 0x3d, 0xdf, 0x7b, 0xb1, 0x24, 0xa9, 0xfe, 0xa8, 0x37, 0xd7, 0xbe,
+	0xb3, 0xf7, 0x62, 0x69, 0x7f, 0xbe, 0x1f, 0xee, 0xbd, 0x58, 0xaa, 0xfb, 0xeb, 0xd8, 0x0d, 0xad,
+	0x24, 0x46, 0xb7, 0x7e, 0x16, 0x16, 0x63, 0x4d, 0x2a, 0x22, 0x8e, 0x6d, 0x11, 0x54, 0x1f, 0xc0,
+	0x89, 0x0d, 0x62, 0xa8, 0xc8, 0x30, 0x89, 0x8b, 0xf0, 0x43, 0x64, 0x69, 0x96, 0x2b, 0xb7, 0x60,
+	0x56, 0xc7, 0x48, 0x73, 0x6d, 0x7c, 0xe0, 0xe2, 0x02, 0xa0, 0x7c, 0x06, 0x0a, 0x2e, 0x1b, 0xcd,
+	0x96, 0x56, 0x54, 0xfd, 0xaf, 0x37, 0xe7, 0x29, 0xe5, 0x00, 0x55, 0x3f, 0x07, 0x67, 0x13, 0xe2,
+	0x38, 0x97, 0x7f, 0x4b, 0xa0, 0x6c, 0x10, 0xe3, 0x21, 0xd6, 0x2c, 0xf2, 0x24, 0xe8, 0x7d, 0x67,
+	0xc7, 0x42, 0x98, 0xf4, 0x4d, 0x67, 0x92, 0xac, 0xe4, 0x35, 0x28, 0x5a, 0x68, 0xa7, 0x6b, 0xd3,
+	0xc9, 0x2b, 0xf9, 0x03, 0x66, 0x9b, 0xb3, 0xd0, 0x0e, 0xa3, 0x21, 0x5f, 0x00, 0x40, 0xbb, 0x8e,
+	0x89, 0x11, 0xe9, 0x6a, 0x6e, 0x65, 0x9a, 0x4d, 0x59, 0xf4, 0x5b, 0xd6, 0x5d, 0xf9, 0x2a, 0x2c,
+	0x04, 0xdd, 0x7d, 0x64, 0x1a, 0x7d, 0xb7, 0x32, 0x53, 0x93, 0x1a, 0xd3, 0x6a, 0xd9, 0x6f, 0xed,
+	0xb0, 0xc6, 0xd8, 0x96, 0x5c, 0x81, 0x7a, 0xfa, 0xa2, 0xf9, 0xde, 0xb8, 0x50, 0xd9, 0x20, 0xc6,
+	0xba, 0xae, 0x23, 0xc7, 0x3d, 0xc4, 0x8d, 0x89, 0x71, 0xab, 0x43, 0x2d, 0x4d, 0x2a, 0x67, 0xf6,
+	0x1e, 0xc3, 0xdc, 0xd5, 0x2c, 0x1d, 0x6d, 0xc5, 0x30, 0xc1, 0xa2, 0x0e, 0x91, 0xe1, 0x12, 0x34,
+	0x0e, 0x92, 0xce, 0x99, 0xfe, 0x26, 0x0f, 0xa7, 0x29, 0xd8, 0x1e, 0x0c, 0x4c, 0xf7, 0x7e, 0x70,
+	0x5a, 0x36, 0xd1, 0x44, 0x0d, 0x5e, 0x96, 0x61, 0x9a, 0x3a, 0x22, 0xcf, 0xaa, 0x54, 0xf6, 0x3f,
+	0xc5, 0x7a, 0xae, 0xc5, 0xb7, 0x19, 0xff, 0x8b, 0x62, 0xb1, 0x6d, 0x7b, 0x66, 0x52, 0x54, 0xd9,
+	0xff, 0xf2, 0xdb, 0x30, 0xeb, 0x79, 0x47, 0x52, 0x29, 0xd4, 0xf2, 0x8d, 0x52, 0xeb, 0x8a, 0xd0,
+	0x49, 0x70, 0xfe, 0x2a, 0x03, 0xb7, 0xa7, 0xa9, 0xb7, 0x50, 0x83, 0xa1, 0xf2, 0x97, 0xa0, 0xd4,
+	0xd7, 0x48, 0xbf, 0x4b, 0xf4, 0x3e, 0x1a, 0xa0, 0xca, 0x6c, 0x4d, 0x6a, 0x2c, 0xb4, 0x2e, 0x0a,
+	0x67, 0xea, 0x68, 0xa4, 0xbf, 0xc9, 0x60, 0x2a, 0xf4, 0xf9, 0xff, 0xf2, 0x7d, 0x58, 0x60, 0x33,
+	0x68, 0x5b, 0x06, 0x75, 0x36, 0xfd, 0x41, 0x65, 0x8e, 0x4d, 0x52, 0x4f, 0x9d, 0x64, 0x3d, 0x40,
+	0xaa, 0xe5, 0x7e, 0xf8, 0x53, 0xbe, 0x0c, 0x65, 0x07, 0xa3, 0x6d, 0xd3, 0x1e, 0x92, 0x2e, 0x5b,
+	0x6f, 0x91, 0xad, 0x77, 0x3e, 0x68, 0x54, 0x6d, 0x3b, 0xae, 0xd7, 0x8b, 0x70, 0x41, 0xa8, 0x2a,
+	0xae, 0xcc, 0x7f, 0x4e, 0xc1, 0xc9, 0x70, 0xc7, 0x03, 0xed, 0xd9, 0x96, 0xad, 0xf5, 0x42, 0x6a,
+	0x91, 0x84, 0x6a, 0x99, 0x12, 0xaa, 0x25, 0x2f, 0x54, 0xcb, 0xb4, 0x58, 0x2d, 0x33, 0x13, 0x53,
+	0x4b, 0x61, 0x12, 0x6a, 0x99, 0x9d, 0x98, 0x5a, 0xe6, 0x92, 0x6a, 0xa9, 0xff, 0x5c, 0x82, 0x33,
+	0x42, 0x4d, 0x90, 0xd7, 0x3a, 0x35, 0x6d, 0x98, 0x26, 0xc8, 0xa5, 0xf1, 0x8f, 0xee, 0x61, 0x63,
+	0xf4, 0x1e, 0xee, 0xab, 0xd5, 0xdf, 0x47, 0x36, 0x36, 0x66, 0x29, 0x6f, 0x41, 0x55, 0xcc, 0x2f,
+	0x30, 0x15, 0xf9, 0x1c, 0x14, 0x09, 0x72, 0xbb, 0xba, 0x3d, 0xf4, 0xad, 0x62, 0x5a, 0x9d, 0x23,
+	0xc8, 0xbd, 0x4b, 0xbf, 0xeb, 0xff, 0x92, 0xe0, 0xd4, 0x06, 0x31, 0xbe, 0x82, 0x35, 0xcb, 0x77,
+	0x71, 0x5f, 0xc7, 0xa6, 0x3b, 0x59, 0x9f, 0x25, 0xdf, 0x82, 0xc2, 0x0e, 0x9b, 0xf5, 0xc0, 0x58,
+	0xe3, 0xe3, 0xa8, 0x6e, 0xa8, 0x89, 0x76, 0x1d, 0x8c, 0x9e, 0x98, 0xbb, 0x88, 0x54, 0xa6, 0x6b,
+	0x79, 0xaa, 0x1b, 0xda, 0xf8, 0xc0, 0x6f, 0x8b, 0x85, 0xa3, 0x99, 0x58, 0x38, 0x8a, 0xed, 0x53,
+	0x15, 0xce, 0x8b, 0xd6, 0xc9, 0x0f, 0xd4, 0xaf, 0x24, 0xe6, 0x1d, 0x55, 0xb4, 0x6d, 0x3f, 0x45,
+	0x47, 0x67, 0x27, 0x84, 0x7e, 0x21, 0x49, 0x92, 0x2f, 0xe3, 0x79, 0x9e, 0x45, 0xca, 0xcd, 0xa1,
+	0x83, 0x30, 0x41, 0x3d, 0xf4, 0x29, 0xf7, 0xf3, 0x17, 0xa1, 0x84, 0x91, 0x46, 0x6c, 0xab, 0x4b,
+	0xcf, 0x36, 0xf3, 0x05, 0x45, 0x15, 0xbc, 0x26, 0x7a, 0xf8, 0xe3, 0x1e, 0x67, 0x6e, 0x12, 0x1e,
+	0xa7, 0xf8, 0x9a, 0x1e, 0x27, 0xa6, 0xcb, 0xcf, 0x43, 0x2d, 0x4d, 0x53, 0xfc, 0xec, 0x56, 0x60,
+	0x76, 0x1b, 0x61, 0x62, 0xda, 0x96, 0x7f, 0x72, 0x83, 0xcf, 0xfa, 0xf3, 0x29, 0x38, 0xb9, 0x41,
+	0x8c, 0x77, 0x1c, 0x64, 0x1d, 0x09, 0x1d, 0xc7, 0x36, 0x7a, 0x66, 0x12, 0x1b, 0x5d, 0x98, 0xcc,
+	0x46, 0x5f, 0x80, 0x73, 0x82, 0x9d, 0xe2, 0x47, 0x66, 0x4f, 0x62, 0x59, 0xf9, 0xba, 0xe3, 0x20,
+	0xab, 0x17, 0xb3, 0x37, 0xf2, 0x89, 0xed, 0xe7, 0x44, 0x02, 0x6e, 0x6c, 0x23, 0xde, 0x85, 0x4b,
+	0xa9, 0x0b, 0xe5, 0x26, 0x17, 0x1c, 0x56, 0x29, 0x74, 0x58, 0x2f, 0xc1, 0xbc, 0x37, 0xa3, 0x1f,
+	0x45, 0xa6, 0x98, 0x2d, 0x96, 0xbc, 0x36, 0x2f, 0x90, 0xfc, 0x4e, 0x62, 0xf6, 0xb8, 0x89, 0xb4,
+	0xad, 0xa3, 0xea, 0x73, 0x84, 0x66, 0x11, 0x27, 0xcc, 0xcd, 0xe2, 0x03, 0x2f, 0x20, 0x78, 0xb9,
+	0xf5, 0x51, 0x58, 0x92, 0x38, 0x45, 0x4c, 0xd0, 0xe3, 0x0b, 0xf8, 0x6d, 0x9e, 0xa5, 0x2e, 0xeb,
+	0x96, 0xde, 0xb7, 0xf1, 0xbe, 0xba, 0x6d, 0xfb, 0x13, 0x5b, 0x81, 0x30, 0x10, 0xc4, 0x6d, 0xab,
+	0x90, 0xb0, 0x2d, 0xea, 0xe5, 0x89, 0x6b, 0x63, 0xcd, 0x40, 0xdd, 0x21, 0x36, 0x03, 0x2f, 0xef,
+	0x37, 0x3d, 0xc2, 0x26, 0xbd, 0x79, 0x06, 0x80, 0x9e, 0x69, 0x20, 0x12, 0xe4, 0x72, 0x65, 0xbf,
+	0xf5, 0x6d, 0xd6, 0x18, 0xf7, 0x51, 0xc5, 0x49, 0xf8, 0x28, 0x98, 0x8c, 0x8f, 0xaa, 0x41, 0x55,
+	0xac, 0x2b, 0xae, 0xce, 0x3f, 0x49, 0x20, 0x33, 0x7b, 0x75, 0x1f, 0xb0, 0xcd, 0xbc, 0xc7, 0xaa,
+	0x34, 0x87, 0xae, 0xca, 0xcf, 0x41, 0xc1, 0xab, 0x07, 0x31, 0x55, 0x2e, 0xb4, 0x2e, 0x89, 0x6b,
+	0x36, 0x21, 0x4a, 0xaa, 0x3f, 0x20, 0xb6, 0xc2, 0xf3, 0xa0, 0x24, 0xe9, 0xf3, 0xd5, 0xfd, 0x67,
+	0x0a, 0x2a, 0xa1, 0xd2, 0x08, 0xdf, 0x82, 0x87, 0x94, 0xc3, 0x61, 0xaf, 0xb1, 0x06, 0xa5, 0x1e,
+	0x22, 0x3a, 0x36, 0x1d, 0x97, 0x46, 0x5a, 0xcf, 0x66, 0xc3, 0x4d, 0xcc, 0x02, 0xa9, 0x05, 0x68,
+	0x5e, 0x9e, 0x31, 0xe3, 0x5b, 0x20, 0x6b, 0x62, 0x79, 0xc6, 0x3d, 0x28, 0x47, 0xaa, 0x67, 0x95,
+	0x42, 0xd6, 0xdd, 0x9a, 0x77, 0xc2, 0xea, 0xfc, 0xdf, 0x2f, 0xae, 0x55, 0x80, 0x1e, 0x72, 0x30,
+	0xd2, 0x35, 0x17, 0xf5, 0xd8, 0x39, 0x98, 0x53, 0x43, 0x2d, 0xc2, 0x12, 0x87, 0x70, 0xdb, 0xb9,
+	0x6e, 0xfe, 0x2a, 0xc1, 0x59, 0x9e, 0x75, 0x1e, 0x99, 0x00, 0x49, 0xb1, 0x9a, 0xe1, 0x45, 0x47,
+	0x8a, 0xd5, 0x8c, 0x70, 0x3a, 0xa8, 0xdb, 0x3d, 0xef, 0x7e, 0x59, 0x0e, 0xd2, 0xc1, 0xbb, 0x76,
+	0x0f, 0xc5, 0x16, 0x7f, 0x19, 0x2e, 0xa5, 0xae, 0x8b, 0xaf, 0xfe, 0x29, 0xab, 0x7f, 0xde, 0xc3,
+	0x08, 0x7d, 0x13, 0x1d, 0x7a, 0x81, 0xd0, 0x2b, 0x55, 0x86, 0x85, 0xc5, 0x4a, 0x95, 0x8f, 0xac,
+	0x27, 0xff, 0x1f, 0x26, 0x5e, 0xa9, 0x32, 0x2a, 0x8e, 0x73, 0xd9, 0x82, 0xe3, 0xd4, 0x5b, 0x61,
+	0xbd, 0x6f, 0x6e, 0x1f, 0x3e, 0x15, 0x05, 0x2a, 0x71, 0x69, 0x9c, 0xc9, 0xaf, 0x83, 0xb4, 0xc3,
+	0xbf, 0xd5, 0x6d, 0x9a, 0x86, 0x85, 0xf0, 0x64, 0xad, 0xb2, 0x02, 0xb3, 0xc4, 0x9b, 0xb6, 0x92,
+	0x67, 0xc6, 0x16, 0x7c, 0xca, 0xe7, 0xa1, 0xe8, 0xf6, 0x31, 0x22, 0x7d, 0x7b, 0xcb, 0x33, 0xcf,
+	0xb2, 0xba, 0xdf, 0x90, 0x92, 0x6e, 0x44, 0x89, 0xf2, 0x85, 0x7c, 0xff, 0x38, 0xcc, 0x7b, 0x3d,
+	0xeb, 0x3a, 0x73, 0x39, 0x3f, 0x92, 0xe0, 0x94, 0xce, 0xae, 0xf5, 0xdd, 0xc8, 0x63, 0x00, 0x5b,
+	0x4f, 0xa9, 0xb5, 0x24, 0xf4, 0x09, 0xc2, 0x52, 0x40, 0x7b, 0xf9, 0xc3, 0xbd, 0x17, 0x4b, 0x0d,
+	0x71, 0xa5, 0x3c, 0x09, 0xef, 0xe4, 0x54, 0x59, 0x4f, 0xb4, 0xca, 0x3f, 0x93, 0x60, 0x91, 0x04,
+	0x97, 0x95, 0x18, 0x25, 0xaf, 0x9c, 0xbf, 0x9c, 0x46, 0x49, 0x78, 0xc7, 0x69, 0xdf, 0xa2, 0xac,
+	0x6e, 0x88, 0x59, 0x09, 0x47, 0x74, 0x72, 0xea, 0x69, 0x22, 0xea, 0x90, 0x3f, 0x90, 0xa0, 0x82,
+	0xd9, 0x31, 0xee, 0xc6, 0x1f, 0x59, 0x08, 0x73, 0x26, 0xa5, 0xd6, 0x4a, 0x1a, 0x39, 0xf1, 0xf1,
+	0x6f, 0xdf, 0xa6, 0xec, 0x6e, 0x8a, 0xd9, 0x89, 0x87, 0x74, 0x72, 0xea, 0x19, 0x2c, 0xec, 0x91,
+	0x7f, 0x29, 0xc1, 0x59, 0xd7, 0xaf, 0xc7, 0x76, 0x3d, 0xab, 0xea, 0xda, 0x41, 0xa1, 0x96, 0x19,
+	0x4e, 0xa9, 0xd5, 0x4c, 0x23, 0x98, 0x52, 0x1b, 0x6f, 0xb7, 0x28, 0xc3, 0x65, 0x31, 0xc3, 0x94,
+	0x31, 0x9d, 0x9c, 0xba, 0xe8, 0x8a, 0xbb, 0xe4, 0x3f, 0x4a, 0x50, 0xd3, 0x59, 0x36, 0x99, 0x60,
+	0xd8, 0x0d, 0xc6, 0xb0, 0xc0, 0x57, 0x6a, 0xad, 0xa5, 0xda, 0xde, 0xa8, 0x42, 0x74, 0xfb, 0xb3,
+	0x94, 0xf0, 0x6a, 0x8a, 0x19, 0x8e, 0x1a, 0xd9, 0xc9, 0xa9, 0x17, 0xf4, 0x51, 0x00, 0xf9, 0x7b,
+	0x12, 0xc8, 0xb4, 0xc8, 0xe5, 0x33, 0x0f, 0x8e, 0x6b, 0xa1, 0x26, 0xa5, 0x96, 0xd9, 0x04, 0x87,
+	0xb1, 0x7d, 0x83, 0x32, 0xbc, 0x96, 0x62, 0x92, 0x31, 0x70, 0x27, 0xa7, 0x1e, 0x27, 0xb1, 0x36,
+	0xf9, 0x07, 0x12, 0x9c, 0x34, 0xb0, 0x66, 0x71, 0x26, 0x7e, 0xb5, 0x67, 0x96, 0x11, 0x79, 0x23,
+	0x8d, 0x48, 0xa2, 0x2c, 0xd5, 0xbe, 0x49, 0x99, 0x5c, 0x17, 0x33, 0x49, 0xa0, 0x3b, 0x39, 0xf5,
+	0x84, 0x11, 0x6f, 0x64, 0x0e, 0xc4, 0x3f, 0x14, 0x51, 0x32, 0x73, 0xa3, 0x1d, 0x48, 0xb2, 0xba,
+	0x34, 0xd2, 0x81, 0x24, 0xe1, 0xd4, 0x81, 0xe0, 0x44, 0xab, 0xec, 0x42, 0xd9, 0x0b, 0x26, 0x3e,
+	0x1d, 0x96, 0x7f, 0xa7, 0xdd, 0x6b, 0x63, 0x51, 0xb0, 0x7d, 0xfd, 0xc3, 0xf4, 0xc7, 0xbe, 0x30,
+	0xb0, 0x93, 0x53, 0xe7, 0x23, 0x11, 0xf2, 0x3d, 0x38, 0x36, 0xb4, 0xa2, 0x72, 0x81, 0xc9, 0xbd,
+	0x96, 0x26, 0x37, 0x1a, 0xf3, 0xda, 0x6f, 0x50, 0xc9, 0x57, 0x52, 0x9e, 0x19, 0x23, 0xd0, 0x4e,
+	0x4e, 0x5d, 0x18, 0x46, 0x5a, 0xe4, 0x5d, 0x58, 0xd0, 0xbc, 0xb8, 0x15, 0x08, 0x2f, 0x31, 0xe1,
+	0x57, 0xd3, 0x84, 0x47, 0xa2, 0x5c, 0xbb, 0x41, 0x65, 0x5f, 0x16, 0xcb, 0x8e, 0x20, 0x3b, 0x39,
+	0xb5, 0xac, 0x85, 0x1b, 0xe4, 0x6f, 0x4b, 0x70, 0x82, 0x9e, 0x88, 0x68, 0x56, 0x3a, 0xcf, 0xa4,
+	0x5f, 0x1f, 0x71, 0x20, 0xc2, 0xb9, 0x69, 0x7b, 0x89, 0xca, 0xbf, 0x9a, 0x7a, 0x1e, 0xc2, 0xd8,
+	0x4e, 0x4e, 0x3d, 0x46, 0xa2, 0x4d, 0x2c, 0x64, 0x60, 0x3f, 0xb5, 0xec, 0x46, 0x1f, 0xaa, 0x2b,
+	0xe5, 0xd1, 0x21, 0x43, 0x98, 0x91, 0x8e, 0x0c, 0x19, 0xc2, 0x11, 0x34, 0x64, 0x60, 0x51, 0x87,
+	0x17, 0x5e, 0x3d, 0x77, 0x17, 0x8d, 0x65, 0x0b, 0x07, 0x84, 0xd7, 0xc4, 0x85, 0x7b, 0x74, 0x78,
+	0x4d, 0xc0, 0x59, 0x78, 0x4d, 0x4e, 0x32, 0x07, 0x05, 0x8d, 0x05, 0xfe, 0xfa, 0xef, 0xbd, 0x27,
+	0x87, 0x07, 0xd8, 0x76, 0x6c, 0x82, 0x22, 0x39, 0xc1, 0x24, 0xb3, 0x9a, 0x2f, 0x06, 0x02, 0xfd,
+	0x00, 0x29, 0xbe, 0xaa, 0x84, 0xc5, 0xfb, 0xc5, 0x25, 0x7f, 0x58, 0x2c, 0xbd, 0x59, 0x87, 0xaa,
+	0x98, 0x34, 0x2f, 0x2c, 0x5d, 0x84, 0x92, 0xc3, 0xba, 0xb5, 0xad, 0xae, 0xd9, 0xf3, 0xeb, 0x99,
+	0x10, 0x34, 0xdd, 0xef, 0xd5, 0x7f, 0xe2, 0x2d, 0x7c, 0xdd, 0x71, 0xb0, 0xbd, 0x7d, 0x78, 0x0b,
+	0x8f, 0xf1, 0xc8, 0xc7, 0x79, 0x88, 0x6f, 0xe6, 0x49, 0x52, 0x3c, 0x75, 0xf3, 0x79, 0x7f, 0x79,
+	0x17, 0xe9, 0x43, 0xf7, 0x88, 0xf1, 0x16, 0x90, 0x0a, 0x78, 0xb7, 0x7e, 0xb1, 0x08, 0xf9, 0x0d,
+	0x62, 0xc8, 0x8f, 0x61, 0x3e, 0xf2, 0xf3, 0x8e, 0x54, 0x8f, 0x1c, 0x46, 0x29, 0x37, 0xb3, 0xa0,
+	0xb8, 0xf2, 0xfb, 0xb0, 0x10, 0xfb, 0x95, 0xc5, 0xb5, 0x83, 0x8e, 0xbe, 0x87, 0x53, 0x56, 0xb2,
+	0xe1, 0xb8, 0xa4, 0xef, 0x4a, 0xb0, 0x98, 0xf6, 0x1b, 0x8a, 0x71, 0x73, 0x2c, 0xe5, 0x33, 0x63,
+	0x0e, 0xe0, 0x2c, 0xbe, 0x05, 0xa7, 0xc5, 0xbf, 0x56, 0x48, 0xf5, 0x78, 0x42, 0xb8, 0xb2, 0x36,
+	0x16, 0x9c, 0x8b, 0xff, 0xa9, 0x04, 0x17, 0x46, 0xff, 0x26, 0xe1, 0xf5, 0x72, 0x38, 0xe5, 0xad,
+	0xd7, 0x1a, 0xc6, 0x79, 0xb9, 0x20, 0x0b, 0x7e, 0x7f, 0x30, 0xc6, 0x5d, 0x46, 0x69, 0x65, 0xc7,
+	0x72, 0xa9, 0x3b, 0x70, 0x52, 0xf4, 0x80, 0x7b, 0x23, 0xfb, 0x54, 0x44, 0x59, 0x1d, 0x03, 0xcc,
+	0x05, 0x7f, 0x03, 0x4e, 0x24, 0x5f, 0x56, 0xb3, 0x67, 0x81, 0xca, 0xed, 0xcc, 0xd0, 0xf0, 0x0e,
+	0x0b, 0xde, 0x30, 0xc7, 0x48, 0xf6, 0x94, 0x56, 0x76, 0x6c, 0xd8, 0xdc, 0xc5, 0x4f, 0x8e, 0xe3,
+	0xdd, 0x09, 0x95, 0xb5, 0xb1, 0xe0, 0x5c, 0xbc, 0x05, 0xc7, 0x13, 0x0f, 0x61, 0xa9, 0x59, 0x7f,
+	0x1c, 0xa9, 0xdc, 0xca, 0x8a, 0xe4, 0xf2, 0xde, 0x97, 0xe0, 0x4c, 0xca, 0x7b, 0x51, 0xaa, 0xbb,
+	0x12, 0xe3, 0x95, 0x3b, 0xe3, 0xe1, 0xc3, 0x4b, 0x4e, 0xbc, 0xb5, 0x8c, 0xb8, 0xe8, 0x68, 0x5b,
+	0xd9, 0x96, 0x9c, 0xf6, 0x1c, 0xc2, 0x4e, 0x6e, 0xf2, 0x29, 0x64, 0x8c, 0x34, 0x49, 0x69, 0x65,
+	0xc7, 0x86, 0x4f, 0xae, 0xe8, 0xfd, 0x22, 0xf5, 0xe4, 0x0a, 0xc0, 0xca, 0xea, 0x18, 0x60, 0x2e,
+	0xf8, 0x29, 0x1c, 0x8b, 0x57, 0xda, 0xb3, 0x66, 0xcd, 0x4a, 0x33, 0x23, 0x30, 0x7c, 0x7a, 0xc4,
+	0x85, 0xef, 0xf1, 0xd2, 0x63, 0x65, 0x6d, 0x2c, 0x78, 0xc4, 0x9a, 0x53, 0x8a, 0xbb, 0x63, 0x56,
+	0x4d, 0x94, 0x3b, 0xe3, 0xe1, 0x39, 0x85, 0xc7, 0x30, 0x1f, 0xa9, 0xb0, 0x66, 0xba, 0x14, 0x2a,
+	0x37, 0xb3, 0xa0, 0xc2, 0x29, 0x48, 0xac, 0x7a, 0x9a, 0xf1, 0x0a, 0xa8, 0xac, 0x64, 0xc3, 0x71,
+	0x49, 0x08, 0xca, 0xd1, 0xda, 0x68, 0xb6, 0xeb, 0x9e, 0xb2, 0x9c, 0x09, 0x16, 0x75, 0x01, 0xb1,
+	0x02, 0x44, 0xe6, 0x5a, 0x87, 0x72, 0x2b, 0x2b, 0x32, 0x7c, 0x18, 0x45, 0x97, 0x92, 0xd4, 0xc3,
+	0x28, 0x00, 0x2b, 0xab, 0x63, 0x80, 0x23, 0x5e, 0x40, 0x70, 0x29, 0xb8, 0x31, 0xc2, 0x75, 0xc6,
+	0xc1, 0xca, 0xea, 0x18, 0xe0, 0xb0, 0x60, 0x51, 0x56, 0x9f, 0x2a, 0x58, 0x00, 0x56, 0x56, 0xc7,
+	0x00, 0x07, 0x82, 0x95, 0x99, 0xf7, 0xe9, 0x0f, 0x9f, 0xdb, 0xf7, 0x3f, 0x7a, 0x59, 0x95, 0x3e,
+	0x7e, 0x59, 0x95, 0xfe, 0xf1, 0xb2, 0x2a, 0xfd, 0xf8, 0x55, 0x35, 0xf7, 0xf1, 0xab, 0x6a, 0xee,
+	0x6f, 0xaf, 0xaa, 0xb9, 0x77, 0x9b, 0x86, 0xe9, 0xf6, 0x87, 0x8f, 0x57, 0x74, 0x7b, 0xd0, 0xfc,
+	0x2a, 0x9b, 0x7f, 0xf9, 0x6b, 0xda, 0x63, 0xd2, 0x14, 0xdc, 0x42, 0xe9, 0x75, 0x9b, 0x3c, 0x2e,
+	0xb0, 0x9f, 0x73, 0xaf, 0xfe, 0x77, 0x00, 0xb0, 0xc6, 0x96, 0x40, 0x2a, 0x2f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RegisterTenant defines the RegisterTenant RPC.
+	RegisterTenant(ctx context.Context, in *MsgRegisterTenant, opts ...grpc.CallOption) (*MsgRegisterTenantResponse, error)
+	// TransferTenantOwnership defines the TransferTenantOwnership RPC.
+	TransferTenantOwnership(ctx context.Context, in *MsgTransferTenantOwnership, opts ...grpc.CallOption) (*MsgTransferTenantOwnershipResponse, error)
+	// AcceptTenantOwnership defines the AcceptTenantOwnership RPC.
+	AcceptTenantOwnership(ctx context.Context, in *MsgAcceptTenantOwnership, opts ...grpc.CallOption) (*MsgAcceptTenantOwnershipResponse, error)
+	// CancelTenantOwnershipTransfer defines the CancelTenantOwnershipTransfer RPC.
+	CancelTenantOwnershipTransfer(ctx context.Context, in *MsgCancelTenantOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelTenantOwnershipTransferResponse, error)
+	// CommitIntegritySet defines the CommitIntegritySet RPC.
+	CommitIntegritySet(ctx context.Context, in *MsgCommitIntegritySet, opts ...grpc.CallOption) (*MsgCommitIntegritySetResponse, error)
+	// CommitIntegritySets defines the CommitIntegritySets RPC.
+	CommitIntegritySets(ctx context.Context, in *MsgCommitIntegritySets, opts ...grpc.CallOption) (*MsgCommitIntegritySetsResponse, error)
+	// GrantTenantWriter defines the GrantTenantWriter RPC.
+	GrantTenantWriter(ctx context.Context, in *MsgGrantTenantWriter, opts ...grpc.CallOption) (*MsgGrantTenantWriterResponse, error)
+	// RevokeTenantWriter defines the RevokeTenantWriter RPC.
+	RevokeTenantWriter(ctx context.Context, in *MsgRevokeTenantWriter, opts ...grpc.CallOption) (*MsgRevokeTenantWriterResponse, error)
+	// SupersedeIntegritySet defines the SupersedeIntegritySet RPC.
+	SupersedeIntegritySet(ctx context.Context, in *MsgSupersedeIntegritySet, opts ...grpc.CallOption) (*MsgSupersedeIntegritySetResponse, error)
+	// OpenIntegritySet defines the OpenIntegritySet RPC.
+	OpenIntegritySet(ctx context.Context, in *MsgOpenIntegritySet, opts ...grpc.CallOption) (*MsgOpenIntegritySetResponse, error)
+	// AppendIntegrityRecords defines the AppendIntegrityRecords RPC.
+	AppendIntegrityRecords(ctx context.Context, in *MsgAppendIntegrityRecords, opts ...grpc.CallOption) (*MsgAppendIntegrityRecordsResponse, error)
+	// SealIntegritySet defines the SealIntegritySet RPC.
+	SealIntegritySet(ctx context.Context, in *MsgSealIntegritySet, opts ...grpc.CallOption) (*MsgSealIntegritySetResponse, error)
+	// CancelIntegritySet defines the CancelIntegritySet RPC.
+	CancelIntegritySet(ctx context.Context, in *MsgCancelIntegritySet, opts ...grpc.CallOption) (*MsgCancelIntegritySetResponse, error)
+	// AnchorIntegrityRoot defines the AnchorIntegrityRoot RPC.
+	AnchorIntegrityRoot(ctx context.Context, in *MsgAnchorIntegrityRoot, opts ...grpc.CallOption) (*MsgAnchorIntegrityRootResponse, error)
+	// SetPeriodFormat defines the SetPeriodFormat RPC.
+	SetPeriodFormat(ctx context.Context, in *MsgSetPeriodFormat, opts ...grpc.CallOption) (*MsgSetPeriodFormatResponse, error)
+	// RegisterIntegrityType defines the RegisterIntegrityType RPC.
+	RegisterIntegrityType(ctx context.Context, in *MsgRegisterIntegrityType, opts ...grpc.CallOption) (*MsgRegisterIntegrityTypeResponse, error)
+	// RevokeIntegrityRecords defines the RevokeIntegrityRecords RPC.
+	RevokeIntegrityRecords(ctx context.Context, in *MsgRevokeIntegrityRecords, opts ...grpc.CallOption) (*MsgRevokeIntegrityRecordsResponse, error)
+	// FreezeTenant defines the FreezeTenant RPC.
+	FreezeTenant(ctx context.Context, in *MsgFreezeTenant, opts ...grpc.CallOption) (*MsgFreezeTenantResponse, error)
+	// UnfreezeTenant defines the UnfreezeTenant RPC.
+	UnfreezeTenant(ctx context.Context, in *MsgUnfreezeTenant, opts ...grpc.CallOption) (*MsgUnfreezeTenantResponse, error)
+	// ArchiveTenant defines the ArchiveTenant RPC.
+	ArchiveTenant(ctx context.Context, in *MsgArchiveTenant, opts ...grpc.CallOption) (*MsgArchiveTenantResponse, error)
+	// SetTenantSigners defines the SetTenantSigners RPC.
+	SetTenantSigners(ctx context.Context, in *MsgSetTenantSigners, opts ...grpc.CallOption) (*MsgSetTenantSignersResponse, error)
+	// ProposeTenantAction defines the ProposeTenantAction RPC.
+	ProposeTenantAction(ctx context.Context, in *MsgProposeTenantAction, opts ...grpc.CallOption) (*MsgProposeTenantActionResponse, error)
+	// ApproveTenantAction defines the ApproveTenantAction RPC.
+	ApproveTenantAction(ctx context.Context, in *MsgApproveTenantAction, opts ...grpc.CallOption) (*MsgApproveTenantActionResponse, error)
+	// ExecuteTenantAction defines the ExecuteTenantAction RPC.
+	ExecuteTenantAction(ctx context.Context, in *MsgExecuteTenantAction, opts ...grpc.CallOption) (*MsgExecuteTenantActionResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterTenant(ctx context.Context, in *MsgRegisterTenant, opts ...grpc.CallOption) (*MsgRegisterTenantResponse, error) {
+	out := new(MsgRegisterTenantResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/RegisterTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferTenantOwnership(ctx context.Context, in *MsgTransferTenantOwnership, opts ...grpc.CallOption) (*MsgTransferTenantOwnershipResponse, error) {
+	out := new(MsgTransferTenantOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/TransferTenantOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptTenantOwnership(ctx context.Context, in *MsgAcceptTenantOwnership, opts ...grpc.CallOption) (*MsgAcceptTenantOwnershipResponse, error) {
+	out := new(MsgAcceptTenantOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/AcceptTenantOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelTenantOwnershipTransfer(ctx context.Context, in *MsgCancelTenantOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelTenantOwnershipTransferResponse, error) {
+	out := new(MsgCancelTenantOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/CancelTenantOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitIntegritySet(ctx context.Context, in *MsgCommitIntegritySet, opts ...grpc.CallOption) (*MsgCommitIntegritySetResponse, error) {
+	out := new(MsgCommitIntegritySetResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/CommitIntegritySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitIntegritySets(ctx context.Context, in *MsgCommitIntegritySets, opts ...grpc.CallOption) (*MsgCommitIntegritySetsResponse, error) {
+	out := new(MsgCommitIntegritySetsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/CommitIntegritySets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) GrantTenantWriter(ctx context.Context, in *MsgGrantTenantWriter, opts ...grpc.CallOption) (*MsgGrantTenantWriterResponse, error) {
+	out := new(MsgGrantTenantWriterResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/GrantTenantWriter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeTenantWriter(ctx context.Context, in *MsgRevokeTenantWriter, opts ...grpc.CallOption) (*MsgRevokeTenantWriterResponse, error) {
+	out := new(MsgRevokeTenantWriterResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/RevokeTenantWriter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SupersedeIntegritySet(ctx context.Context, in *MsgSupersedeIntegritySet, opts ...grpc.CallOption) (*MsgSupersedeIntegritySetResponse, error) {
+	out := new(MsgSupersedeIntegritySetResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/SupersedeIntegritySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) OpenIntegritySet(ctx context.Context, in *MsgOpenIntegritySet, opts ...grpc.CallOption) (*MsgOpenIntegritySetResponse, error) {
+	out := new(MsgOpenIntegritySetResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/OpenIntegritySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AppendIntegrityRecords(ctx context.Context, in *MsgAppendIntegrityRecords, opts ...grpc.CallOption) (*MsgAppendIntegrityRecordsResponse, error) {
+	out := new(MsgAppendIntegrityRecordsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/AppendIntegrityRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SealIntegritySet(ctx context.Context, in *MsgSealIntegritySet, opts ...grpc.CallOption) (*MsgSealIntegritySetResponse, error) {
+	out := new(MsgSealIntegritySetResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/SealIntegritySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelIntegritySet(ctx context.Context, in *MsgCancelIntegritySet, opts ...grpc.CallOption) (*MsgCancelIntegritySetResponse, error) {
+	out := new(MsgCancelIntegritySetResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/CancelIntegritySet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AnchorIntegrityRoot(ctx context.Context, in *MsgAnchorIntegrityRoot, opts ...grpc.CallOption) (*MsgAnchorIntegrityRootResponse, error) {
+	out := new(MsgAnchorIntegrityRootResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/AnchorIntegrityRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPeriodFormat(ctx context.Context, in *MsgSetPeriodFormat, opts ...grpc.CallOption) (*MsgSetPeriodFormatResponse, error) {
+	out := new(MsgSetPeriodFormatResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/SetPeriodFormat", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterIntegrityType(ctx context.Context, in *MsgRegisterIntegrityType, opts ...grpc.CallOption) (*MsgRegisterIntegrityTypeResponse, error) {
+	out := new(MsgRegisterIntegrityTypeResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/RegisterIntegrityType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeIntegrityRecords(ctx context.Context, in *MsgRevokeIntegrityRecords, opts ...grpc.CallOption) (*MsgRevokeIntegrityRecordsResponse, error) {
+	out := new(MsgRevokeIntegrityRecordsResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/RevokeIntegrityRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeTenant(ctx context.Context, in *MsgFreezeTenant, opts ...grpc.CallOption) (*MsgFreezeTenantResponse, error) {
+	out := new(MsgFreezeTenantResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/FreezeTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnfreezeTenant(ctx context.Context, in *MsgUnfreezeTenant, opts ...grpc.CallOption) (*MsgUnfreezeTenantResponse, error) {
+	out := new(MsgUnfreezeTenantResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/UnfreezeTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ArchiveTenant(ctx context.Context, in *MsgArchiveTenant, opts ...grpc.CallOption) (*MsgArchiveTenantResponse, error) {
+	out := new(MsgArchiveTenantResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/ArchiveTenant", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetTenantSigners(ctx context.Context, in *MsgSetTenantSigners, opts ...grpc.CallOption) (*MsgSetTenantSignersResponse, error) {
+	out := new(MsgSetTenantSignersResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/SetTenantSigners", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeTenantAction(ctx context.Context, in *MsgProposeTenantAction, opts ...grpc.CallOption) (*MsgProposeTenantActionResponse, error) {
+	out := new(MsgProposeTenantActionResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/ProposeTenantAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ApproveTenantAction(ctx context.Context, in *MsgApproveTenantAction, opts ...grpc.CallOption) (*MsgApproveTenantActionResponse, error) {
+	out := new(MsgApproveTenantActionResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/ApproveTenantAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ExecuteTenantAction(ctx context.Context, in *MsgExecuteTenantAction, opts ...grpc.CallOption) (*MsgExecuteTenantActionResponse, error) {
+	out := new(MsgExecuteTenantActionResponse)
+	err := c.cc.Invoke(ctx, "/kudora.integrity.v1.Msg/ExecuteTenantAction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RegisterTenant defines the RegisterTenant RPC.
+	RegisterTenant(context.Context, *MsgRegisterTenant) (*MsgRegisterTenantResponse, error)
+	// TransferTenantOwnership defines the TransferTenantOwnership RPC.
+	TransferTenantOwnership(context.Context, *MsgTransferTenantOwnership) (*MsgTransferTenantOwnershipResponse, error)
+	// AcceptTenantOwnership defines the AcceptTenantOwnership RPC.
+	AcceptTenantOwnership(context.Context, *MsgAcceptTenantOwnership) (*MsgAcceptTenantOwnershipResponse, error)
+	// CancelTenantOwnershipTransfer defines the CancelTenantOwnershipTransfer RPC.
+	CancelTenantOwnershipTransfer(context.Context, *MsgCancelTenantOwnershipTransfer) (*MsgCancelTenantOwnershipTransferResponse, error)
+	// CommitIntegritySet defines the CommitIntegritySet RPC.
+	CommitIntegritySet(context.Context, *MsgCommitIntegritySet) (*MsgCommitIntegritySetResponse, error)
+	// CommitIntegritySets defines the CommitIntegritySets RPC.
+	CommitIntegritySets(context.Context, *MsgCommitIntegritySets) (*MsgCommitIntegritySetsResponse, error)
+	// GrantTenantWriter defines the GrantTenantWriter RPC.
+	GrantTenantWriter(context.Context, *MsgGrantTenantWriter) (*MsgGrantTenantWriterResponse, error)
+	// RevokeTenantWriter defines the RevokeTenantWriter RPC.
+	RevokeTenantWriter(context.Context, *MsgRevokeTenantWriter) (*MsgRevokeTenantWriterResponse, error)
+	// SupersedeIntegritySet defines the SupersedeIntegritySet RPC.
+	SupersedeIntegritySet(context.Context, *MsgSupersedeIntegritySet) (*MsgSupersedeIntegritySetResponse, error)
+	// OpenIntegritySet defines the OpenIntegritySet RPC.
+	OpenIntegritySet(context.Context, *MsgOpenIntegritySet) (*MsgOpenIntegritySetResponse, error)
+	// AppendIntegrityRecords defines the AppendIntegrityRecords RPC.
+	AppendIntegrityRecords(context.Context, *MsgAppendIntegrityRecords) (*MsgAppendIntegrityRecordsResponse, error)
+	// SealIntegritySet defines the SealIntegritySet RPC.
+	SealIntegritySet(context.Context, *MsgSealIntegritySet) (*MsgSealIntegritySetResponse, error)
+	// CancelIntegritySet defines the CancelIntegritySet RPC.
+	CancelIntegritySet(context.Context, *MsgCancelIntegritySet) (*MsgCancelIntegritySetResponse, error)
+	// AnchorIntegrityRoot defines the AnchorIntegrityRoot RPC.
+	AnchorIntegrityRoot(context.Context, *MsgAnchorIntegrityRoot) (*MsgAnchorIntegrityRootResponse, error)
+	// SetPeriodFormat defines the SetPeriodFormat RPC.
+	SetPeriodFormat(context.Context, *MsgSetPeriodFormat) (*MsgSetPeriodFormatResponse, error)
+	// RegisterIntegrityType defines the RegisterIntegrityType RPC.
+	RegisterIntegrityType(context.Context, *MsgRegisterIntegrityType) (*MsgRegisterIntegrityTypeResponse, error)
+	// RevokeIntegrityRecords defines the RevokeIntegrityRecords RPC.
+	RevokeIntegrityRecords(context.Context, *MsgRevokeIntegrityRecords) (*MsgRevokeIntegrityRecordsResponse, error)
+	// FreezeTenant defines the FreezeTenant RPC.
+	FreezeTenant(context.Context, *MsgFreezeTenant) (*MsgFreezeTenantResponse, error)
+	// UnfreezeTenant defines the UnfreezeTenant RPC.
+	UnfreezeTenant(context.Context, *MsgUnfreezeTenant) (*MsgUnfreezeTenantResponse, error)
+	// ArchiveTenant defines the ArchiveTenant RPC.
+	ArchiveTenant(context.Context, *MsgArchiveTenant) (*MsgArchiveTenantResponse, error)
+	// SetTenantSigners defines the SetTenantSigners RPC.
+	SetTenantSigners(context.Context, *MsgSetTenantSigners) (*MsgSetTenantSignersResponse, error)
+	// ProposeTenantAction defines the ProposeTenantAction RPC.
+	ProposeTenantAction(context.Context, *MsgProposeTenantAction) (*MsgProposeTenantActionResponse, error)
+	// ApproveTenantAction defines the ApproveTenantAction RPC.
+	ApproveTenantAction(context.Context, *MsgApproveTenantAction) (*MsgApproveTenantActionResponse, error)
+	// ExecuteTenantAction defines the ExecuteTenantAction RPC.
+	ExecuteTenantAction(context.Context, *MsgExecuteTenantAction) (*MsgExecuteTenantActionResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RegisterTenant(ctx context.Context, req *MsgRegisterTenant) (*MsgRegisterTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterTenant not implemented")
+}
+func (*UnimplementedMsgServer) TransferTenantOwnership(ctx context.Context, req *MsgTransferTenantOwnership) (*MsgTransferTenantOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferTenantOwnership not implemented")
+}
+func (*UnimplementedMsgServer) AcceptTenantOwnership(ctx context.Context, req *MsgAcceptTenantOwnership) (*MsgAcceptTenantOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptTenantOwnership not implemented")
+}
+func (*UnimplementedMsgServer) CancelTenantOwnershipTransfer(ctx context.Context, req *MsgCancelTenantOwnershipTransfer) (*MsgCancelTenantOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelTenantOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CommitIntegritySet(ctx context.Context, req *MsgCommitIntegritySet) (*MsgCommitIntegritySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitIntegritySet not implemented")
+}
+func (*UnimplementedMsgServer) CommitIntegritySets(ctx context.Context, req *MsgCommitIntegritySets) (*MsgCommitIntegritySetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitIntegritySets not implemented")
+}
+func (*UnimplementedMsgServer) GrantTenantWriter(ctx context.Context, req *MsgGrantTenantWriter) (*MsgGrantTenantWriterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantTenantWriter not implemented")
+}
+func (*UnimplementedMsgServer) RevokeTenantWriter(ctx context.Context, req *MsgRevokeTenantWriter) (*MsgRevokeTenantWriterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeTenantWriter not implemented")
+}
+func (*UnimplementedMsgServer) SupersedeIntegritySet(ctx context.Context, req *MsgSupersedeIntegritySet) (*MsgSupersedeIntegritySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupersedeIntegritySet not implemented")
+}
+func (*UnimplementedMsgServer) OpenIntegritySet(ctx context.Context, req *MsgOpenIntegritySet) (*MsgOpenIntegritySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenIntegritySet not implemented")
+}
+func (*UnimplementedMsgServer) AppendIntegrityRecords(ctx context.Context, req *MsgAppendIntegrityRecords) (*MsgAppendIntegrityRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AppendIntegrityRecords not implemented")
+}
+func (*UnimplementedMsgServer) SealIntegritySet(ctx context.Context, req *MsgSealIntegritySet) (*MsgSealIntegritySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealIntegritySet not implemented")
+}
+func (*UnimplementedMsgServer) CancelIntegritySet(ctx context.Context, req *MsgCancelIntegritySet) (*MsgCancelIntegritySetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIntegritySet not implemented")
+}
+func (*UnimplementedMsgServer) AnchorIntegrityRoot(ctx context.Context, req *MsgAnchorIntegrityRoot) (*MsgAnchorIntegrityRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnchorIntegrityRoot not implemented")
+}
+func (*UnimplementedMsgServer) SetPeriodFormat(ctx context.Context, req *MsgSetPeriodFormat) (*MsgSetPeriodFormatResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPeriodFormat not implemented")
+}
+func (*UnimplementedMsgServer) RegisterIntegrityType(ctx context.Context, req *MsgRegisterIntegrityType) (*MsgRegisterIntegrityTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterIntegrityType not implemented")
+}
+func (*UnimplementedMsgServer) RevokeIntegrityRecords(ctx context.Context, req *MsgRevokeIntegrityRecords) (*MsgRevokeIntegrityRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeIntegrityRecords not implemented")
+}
+func (*UnimplementedMsgServer) FreezeTenant(ctx context.Context, req *MsgFreezeTenant) (*MsgFreezeTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeTenant not implemented")
+}
+func (*UnimplementedMsgServer) UnfreezeTenant(ctx context.Context, req *MsgUnfreezeTenant) (*MsgUnfreezeTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnfreezeTenant not implemented")
+}
+func (*UnimplementedMsgServer) ArchiveTenant(ctx context.Context, req *MsgArchiveTenant) (*MsgArchiveTenantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveTenant not implemented")
+}
+func (*UnimplementedMsgServer) SetTenantSigners(ctx context.Context, req *MsgSetTenantSigners) (*MsgSetTenantSignersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTenantSigners not implemented")
+}
+func (*UnimplementedMsgServer) ProposeTenantAction(ctx context.Context, req *MsgProposeTenantAction) (*MsgProposeTenantActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeTenantAction not implemented")
+}
+func (*UnimplementedMsgServer) ApproveTenantAction(ctx context.Context, req *MsgApproveTenantAction) (*MsgApproveTenantActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTenantAction not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteTenantAction(ctx context.Context, req *MsgExecuteTenantAction) (*MsgExecuteTenantActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteTenantAction not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterTenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/RegisterTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterTenant(ctx, req.(*MsgRegisterTenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferTenantOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferTenantOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferTenantOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/TransferTenantOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferTenantOwnership(ctx, req.(*MsgTransferTenantOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptTenantOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptTenantOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptTenantOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/AcceptTenantOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptTenantOwnership(ctx, req.(*MsgAcceptTenantOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelTenantOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelTenantOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelTenantOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/CancelTenantOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelTenantOwnershipTransfer(ctx, req.(*MsgCancelTenantOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitIntegritySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitIntegritySet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitIntegritySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/CommitIntegritySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitIntegritySet(ctx, req.(*MsgCommitIntegritySet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitIntegritySets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitIntegritySets)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitIntegritySets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/CommitIntegritySets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitIntegritySets(ctx, req.(*MsgCommitIntegritySets))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_GrantTenantWriter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantTenantWriter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantTenantWriter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/GrantTenantWriter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantTenantWriter(ctx, req.(*MsgGrantTenantWriter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeTenantWriter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeTenantWriter)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeTenantWriter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/RevokeTenantWriter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeTenantWriter(ctx, req.(*MsgRevokeTenantWriter))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SupersedeIntegritySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupersedeIntegritySet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SupersedeIntegritySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/SupersedeIntegritySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupersedeIntegritySet(ctx, req.(*MsgSupersedeIntegritySet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_OpenIntegritySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgOpenIntegritySet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).OpenIntegritySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/OpenIntegritySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).OpenIntegritySet(ctx, req.(*MsgOpenIntegritySet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AppendIntegrityRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAppendIntegrityRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AppendIntegrityRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/AppendIntegrityRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AppendIntegrityRecords(ctx, req.(*MsgAppendIntegrityRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SealIntegritySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSealIntegritySet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SealIntegritySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/SealIntegritySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SealIntegritySet(ctx, req.(*MsgSealIntegritySet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelIntegritySet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelIntegritySet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelIntegritySet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/CancelIntegritySet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelIntegritySet(ctx, req.(*MsgCancelIntegritySet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AnchorIntegrityRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAnchorIntegrityRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AnchorIntegrityRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/AnchorIntegrityRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AnchorIntegrityRoot(ctx, req.(*MsgAnchorIntegrityRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPeriodFormat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPeriodFormat)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPeriodFormat(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/SetPeriodFormat",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPeriodFormat(ctx, req.(*MsgSetPeriodFormat))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterIntegrityType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterIntegrityType)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterIntegrityType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/RegisterIntegrityType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterIntegrityType(ctx, req.(*MsgRegisterIntegrityType))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeIntegrityRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeIntegrityRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeIntegrityRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/RevokeIntegrityRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeIntegrityRecords(ctx, req.(*MsgRevokeIntegrityRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeTenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/FreezeTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeTenant(ctx, req.(*MsgFreezeTenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnfreezeTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnfreezeTenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnfreezeTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/UnfreezeTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnfreezeTenant(ctx, req.(*MsgUnfreezeTenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ArchiveTenant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgArchiveTenant)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ArchiveTenant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/ArchiveTenant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ArchiveTenant(ctx, req.(*MsgArchiveTenant))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetTenantSigners_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetTenantSigners)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetTenantSigners(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/SetTenantSigners",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetTenantSigners(ctx, req.(*MsgSetTenantSigners))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeTenantAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeTenantAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeTenantAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/ProposeTenantAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeTenantAction(ctx, req.(*MsgProposeTenantAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ApproveTenantAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgApproveTenantAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ApproveTenantAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/ApproveTenantAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ApproveTenantAction(ctx, req.(*MsgApproveTenantAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ExecuteTenantAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteTenantAction)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteTenantAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudora.integrity.v1.Msg/ExecuteTenantAction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteTenantAction(ctx, req.(*MsgExecuteTenantAction))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kudora.integrity.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RegisterTenant",
+			Handler:    _Msg_RegisterTenant_Handler,
+		},
+		{
+			MethodName: "TransferTenantOwnership",
+			Handler:    _Msg_TransferTenantOwnership_Handler,
+		},
+		{
+			MethodName: "AcceptTenantOwnership",
+			Handler:    _Msg_AcceptTenantOwnership_Handler,
+		},
+		{
+			MethodName: "CancelTenantOwnershipTransfer",
+			Handler:    _Msg_CancelTenantOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "CommitIntegritySet",
+			Handler:    _Msg_CommitIntegritySet_Handler,
+		},
+		{
+			MethodName: "CommitIntegritySets",
+			Handler:    _Msg_CommitIntegritySets_Handler,
+		},
+		{
+			MethodName: "GrantTenantWriter",
+			Handler:    _Msg_GrantTenantWriter_Handler,
+		},
+		{
+			MethodName: "RevokeTenantWriter",
+			Handler:    _Msg_RevokeTenantWriter_Handler,
+		},
+		{
+			MethodName: "SupersedeIntegritySet",
+			Handler:    _Msg_SupersedeIntegritySet_Handler,
+		},
+		{
+			MethodName: "OpenIntegritySet",
+			Handler:    _Msg_OpenIntegritySet_Handler,
+		},
+		{
+			MethodName: "AppendIntegrityRecords",
+			Handler:    _Msg_AppendIntegrityRecords_Handler,
+		},
+		{
+			MethodName: "SealIntegritySet",
+			Handler:    _Msg_SealIntegritySet_Handler,
+		},
+		{
+			MethodName: "CancelIntegritySet",
+			Handler:    _Msg_CancelIntegritySet_Handler,
+		},
+		{
+			MethodName: "AnchorIntegrityRoot",
+			Handler:    _Msg_AnchorIntegrityRoot_Handler,
+		},
+		{
+			MethodName: "SetPeriodFormat",
+			Handler:    _Msg_SetPeriodFormat_Handler,
+		},
+		{
+			MethodName: "RegisterIntegrityType",
+			Handler:    _Msg_RegisterIntegrityType_Handler,
+		},
+		{
+			MethodName: "RevokeIntegrityRecords",
+			Handler:    _Msg_RevokeIntegrityRecords_Handler,
+		},
+		{
+			MethodName: "FreezeTenant",
+			Handler:    _Msg_FreezeTenant_Handler,
+		},
+		{
+			MethodName: "UnfreezeTenant",
+			Handler:    _Msg_UnfreezeTenant_Handler,
+		},
+		{
+			MethodName: "ArchiveTenant",
+			Handler:    _Msg_ArchiveTenant_Handler,
+		},
+		{
+			MethodName: "SetTenantSigners",
+			Handler:    _Msg_SetTenantSigners_Handler,
+		},
+		{
+			MethodName: "ProposeTenantAction",
+			Handler:    _Msg_ProposeTenantAction_Handler,
+		},
+		{
+			MethodName: "ApproveTenantAction",
+			Handler:    _Msg_ApproveTenantAction_Handler,
+		},
+		{
+			MethodName: "ExecuteTenantAction",
+			Handler:    _Msg_ExecuteTenantAction_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kudora/integrity/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterTenant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTenantOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTenantOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTenantOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiresHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferTenantOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferTenantOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferTenantOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptTenantOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptTenantOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptTenantOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptTenantOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptTenantOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptTenantOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTenantOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTenantOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTenantOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelTenantOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelTenantOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelTenantOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitIntegritySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitIntegritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousRoot) > 0 {
+		i -= len(m.PreviousRoot)
+		copy(dAtA[i:], m.PreviousRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousRoot)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitIntegritySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitIntegritySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitIntegritySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *IntegritySetPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IntegritySetPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IntegritySetPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PreviousRoot) > 0 {
+		i -= len(m.PreviousRoot)
+		copy(dAtA[i:], m.PreviousRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PreviousRoot)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitIntegritySets) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitIntegritySets) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitIntegritySets) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Sets) > 0 {
+		for iNdEx := len(m.Sets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitIntegritySetsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitIntegritySetsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitIntegritySetsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SetCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SetCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantTenantWriter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantTenantWriter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantTenantWriter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ExpiresAt) > 0 {
+		i -= len(m.ExpiresAt)
+		copy(dAtA[i:], m.ExpiresAt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ExpiresAt)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TypePrefixes) > 0 {
+		for iNdEx := len(m.TypePrefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypePrefixes[iNdEx])
+			copy(dAtA[i:], m.TypePrefixes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypePrefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Writer) > 0 {
+		i -= len(m.Writer)
+		copy(dAtA[i:], m.Writer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Writer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantTenantWriterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantTenantWriterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantTenantWriterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTenantWriter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTenantWriter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTenantWriter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Writer) > 0 {
+		i -= len(m.Writer)
+		copy(dAtA[i:], m.Writer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Writer)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeTenantWriterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeTenantWriterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeTenantWriterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupersedeIntegritySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupersedeIntegritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupersedeIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReasonHash) > 0 {
+		i -= len(m.ReasonHash)
+		copy(dAtA[i:], m.ReasonHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ReasonHash)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupersedeIntegritySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupersedeIntegritySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupersedeIntegritySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenIntegritySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenIntegritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgOpenIntegritySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgOpenIntegritySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgOpenIntegritySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendIntegrityRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendIntegrityRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendIntegrityRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAppendIntegrityRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAppendIntegrityRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAppendIntegrityRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecordCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSealIntegritySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSealIntegritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSealIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSealIntegritySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSealIntegritySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSealIntegritySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelIntegritySet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelIntegritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelIntegritySetResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelIntegritySetResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelIntegritySetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnchorIntegrityRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnchorIntegrityRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnchorIntegrityRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HashAlgorithm != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashAlgorithm))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.StorageDigest) > 0 {
+		i -= len(m.StorageDigest)
+		copy(dAtA[i:], m.StorageDigest)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageDigest)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.StorageUri) > 0 {
+		i -= len(m.StorageUri)
+		copy(dAtA[i:], m.StorageUri)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.StorageUri)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.RecordCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RecordCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAnchorIntegrityRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAnchorIntegrityRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAnchorIntegrityRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPeriodFormat) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPeriodFormat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPeriodFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Format != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Format))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPeriodFormatResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPeriodFormatResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPeriodFormatResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterIntegrityType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterIntegrityType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterIntegrityType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.HashScheme != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HashScheme))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PeriodFormat != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PeriodFormat))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SchemaHash) > 0 {
+		i -= len(m.SchemaHash)
+		copy(dAtA[i:], m.SchemaHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SchemaHash)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterIntegrityTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterIntegrityTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterIntegrityTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeIntegrityRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeIntegrityRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeIntegrityRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReasonCode != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReasonCode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Period) > 0 {
+		i -= len(m.Period)
+		copy(dAtA[i:], m.Period)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Period)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeIntegrityRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeIntegrityRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeIntegrityRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeTenant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeTenant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnfreezeTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnfreezeTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnfreezeTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveTenant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgArchiveTenantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgArchiveTenantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgArchiveTenantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTenantSigners) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTenantSigners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTenantSigners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Signers) > 0 {
+		for iNdEx := len(m.Signers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Signers[iNdEx])
+			copy(dAtA[i:], m.Signers[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Signers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetTenantSignersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetTenantSignersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetTenantSignersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *TenantAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TenantAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Action != nil {
+		{
+			size := m.Action.Size()
+			i -= size
+			if _, err := m.Action.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TenantAction_CommitIntegritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_CommitIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CommitIntegritySet != nil {
+		{
+			size, err := m.CommitIntegritySet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_SupersedeIntegritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_SupersedeIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SupersedeIntegritySet != nil {
+		{
+			size, err := m.SupersedeIntegritySet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_RevokeIntegrityRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_RevokeIntegrityRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RevokeIntegrityRecords != nil {
+		{
+			size, err := m.RevokeIntegrityRecords.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_TransferTenantOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_TransferTenantOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.TransferTenantOwnership != nil {
+		{
+			size, err := m.TransferTenantOwnership.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_CancelTenantOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_CancelTenantOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CancelTenantOwnershipTransfer != nil {
+		{
+			size, err := m.CancelTenantOwnershipTransfer.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_SetTenantSigners) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_SetTenantSigners) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetTenantSigners != nil {
+		{
+			size, err := m.SetTenantSigners.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_GrantTenantWriter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_GrantTenantWriter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.GrantTenantWriter != nil {
+		{
+			size, err := m.GrantTenantWriter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_RevokeTenantWriter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_RevokeTenantWriter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RevokeTenantWriter != nil {
+		{
+			size, err := m.RevokeTenantWriter.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_FreezeTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_FreezeTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FreezeTenant != nil {
+		{
+			size, err := m.FreezeTenant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_UnfreezeTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_UnfreezeTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.UnfreezeTenant != nil {
+		{
+			size, err := m.UnfreezeTenant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_ArchiveTenant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_ArchiveTenant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ArchiveTenant != nil {
+		{
+			size, err := m.ArchiveTenant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_SetPeriodFormat) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_SetPeriodFormat) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.SetPeriodFormat != nil {
+		{
+			size, err := m.SetPeriodFormat.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_RegisterIntegrityType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_RegisterIntegrityType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.RegisterIntegrityType != nil {
+		{
+			size, err := m.RegisterIntegrityType.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x6a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *TenantAction_CancelIntegritySet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TenantAction_CancelIntegritySet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CancelIntegritySet != nil {
+		{
+			size, err := m.CancelIntegritySet.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	return len(dAtA) - i, nil
+}
+func (m *MsgProposeTenantAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeTenantAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeTenantAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Action.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeTenantActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeTenantActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeTenantActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveTenantAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveTenantAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveTenantAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgApproveTenantActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgApproveTenantActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgApproveTenantActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteTenantAction) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteTenantAction) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteTenantAction) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ProposalId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Tenant) > 0 {
+		i -= len(m.Tenant)
+		copy(dAtA[i:], m.Tenant)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Tenant)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgExecuteTenantActionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteTenantActionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteTenantActionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRegisterTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgTransferTenantOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiresHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiresHeight))
+	}
+	return n
+}
+
+func (m *MsgTransferTenantOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptTenantOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptTenantOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelTenantOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelTenantOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitIntegritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovTx(uint64(m.HashScheme))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovTx(uint64(m.HashAlgorithm))
+	}
+	l = len(m.PreviousRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitIntegritySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *IntegritySetPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovTx(uint64(m.HashScheme))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovTx(uint64(m.HashAlgorithm))
+	}
+	l = len(m.PreviousRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitIntegritySets) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Sets) > 0 {
+		for _, e := range m.Sets {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCommitIntegritySetsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetCount != 0 {
+		n += 1 + sovTx(uint64(m.SetCount))
+	}
+	return n
+}
+
+func (m *MsgGrantTenantWriter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Writer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypePrefixes) > 0 {
+		for _, s := range m.TypePrefixes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ExpiresAt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgGrantTenantWriterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeTenantWriter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Writer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeTenantWriterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSupersedeIntegritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.ReasonHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovTx(uint64(m.HashScheme))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovTx(uint64(m.HashAlgorithm))
+	}
+	return n
+}
+
+func (m *MsgSupersedeIntegritySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Version != 0 {
+		n += 1 + sovTx(uint64(m.Version))
+	}
+	return n
+}
+
+func (m *MsgOpenIntegritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovTx(uint64(m.HashScheme))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovTx(uint64(m.HashAlgorithm))
+	}
+	return n
+}
+
+func (m *MsgOpenIntegritySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAppendIntegrityRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgAppendIntegrityRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovTx(uint64(m.RecordCount))
+	}
+	return n
+}
+
+func (m *MsgSealIntegritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSealIntegritySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelIntegritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelIntegritySetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAnchorIntegrityRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RecordCount != 0 {
+		n += 1 + sovTx(uint64(m.RecordCount))
+	}
+	l = len(m.StorageUri)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.StorageDigest)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovTx(uint64(m.HashScheme))
+	}
+	if m.HashAlgorithm != 0 {
+		n += 1 + sovTx(uint64(m.HashAlgorithm))
+	}
+	return n
+}
+
+func (m *MsgAnchorIntegrityRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPeriodFormat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Format != 0 {
+		n += 1 + sovTx(uint64(m.Format))
+	}
+	return n
+}
+
+func (m *MsgSetPeriodFormatResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterIntegrityType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SchemaHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PeriodFormat != 0 {
+		n += 1 + sovTx(uint64(m.PeriodFormat))
+	}
+	if m.HashScheme != 0 {
+		n += 1 + sovTx(uint64(m.HashScheme))
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgRegisterIntegrityTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeIntegrityRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Period)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.ReasonCode != 0 {
+		n += 1 + sovTx(uint64(m.ReasonCode))
+	}
+	return n
+}
+
+func (m *MsgRevokeIntegrityRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnfreezeTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnfreezeTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgArchiveTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgArchiveTenantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetTenantSigners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTx(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *MsgSetTenantSignersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *TenantAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != nil {
+		n += m.Action.Size()
+	}
+	return n
+}
+
+func (m *TenantAction_CommitIntegritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CommitIntegritySet != nil {
+		l = m.CommitIntegritySet.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_SupersedeIntegritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SupersedeIntegritySet != nil {
+		l = m.SupersedeIntegritySet.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_RevokeIntegrityRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevokeIntegrityRecords != nil {
+		l = m.RevokeIntegrityRecords.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_TransferTenantOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransferTenantOwnership != nil {
+		l = m.TransferTenantOwnership.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_CancelTenantOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelTenantOwnershipTransfer != nil {
+		l = m.CancelTenantOwnershipTransfer.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_SetTenantSigners) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetTenantSigners != nil {
+		l = m.SetTenantSigners.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_GrantTenantWriter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.GrantTenantWriter != nil {
+		l = m.GrantTenantWriter.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_RevokeTenantWriter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RevokeTenantWriter != nil {
+		l = m.RevokeTenantWriter.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_FreezeTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FreezeTenant != nil {
+		l = m.FreezeTenant.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_UnfreezeTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.UnfreezeTenant != nil {
+		l = m.UnfreezeTenant.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_ArchiveTenant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ArchiveTenant != nil {
+		l = m.ArchiveTenant.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_SetPeriodFormat) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SetPeriodFormat != nil {
+		l = m.SetPeriodFormat.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_RegisterIntegrityType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RegisterIntegrityType != nil {
+		l = m.RegisterIntegrityType.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *TenantAction_CancelIntegritySet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CancelIntegritySet != nil {
+		l = m.CancelIntegritySet.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+func (m *MsgProposeTenantAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Action.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgProposeTenantActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveTenantAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgApproveTenantActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteTenantAction) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Tenant)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ProposalId != 0 {
+		n += 1 + sovTx(uint64(m.ProposalId))
+	}
+	return n
+}
+
+func (m *MsgExecuteTenantActionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterTenant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterTenant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterTenant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterTenantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterTenantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTenantOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTenantOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTenantOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresHeight", wireType)
+			}
+			m.ExpiresHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferTenantOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferTenantOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferTenantOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptTenantOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptTenantOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptTenantOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptTenantOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptTenantOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptTenantOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTenantOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTenantOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTenantOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelTenantOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelTenantOwnershipTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelTenantOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitIntegritySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitIntegritySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitIntegritySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, IntegrityRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitIntegritySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitIntegritySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitIntegritySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IntegritySetPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IntegritySetPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IntegritySetPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, IntegrityRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitIntegritySets) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitIntegritySets: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitIntegritySets: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sets = append(m.Sets, IntegritySetPayload{})
+			if err := m.Sets[len(m.Sets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitIntegritySetsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitIntegritySetsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitIntegritySetsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetCount", wireType)
+			}
+			m.SetCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SetCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantTenantWriter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantTenantWriter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantTenantWriter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypePrefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypePrefixes = append(m.TypePrefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExpiresAt = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGrantTenantWriterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGrantTenantWriterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGrantTenantWriterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeTenantWriter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeTenantWriter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeTenantWriter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Writer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Writer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeTenantWriterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeTenantWriterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeTenantWriterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupersedeIntegritySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupersedeIntegritySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupersedeIntegritySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, IntegrityRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReasonHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupersedeIntegritySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupersedeIntegritySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupersedeIntegritySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenIntegritySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenIntegritySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenIntegritySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgOpenIntegritySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgOpenIntegritySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgOpenIntegritySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppendIntegrityRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppendIntegrityRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppendIntegrityRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, IntegrityRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAppendIntegrityRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAppendIntegrityRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAppendIntegrityRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealIntegritySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealIntegritySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealIntegritySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSealIntegritySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSealIntegritySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSealIntegritySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelIntegritySet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelIntegritySet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelIntegritySet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelIntegritySetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelIntegritySetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelIntegritySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnchorIntegrityRoot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnchorIntegrityRoot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnchorIntegrityRoot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordCount", wireType)
+			}
+			m.RecordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecordCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageUri", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageUri = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageDigest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageDigest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashAlgorithm", wireType)
+			}
+			m.HashAlgorithm = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashAlgorithm |= HashAlgorithm(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAnchorIntegrityRootResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAnchorIntegrityRootResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAnchorIntegrityRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPeriodFormat) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPeriodFormat: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPeriodFormat: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Format", wireType)
+			}
+			m.Format = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Format |= PeriodFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetPeriodFormatResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetPeriodFormatResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetPeriodFormatResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterIntegrityType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterIntegrityType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterIntegrityType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchemaHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SchemaHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodFormat", wireType)
+			}
+			m.PeriodFormat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PeriodFormat |= PeriodFormat(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HashScheme", wireType)
+			}
+			m.HashScheme = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HashScheme |= HashScheme(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterIntegrityTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterIntegrityTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterIntegrityTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeIntegrityRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeIntegrityRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeIntegrityRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Period = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReasonCode", wireType)
+			}
+			m.ReasonCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReasonCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeIntegrityRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeIntegrityRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeIntegrityRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeTenant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeTenant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeTenant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeTenantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeTenantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeTenant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeTenant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeTenant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnfreezeTenantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnfreezeTenantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnfreezeTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArchiveTenant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArchiveTenant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArchiveTenant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgArchiveTenantResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgArchiveTenantResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgArchiveTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTenantSigners) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTenantSigners: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTenantSigners: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tenant = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetTenantSignersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetTenantSignersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetTenantSignersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func (m *MsgCommitIntegritySetResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *TenantAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1578,17 +13401,17 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: TenantAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TenantAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitIntegritySet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1598,27 +13421,275 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			v := &MsgCommitIntegritySet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_CommitIntegritySet{v}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersedeIntegritySet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgSupersedeIntegritySet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_SupersedeIntegritySet{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeIntegrityRecords", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgRevokeIntegrityRecords{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_RevokeIntegrityRecords{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferTenantOwnership", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgTransferTenantOwnership{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_TransferTenantOwnership{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelTenantOwnershipTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgCancelTenantOwnershipTransfer{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_CancelTenantOwnershipTransfer{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SetTenantSigners", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgSetTenantSigners{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_SetTenantSigners{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantTenantWriter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgGrantTenantWriter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_GrantTenantWriter{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeTenantWriter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &MsgRevokeTenantWriter{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_RevokeTenantWriter{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreezeTenant", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1645,115 +13716,17 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			v := &MsgFreezeTenant{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
+			m.Action = &TenantAction_FreezeTenant{v}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRegisterTenant) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterTenant: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterTenant: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UnfreezeTenant", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1763,29 +13736,32 @@ func (m *MsgRegisterTenant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			v := &MsgUnfreezeTenant{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_UnfreezeTenant{v}
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ArchiveTenant", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1795,129 +13771,32 @@ func (m *MsgRegisterTenant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRegisterTenantResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRegisterTenantResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRegisterTenantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			v := &MsgArchiveTenant{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTransferTenantOwnership) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferTenantOwnership: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferTenantOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Action = &TenantAction_ArchiveTenant{v}
+			iNdEx = postIndex
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetPeriodFormat", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1927,29 +13806,32 @@ func (m *MsgTransferTenantOwnership) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			v := &MsgSetPeriodFormat{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_SetPeriodFormat{v}
 			iNdEx = postIndex
-		case 2:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tenant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterIntegrityType", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1959,29 +13841,32 @@ func (m *MsgTransferTenantOwnership) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tenant = string(dAtA[iNdEx:postIndex])
+			v := &MsgRegisterIntegrityType{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Action = &TenantAction_RegisterIntegrityType{v}
 			iNdEx = postIndex
-		case 3:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CancelIntegritySet", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1991,74 +13876,27 @@ func (m *MsgTransferTenantOwnership) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			v := &MsgCancelIntegritySet{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgTransferTenantOwnershipResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgTransferTenantOwnershipResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgTransferTenantOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Action = &TenantAction_CancelIntegritySet{v}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2080,7 +13918,7 @@ func (m *MsgTransferTenantOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptTenantOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeTenantAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2103,10 +13941,10 @@ func (m *MsgAcceptTenantOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptTenantOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeTenantAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptTenantOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeTenantAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2173,6 +14011,39 @@ func (m *MsgAcceptTenantOwnership) Unmarshal(dAtA []byte) error {
 			}
 			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Action.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2194,7 +14065,7 @@ func (m *MsgAcceptTenantOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAcceptTenantOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgProposeTenantActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2217,12 +14088,31 @@ func (m *MsgAcceptTenantOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAcceptTenantOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgProposeTenantActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAcceptTenantOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgProposeTenantActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2244,7 +14134,7 @@ func (m *MsgAcceptTenantOwnershipResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelTenantOwnershipTransfer) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveTenantAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2267,10 +14157,10 @@ func (m *MsgCancelTenantOwnershipTransfer) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelTenantOwnershipTransfer: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveTenantAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelTenantOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveTenantAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2337,6 +14227,25 @@ func (m *MsgCancelTenantOwnershipTransfer) Unmarshal(dAtA []byte) error {
 			}
 			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
+			}
+			m.ProposalId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposalId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2358,7 +14267,7 @@ func (m *MsgCancelTenantOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelTenantOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgApproveTenantActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2381,10 +14290,10 @@ func (m *MsgCancelTenantOwnershipTransferResponse) Unmarshal(dAtA []byte) error
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelTenantOwnershipTransferResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgApproveTenantActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelTenantOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgApproveTenantActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2408,7 +14317,7 @@ func (m *MsgCancelTenantOwnershipTransferResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
-func (m *MsgCommitIntegritySet) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteTenantAction) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2431,10 +14340,10 @@ func (m *MsgCommitIntegritySet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitIntegritySet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteTenantAction: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitIntegritySet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteTenantAction: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2502,106 +14411,10 @@ func (m *MsgCommitIntegritySet) Unmarshal(dAtA []byte) error {
 			m.Tenant = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Type = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Period = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Root = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposalId", wireType)
 			}
-			var msglen int
+			m.ProposalId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2611,26 +14424,11 @@ func (m *MsgCommitIntegritySet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ProposalId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Records = append(m.Records, IntegrityRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2652,7 +14450,7 @@ func (m *MsgCommitIntegritySet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCommitIntegritySetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExecuteTenantActionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2675,10 +14473,10 @@ func (m *MsgCommitIntegritySetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCommitIntegritySetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExecuteTenantActionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCommitIntegritySetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExecuteTenantActionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6,14 +6,62 @@ const (
 	EventTypeTenantOwnershipTransferred      = "tenant_ownership_transferred"
 	EventTypeTenantOwnershipTransferCanceled = "tenant_ownership_transfer_canceled"
 	EventTypeIntegrityCommitted              = "integrity_set_committed"
+	EventTypeTenantWriterGranted             = "tenant_writer_granted"
+	EventTypeTenantWriterRevoked             = "tenant_writer_revoked"
+	EventTypeIntegritySetSuperseded          = "integrity_set_superseded"
+	EventTypeIntegritySetOpened              = "integrity_set_opened"
+	EventTypeIntegrityRecordsAppended        = "integrity_records_appended"
+	EventTypeIntegritySetSealed              = "integrity_set_sealed"
+	EventTypeIntegritySetCanceled            = "integrity_set_canceled"
+	EventTypeIntegritySetsCommitted          = "integrity_sets_committed"
+	EventTypeIntegrityRootAnchored           = "integrity_root_anchored"
+	EventTypePeriodFormatSet                 = "period_format_set"
+	EventTypeIntegrityTypeRegistered         = "integrity_type_registered"
+	EventTypeIntegrityRecordsRevoked         = "integrity_records_revoked"
+	EventTypeTenantFrozen                    = "tenant_frozen"
+	EventTypeTenantUnfrozen                  = "tenant_unfrozen"
+	EventTypeTenantArchived                  = "tenant_archived"
+	EventTypeTenantOwnershipTransferExpired  = "tenant_ownership_transfer_expired"
+	EventTypeTenantSignersSet                = "tenant_signers_set"
+	EventTypeTenantActionProposed            = "tenant_action_proposed"
+	EventTypeTenantActionApproved            = "tenant_action_approved"
+	EventTypeTenantActionExecuted            = "tenant_action_executed"
 
-	AttributeKeyTenant        = "tenant"
-	AttributeKeyOwner         = "owner"
-	AttributeKeyPendingOwner  = "pending_owner"
-	AttributeKeyPreviousOwner = "previous_owner"
-	AttributeKeyType          = "type"
-	AttributeKeyPeriod        = "period"
-	AttributeKeyRoot          = "root"
-	AttributeKeyCreator       = "creator"
-	AttributeKeyRecordCount   = "record_count"
+	AttributeKeyTenant              = "tenant"
+	AttributeKeyOwner               = "owner"
+	AttributeKeyPendingOwner        = "pending_owner"
+	AttributeKeyPreviousOwner       = "previous_owner"
+	AttributeKeyType                = "type"
+	AttributeKeyPeriod              = "period"
+	AttributeKeyRoot                = "root"
+	AttributeKeyCreator             = "creator"
+	AttributeKeyRecordCount         = "record_count"
+	AttributeKeyStorageFee          = "storage_fee"
+	AttributeKeyWriter              = "writer"
+	AttributeKeyTypePrefixes        = "type_prefixes"
+	AttributeKeyExpiresAt           = "expires_at"
+	AttributeKeyVersion             = "version"
+	AttributeKeyPreviousRoot        = "previous_root"
+	AttributeKeyReasonHash          = "reason_hash"
+	AttributeKeyAppendedCount       = "appended_count"
+	AttributeKeySetCount            = "set_count"
+	AttributeKeyStorageURI          = "storage_uri"
+	AttributeKeyStorageDigest       = "storage_digest"
+	AttributeKeyHashScheme          = "hash_scheme"
+	AttributeKeyHashAlgorithm       = "hash_algorithm"
+	AttributeKeyChainPreviousPeriod = "chain_previous_period"
+	AttributeKeyChainPreviousRoot   = "chain_previous_root"
+	AttributeKeyChainLength         = "chain_length"
+	AttributeKeyPeriodFormat        = "period_format"
+	AttributeKeySchemaHash          = "schema_hash"
+	AttributeKeyDeprecated          = "deprecated"
+	AttributeKeyTags                = "tags"
+	AttributeKeyReasonCode          = "reason_code"
+	AttributeKeyRevokedCount        = "revoked_count"
+	AttributeKeyExpiresHeight       = "expires_height"
+	AttributeKeySigners             = "signers"
+	AttributeKeyThreshold           = "threshold"
+	AttributeKeyProposalID          = "proposal_id"
+	AttributeKeyAction              = "action"
+	AttributeKeyApprovalCount       = "approval_count"
 )
@@ -39,8 +39,8 @@ func NormalizeTenant(tenant string) (string, error) {
 	switch {
 	case tenant == "":
 		return "", ErrInvalidTenant.Wrap("tenant must not be empty")
-	case len(tenant) > MaxTenantLength:
-		return "", ErrInvalidTenant.Wrapf("tenant exceeds maximum length %d", MaxTenantLength)
+	case uint64(len(tenant)) > AbsoluteMaxTenantLength:
+		return "", ErrInvalidTenant.Wrapf("tenant exceeds absolute maximum length %d", AbsoluteMaxTenantLength)
 	case !tenantPattern.MatchString(tenant):
 		return "", ErrInvalidTenant.Wrap("tenant contains unsupported characters")
 	default:
@@ -53,8 +53,8 @@ func NormalizeIntegrityType(integrityType string) (string, error) {
 	switch {
 	case integrityType == "":
 		return "", ErrInvalidType.Wrap("type must not be empty")
-	case len(integrityType) > MaxTypeLength:
-		return "", ErrInvalidType.Wrapf("type exceeds maximum length %d", MaxTypeLength)
+	case uint64(len(integrityType)) > AbsoluteMaxTypeLength:
+		return "", ErrInvalidType.Wrapf("type exceeds absolute maximum length %d", AbsoluteMaxTypeLength)
 	case !typePattern.MatchString(integrityType):
 		return "", ErrInvalidType.Wrap("type contains unsupported characters")
 	default:
@@ -67,9 +67,9 @@ func NormalizePeriod(period string) (string, error) {
 	switch {
 	case period == "":
 		return "", ErrInvalidPeriod.Wrap("period must not be empty")
-	case len(period) > MaxPeriodLength:
-		return "", ErrInvalidPeriod.Wrapf("period exceeds maximum length %d", MaxPeriodLength)
-	case strings.ContainsAny(period, "\r\n\t"):
+	case uint64(len(period)) > AbsoluteMaxPeriodLength:
+		return "", ErrInvalidPeriod.Wrapf("period exceeds absolute maximum length %d", AbsoluteMaxPeriodLength)
+	case strings.ContainsAny(period, "\x00\r\n\t"):
 		return "", ErrInvalidPeriod.Wrap("period must not contain control characters")
 	default:
 		return period, nil
@@ -80,6 +80,10 @@ func NormalizeRoot(root string) (string, error) {
 	return normalizeFixedLengthHex(root, 32, ErrInvalidRoot, "root")
 }
 
+func NormalizeReasonHash(reasonHash string) (string, error) {
+	return normalizeFixedLengthHex(reasonHash, 32, ErrInvalidReasonHash, "reason hash")
+}
+
 func NormalizeTag(tag string) (string, error) {
 	return normalizeTag(tag)
 }
@@ -132,13 +136,67 @@ func normalizeHexBytes(value string, maxBytes int) (string, []byte, error) {
 	return "0x" + hexPart, decoded, nil
 }
 
+// PrepareIntegrityRecords normalizes, sorts and deduplicates records under the absolute limits only, not the
+// size limits from Params. Every set accepted on chain fits the absolute limits, so offline tooling relies on
+// it to reproduce roots; on-chain callers use Params.PrepareIntegrityRecords instead.
 func PrepareIntegrityRecords(records []IntegrityRecord) ([]IntegrityRecord, int, error) {
+	if uint64(len(records)) > AbsoluteMaxRecordsPerSet {
+		return nil, 0, ErrTooManyRecords.Wrapf("absolute maximum records per set is %d", AbsoluteMaxRecordsPerSet)
+	}
+	return prepareIntegrityRecords(records, int(AbsoluteMaxNonceBytes), int(AbsoluteMaxCiphertextBytes), int(AbsoluteMaxTotalCiphertextBytes))
+}
+
+// PrepareIntegrityRecords normalizes, sorts and deduplicates records while enforcing the record count and
+// ciphertext size limits configured in the params. Params.Validate keeps the limits at or below the absolute
+// limits, so they convert to int without overflow.
+func (p Params) PrepareIntegrityRecords(records []IntegrityRecord) ([]IntegrityRecord, int, error) {
+	if uint64(len(records)) > p.MaxRecordsPerSet {
+		return nil, 0, ErrTooManyRecords.Wrapf("maximum records per set is %d", p.MaxRecordsPerSet)
+	}
+	return prepareIntegrityRecords(records, int(p.MaxNonceBytes), int(p.MaxCiphertextBytes), int(p.MaxTotalCiphertextBytes))
+}
+
+// NormalizeTenant normalizes a tenant name and enforces the configured maximum length.
+func (p Params) NormalizeTenant(tenant string) (string, error) {
+	tenant, err := NormalizeTenant(tenant)
+	if err != nil {
+		return "", err
+	}
+	if uint64(len(tenant)) > p.MaxTenantLength {
+		return "", ErrInvalidTenant.Wrapf("tenant exceeds maximum length %d", p.MaxTenantLength)
+	}
+	return tenant, nil
+}
+
+// NormalizeIntegrityType normalizes an integrity type and enforces the configured maximum length.
+func (p Params) NormalizeIntegrityType(integrityType string) (string, error) {
+	integrityType, err := NormalizeIntegrityType(integrityType)
+	if err != nil {
+		return "", err
+	}
+	if uint64(len(integrityType)) > p.MaxTypeLength {
+		return "", ErrInvalidType.Wrapf("type exceeds maximum length %d", p.MaxTypeLength)
+	}
+	return integrityType, nil
+}
+
+// NormalizePeriod normalizes a period and enforces the configured maximum length.
+func (p Params) NormalizePeriod(period string) (string, error) {
+	period, err := NormalizePeriod(period)
+	if err != nil {
+		return "", err
+	}
+	if uint64(len(period)) > p.MaxPeriodLength {
+		return "", ErrInvalidPeriod.Wrapf("period exceeds maximum length %d", p.MaxPeriodLength)
+	}
+	return period, nil
+}
+
+// prepareIntegrityRecords applies the shared record canonicalization under the given size limits.
+func prepareIntegrityRecords(records []IntegrityRecord, maxNonceBytes, maxCiphertextBytes, maxTotalCiphertextBytes int) ([]IntegrityRecord, int, error) {
 	if len(records) == 0 {
 		return nil, 0, ErrEmptyRecords
 	}
-	if len(records) > MaxRecordsPerSet {
-		return nil, 0, ErrTooManyRecords.Wrapf("maximum records per set is %d", MaxRecordsPerSet)
-	}
 
 	prepared := make([]IntegrityRecord, len(records))
 	totalCiphertextBytes := 0
@@ -147,17 +205,17 @@ func PrepareIntegrityRecords(records []IntegrityRecord) ([]IntegrityRecord, int,
 		if err != nil {
 			return nil, 0, err
 		}
-		nonce, _, err := normalizeVariableLengthHex(records[i].Nonce, MaxNonceBytes, ErrInvalidRecord, "nonce")
+		nonce, _, err := normalizeVariableLengthHex(records[i].Nonce, maxNonceBytes, ErrInvalidRecord, "nonce")
 		if err != nil {
 			return nil, 0, err
 		}
-		ciphertext, ciphertextBytes, err := normalizeVariableLengthHex(records[i].Ciphertext, MaxCiphertextBytes, ErrInvalidRecord, "ciphertext")
+		ciphertext, ciphertextBytes, err := normalizeVariableLengthHex(records[i].Ciphertext, maxCiphertextBytes, ErrInvalidRecord, "ciphertext")
 		if err != nil {
 			return nil, 0, err
 		}
 		totalCiphertextBytes += ciphertextBytes
-		if totalCiphertextBytes > MaxTotalCiphertextBytes {
-			return nil, 0, ErrTotalCiphertextTooLarge.Wrapf("maximum total ciphertext size is %d bytes", MaxTotalCiphertextBytes)
+		if totalCiphertextBytes > maxTotalCiphertextBytes {
+			return nil, 0, ErrTotalCiphertextTooLarge.Wrapf("maximum total ciphertext size is %d bytes", maxTotalCiphertextBytes)
 		}
 		prepared[i] = IntegrityRecord{
 			Tag:        tag,
@@ -1,6 +1,8 @@
 package types_test
 
 import (
+	"math"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -42,3 +44,52 @@ func TestNormalizeFieldConstraints(t *testing.T) {
 	})
 	require.ErrorIs(t, err, types.ErrInvalidRecord)
 }
+
+func TestParamsLimits(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxTenantLength = 4
+	params.MaxRecordsPerSet = 1
+	params.MaxNonceBytes = 1
+
+	_, err := params.NormalizeTenant("acme")
+	require.NoError(t, err)
+	_, err = params.NormalizeTenant("acme-labs")
+	require.ErrorIs(t, err, types.ErrInvalidTenant)
+
+	records := []types.IntegrityRecord{
+		{Tag: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Nonce: "0x0102", Ciphertext: "0x0304"},
+		{Tag: "0xbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbbb", Nonce: "0x01", Ciphertext: "0x0304"},
+	}
+	_, _, err = params.PrepareIntegrityRecords(records)
+	require.ErrorIs(t, err, types.ErrTooManyRecords)
+	_, _, err = params.PrepareIntegrityRecords(records[:1])
+	require.ErrorIs(t, err, types.ErrInvalidRecord)
+	_, _, err = params.PrepareIntegrityRecords(records[1:])
+	require.NoError(t, err)
+
+	// The package-level helper stays limit-free so offline tooling can rebuild roots.
+	_, _, err = types.PrepareIntegrityRecords(records)
+	require.NoError(t, err)
+}
+
+func TestAbsoluteLimits(t *testing.T) {
+	_, err := types.NormalizeTenant(strings.Repeat("a", int(types.AbsoluteMaxTenantLength)+1))
+	require.ErrorIs(t, err, types.ErrInvalidTenant)
+	_, err = types.NormalizeIntegrityType(strings.Repeat("a", int(types.AbsoluteMaxTypeLength)+1))
+	require.ErrorIs(t, err, types.ErrInvalidType)
+	_, err = types.NormalizePeriod(strings.Repeat("1", int(types.AbsoluteMaxPeriodLength)+1))
+	require.ErrorIs(t, err, types.ErrInvalidPeriod)
+
+	_, _, err = types.PrepareIntegrityRecords([]types.IntegrityRecord{
+		{Tag: "0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa", Nonce: "0x" + strings.Repeat("01", int(types.AbsoluteMaxNonceBytes)+1), Ciphertext: "0x0304"},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidRecord)
+
+	// Params cannot lift a limit above its absolute maximum, which would also overflow the int conversions.
+	params := types.DefaultParams()
+	params.MaxNonceBytes = math.MaxUint64
+	require.ErrorContains(t, params.Validate(), "exceeds the absolute maximum")
+	params = types.DefaultParams()
+	params.MaxTenantLength = types.AbsoluteMaxTenantLength
+	require.NoError(t, params.Validate())
+}
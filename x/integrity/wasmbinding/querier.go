@@ -0,0 +1,224 @@
+package wasmbinding
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Kudora-Labs/kudora/x/integrity/keeper"
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+)
+
+// RecordByteGas is charged for every record byte returned to a contract or hashed while verifying a proof,
+// on top of the SDK KV gas of the store reads. Proof siblings count as record bytes.
+const RecordByteGas uint64 = 10
+
+// CustomQuerier serves KudoraQuery custom queries from the integrity keeper. Missing tenants, sets and
+// records are reported as null results rather than errors, so contracts can branch on them.
+func CustomQuerier(k keeper.Keeper) func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var query KudoraQuery
+		if err := json.Unmarshal(request, &query); err != nil {
+			return nil, wasmvmtypes.InvalidRequest{Err: err.Error(), Request: request}
+		}
+		if query.Integrity == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown kudora query variant"}
+		}
+
+		var (
+			response any
+			err      error
+		)
+		switch q := query.Integrity; {
+		case q.Tenant != nil && q.Set == nil && q.Record == nil && q.VerifyProof == nil:
+			response, err = queryTenant(ctx, k, q.Tenant)
+		case q.Set != nil && q.Tenant == nil && q.Record == nil && q.VerifyProof == nil:
+			response, err = querySet(ctx, k, q.Set)
+		case q.Record != nil && q.Tenant == nil && q.Set == nil && q.VerifyProof == nil:
+			response, err = queryRecord(ctx, k, q.Record)
+		case q.VerifyProof != nil && q.Tenant == nil && q.Set == nil && q.Record == nil:
+			response, err = queryVerifyProof(ctx, k, q.VerifyProof)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown integrity query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		return json.Marshal(response)
+	}
+}
+
+func queryTenant(ctx sdk.Context, k keeper.Keeper, q *TenantQuery) (TenantResponse, error) {
+	tenant, err := types.NormalizeTenant(q.Tenant)
+	if err != nil {
+		return TenantResponse{}, err
+	}
+
+	tenantData, err := k.GetTenant(ctx, tenant)
+	if err != nil {
+		if errors.Is(err, types.ErrTenantNotFound) {
+			return TenantResponse{}, nil
+		}
+		return TenantResponse{}, err
+	}
+
+	// Contracts decode signers as a Vec, which rejects null.
+	signers := tenantData.Signers
+	if signers == nil {
+		signers = []string{}
+	}
+
+	return TenantResponse{Tenant: &TenantInfo{
+		Tenant:        tenantData.Tenant,
+		Owner:         tenantData.Owner,
+		Status:        tenantData.Status.String(),
+		CreatedHeight: tenantData.CreatedHeight,
+		Signers:       signers,
+		Threshold:     tenantData.Threshold,
+	}}, nil
+}
+
+func querySet(ctx sdk.Context, k keeper.Keeper, q *SetQuery) (SetResponse, error) {
+	integritySet, found, err := loadSet(ctx, k, q.Tenant, q.Type, q.Period, q.Version)
+	if err != nil || !found {
+		return SetResponse{}, err
+	}
+
+	return SetResponse{Set: &SetInfo{
+		Tenant:         integritySet.Tenant,
+		Type:           integritySet.Type,
+		Period:         integritySet.Period,
+		Root:           integritySet.Root,
+		Creator:        integritySet.Creator,
+		Version:        integritySet.Version,
+		RecordCount:    integritySet.RecordCount,
+		BlockHeight:    integritySet.BlockHeight,
+		Open:           integritySet.Open,
+		RecordsOnChain: integritySet.RecordsOnChain(),
+		HashScheme:     integritySet.HashScheme.String(),
+		HashAlgorithm:  integritySet.HashAlgorithm.String(),
+	}}, nil
+}
+
+func queryRecord(ctx sdk.Context, k keeper.Keeper, q *RecordQuery) (RecordResponse, error) {
+	tag, err := types.NormalizeTag(q.Tag)
+	if err != nil {
+		return RecordResponse{}, err
+	}
+
+	integritySet, found, err := loadSet(ctx, k, q.Tenant, q.Type, q.Period, q.Version)
+	if err != nil || !found {
+		return RecordResponse{}, err
+	}
+	if !integritySet.RecordsOnChain() {
+		return RecordResponse{}, types.ErrRecordsNotOnChain.Wrapf("set %s/%s/%s is root-only", integritySet.Tenant, integritySet.Type, integritySet.Period)
+	}
+
+	record, err := k.GetIntegrityRecordForVersion(ctx, integritySet.Tenant, integritySet.Type, integritySet.Period, integritySet.Version, tag)
+	if err != nil {
+		if errors.Is(err, types.ErrIntegrityRecordNotFound) {
+			return RecordResponse{}, nil
+		}
+		return RecordResponse{}, err
+	}
+	_, revoked, err := k.GetRecordRevocation(ctx, integritySet.Tenant, integritySet.Type, integritySet.Period, tag)
+	if err != nil {
+		return RecordResponse{}, err
+	}
+
+	ctx.GasMeter().ConsumeGas(RecordByteGas*recordByteLen(record.Tag, record.Nonce, record.Ciphertext), "integrity wasm record")
+
+	return RecordResponse{
+		Record: &Record{
+			Tag:        record.Tag,
+			Nonce:      record.Nonce,
+			Ciphertext: record.Ciphertext,
+		},
+		Revoked: revoked,
+	}, nil
+}
+
+// queryVerifyProof uses the hash scheme and hash algorithm recorded on the set, so it serves root-only sets as
+// well. Malformed records and proofs are errors; a proof that does not resolve to the root is not.
+func queryVerifyProof(ctx sdk.Context, k keeper.Keeper, q *VerifyProofQuery) (VerifyProofResponse, error) {
+	// Hashing happens outside the store, so it is metered here rather than through the KV gas config.
+	hashedBytes := recordByteLen(q.Record.Tag, q.Record.Nonce, q.Record.Ciphertext) + recordByteLen(q.Proof.Siblings...)
+	ctx.GasMeter().ConsumeGas(RecordByteGas*hashedBytes, "integrity wasm proof verification")
+
+	integritySet, found, err := loadSet(ctx, k, q.Tenant, q.Type, q.Period, q.Version)
+	if err != nil || !found {
+		return VerifyProofResponse{}, err
+	}
+
+	record := types.IntegrityRecord{
+		Tag:        q.Record.Tag,
+		Nonce:      q.Record.Nonce,
+		Ciphertext: q.Record.Ciphertext,
+	}
+	proof := types.IntegrityRecordProof{
+		LeafIndex:     q.Proof.LeafIndex,
+		LeafCount:     q.Proof.LeafCount,
+		Siblings:      q.Proof.Siblings,
+		SiblingOnLeft: q.Proof.SiblingOnLeft,
+		HashScheme:    integritySet.HashScheme,
+		HashAlgorithm: integritySet.HashAlgorithm,
+	}
+	valid := true
+	if err := types.VerifyRecordProof(integritySet.Root, record, proof); err != nil {
+		if !errors.Is(err, types.ErrInvalidProof) && !errors.Is(err, types.ErrRootMismatch) {
+			return VerifyProofResponse{}, err
+		}
+		valid = false
+	}
+
+	tag, err := types.NormalizeTag(q.Record.Tag)
+	if err != nil {
+		return VerifyProofResponse{}, err
+	}
+	_, revoked, err := k.GetRecordRevocation(ctx, integritySet.Tenant, integritySet.Type, integritySet.Period, tag)
+	if err != nil {
+		return VerifyProofResponse{}, err
+	}
+
+	return VerifyProofResponse{Valid: valid, Revoked: revoked}, nil
+}
+
+// loadSet normalizes the set key and loads the requested version, reporting a missing set as not found.
+func loadSet(ctx sdk.Context, k keeper.Keeper, tenant, integrityType, period string, version uint64) (types.IntegritySet, bool, error) {
+	tenant, err := types.NormalizeTenant(tenant)
+	if err != nil {
+		return types.IntegritySet{}, false, err
+	}
+	integrityType, err = types.NormalizeIntegrityType(integrityType)
+	if err != nil {
+		return types.IntegritySet{}, false, err
+	}
+	period, err = k.NormalizePeriod(ctx, tenant, integrityType, period)
+	if err != nil {
+		return types.IntegritySet{}, false, err
+	}
+
+	integritySet, err := k.GetIntegritySetVersion(ctx, tenant, integrityType, period, version)
+	if err != nil {
+		if errors.Is(err, types.ErrIntegritySetNotFound) {
+			return types.IntegritySet{}, false, nil
+		}
+		return types.IntegritySet{}, false, err
+	}
+
+	return integritySet, true, nil
+}
+
+// recordByteLen returns the decoded length of hex-encoded record fields.
+func recordByteLen(fields ...string) uint64 {
+	var n uint64
+	for _, field := range fields {
+		n += uint64(len(strings.TrimPrefix(field, "0x")) / 2)
+	}
+	return n
+}
@@ -0,0 +1,231 @@
+package wasmbinding_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v3/types"
+	"github.com/stretchr/testify/require"
+
+	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	storetypes "github.com/cosmos/cosmos-sdk/store/v2/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Kudora-Labs/kudora/testutil/integritymock"
+	"github.com/Kudora-Labs/kudora/x/integrity/keeper"
+	module "github.com/Kudora-Labs/kudora/x/integrity/module"
+	"github.com/Kudora-Labs/kudora/x/integrity/types"
+	"github.com/Kudora-Labs/kudora/x/integrity/wasmbinding"
+)
+
+const (
+	testTenant = "acme"
+	testType   = "acme.daily.v1"
+	testPeriod = "2026-06-25"
+)
+
+// noopBankKeeper and noopDistributionKeeper satisfy the keeper under the default zero storage fees.
+type noopBankKeeper struct{}
+
+func (noopBankKeeper) SendCoinsFromAccountToModule(context.Context, sdk.AccAddress, string, sdk.Coins) error {
+	return nil
+}
+
+func (noopBankKeeper) BurnCoins(context.Context, string, sdk.Coins) error {
+	return nil
+}
+
+type noopDistributionKeeper struct{}
+
+func (noopDistributionKeeper) FundCommunityPool(context.Context, sdk.Coins, sdk.AccAddress) error {
+	return nil
+}
+
+// setupQuerier commits a three-record set for acme, revokes its last sorted record and returns the custom
+// querier reading it.
+func setupQuerier(t *testing.T) (sdk.Context, keeper.Keeper, func(sdk.Context, json.RawMessage) ([]byte, error), integritymock.MockSet, string) {
+	t.Helper()
+
+	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
+
+	k := keeper.NewKeeper(
+		runtime.NewKVStoreService(storeKey),
+		encCfg.Codec,
+		addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(types.GovModuleName),
+		noopBankKeeper{},
+		noopDistributionKeeper{},
+	)
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+
+	owner := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.RegisterTenant(ctx, &types.MsgRegisterTenant{Creator: owner, Tenant: testTenant})
+	require.NoError(t, err)
+
+	mockSet, err := integritymock.BuildMockSet(3, testTenant, testType, testPeriod)
+	require.NoError(t, err)
+	_, err = msgServer.CommitIntegritySet(ctx, &types.MsgCommitIntegritySet{
+		Creator: owner,
+		Tenant:  testTenant,
+		Type:    testType,
+		Period:  testPeriod,
+		Root:    mockSet.Root,
+		Records: mockSet.Records,
+	})
+	require.NoError(t, err)
+
+	_, err = msgServer.RevokeIntegrityRecords(ctx, &types.MsgRevokeIntegrityRecords{
+		Creator:    owner,
+		Tenant:     testTenant,
+		Type:       testType,
+		Period:     testPeriod,
+		Tags:       []string{mockSet.SortedTags[2]},
+		ReasonCode: 1,
+	})
+	require.NoError(t, err)
+
+	return ctx, k, wasmbinding.CustomQuerier(k), mockSet, owner
+}
+
+// query runs a raw KudoraQuery and decodes the response into out.
+func query(t *testing.T, ctx sdk.Context, querier func(sdk.Context, json.RawMessage) ([]byte, error), request string, out any) error {
+	t.Helper()
+
+	bz, err := querier(ctx, json.RawMessage(request))
+	if err != nil {
+		return err
+	}
+	require.NoError(t, json.Unmarshal(bz, out))
+	return nil
+}
+
+func TestCustomQuerierTenant(t *testing.T) {
+	ctx, _, querier, _, owner := setupQuerier(t)
+
+	var res wasmbinding.TenantResponse
+	require.NoError(t, query(t, ctx, querier, `{"integrity":{"tenant":{"tenant":"ACME"}}}`, &res))
+	require.NotNil(t, res.Tenant)
+	require.Equal(t, testTenant, res.Tenant.Tenant)
+	require.Equal(t, owner, res.Tenant.Owner)
+	require.Equal(t, types.TenantStatus_TENANT_STATUS_ACTIVE.String(), res.Tenant.Status)
+	require.NotNil(t, res.Tenant.Signers)
+
+	res = wasmbinding.TenantResponse{}
+	require.NoError(t, query(t, ctx, querier, `{"integrity":{"tenant":{"tenant":"globex"}}}`, &res))
+	require.Nil(t, res.Tenant)
+
+	err := query(t, ctx, querier, `{"integrity":{"tenant":{"tenant":"bad tenant!"}}}`, &res)
+	require.ErrorIs(t, err, types.ErrInvalidTenant)
+}
+
+func TestCustomQuerierSetAndRecord(t *testing.T) {
+	ctx, _, querier, mockSet, owner := setupQuerier(t)
+
+	var set wasmbinding.SetResponse
+	require.NoError(t, query(t, ctx, querier, fmt.Sprintf(`{"integrity":{"set":{"tenant":%q,"type":%q,"period":%q}}}`, testTenant, testType, testPeriod), &set))
+	require.NotNil(t, set.Set)
+	require.Equal(t, mockSet.Root, set.Set.Root)
+	require.Equal(t, owner, set.Set.Creator)
+	require.Equal(t, uint64(1), set.Set.Version)
+	require.Equal(t, uint64(3), set.Set.RecordCount)
+	require.True(t, set.Set.RecordsOnChain)
+
+	set = wasmbinding.SetResponse{}
+	require.NoError(t, query(t, ctx, querier, fmt.Sprintf(`{"integrity":{"set":{"tenant":%q,"type":%q,"period":%q,"version":2}}}`, testTenant, testType, testPeriod), &set))
+	require.Nil(t, set.Set)
+
+	recordQuery := func(tag string) string {
+		return fmt.Sprintf(`{"integrity":{"record":{"tenant":%q,"type":%q,"period":%q,"tag":%q}}}`, testTenant, testType, testPeriod, tag)
+	}
+
+	var record wasmbinding.RecordResponse
+	consumed := ctx.GasMeter().GasConsumed()
+	require.NoError(t, query(t, ctx, querier, recordQuery(mockSet.SortedTags[0]), &record))
+	require.NotNil(t, record.Record)
+	require.Equal(t, mockSet.SortedRecords[0].Ciphertext, record.Record.Ciphertext)
+	require.False(t, record.Revoked)
+	recordBytes := uint64(len(mockSet.SortedRecords[0].Tag)+len(mockSet.SortedRecords[0].Nonce)+len(mockSet.SortedRecords[0].Ciphertext)-6) / 2
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed()-consumed, wasmbinding.RecordByteGas*recordBytes)
+
+	record = wasmbinding.RecordResponse{}
+	require.NoError(t, query(t, ctx, querier, recordQuery(mockSet.SortedTags[2]), &record))
+	require.NotNil(t, record.Record)
+	require.True(t, record.Revoked)
+
+	record = wasmbinding.RecordResponse{}
+	require.NoError(t, query(t, ctx, querier, recordQuery("0x"+fmt.Sprintf("%064x", 1)), &record))
+	require.Nil(t, record.Record)
+}
+
+func TestCustomQuerierVerifyProof(t *testing.T) {
+	ctx, k, querier, mockSet, _ := setupQuerier(t)
+
+	proofQuery := func(index int, mutate func(*wasmbinding.VerifyProofQuery)) string {
+		record := mockSet.SortedRecords[index]
+		proof, err := k.GetIntegrityRecordProof(ctx, testTenant, testType, testPeriod, 0, record.Tag)
+		require.NoError(t, err)
+
+		q := wasmbinding.VerifyProofQuery{
+			Tenant: testTenant,
+			Type:   testType,
+			Period: testPeriod,
+			Record: wasmbinding.Record{Tag: record.Tag, Nonce: record.Nonce, Ciphertext: record.Ciphertext},
+			Proof: wasmbinding.Proof{
+				LeafIndex:     proof.LeafIndex,
+				LeafCount:     proof.LeafCount,
+				Siblings:      proof.Siblings,
+				SiblingOnLeft: proof.SiblingOnLeft,
+			},
+		}
+		if mutate != nil {
+			mutate(&q)
+		}
+		bz, err := json.Marshal(wasmbinding.KudoraQuery{Integrity: &wasmbinding.IntegrityQuery{VerifyProof: &q}})
+		require.NoError(t, err)
+		return string(bz)
+	}
+
+	var res wasmbinding.VerifyProofResponse
+	require.NoError(t, query(t, ctx, querier, proofQuery(0, nil), &res))
+	require.Equal(t, wasmbinding.VerifyProofResponse{Valid: true}, res)
+
+	require.NoError(t, query(t, ctx, querier, proofQuery(2, nil), &res))
+	require.Equal(t, wasmbinding.VerifyProofResponse{Valid: true, Revoked: true}, res)
+
+	require.NoError(t, query(t, ctx, querier, proofQuery(1, func(q *wasmbinding.VerifyProofQuery) { q.Proof.LeafIndex = 0 }), &res))
+	require.Equal(t, wasmbinding.VerifyProofResponse{}, res)
+
+	require.NoError(t, query(t, ctx, querier, proofQuery(0, func(q *wasmbinding.VerifyProofQuery) { q.Period = "2026-06-26" }), &res))
+	require.Equal(t, wasmbinding.VerifyProofResponse{}, res)
+
+	err := query(t, ctx, querier, proofQuery(0, func(q *wasmbinding.VerifyProofQuery) { q.Record.Nonce = "" }), &res)
+	require.ErrorIs(t, err, types.ErrInvalidRecord)
+}
+
+func TestCustomQuerierRejectsUnknownVariants(t *testing.T) {
+	ctx, _, querier, _, _ := setupQuerier(t)
+
+	for _, request := range []string{
+		`{"bank":{}}`,
+		`{"integrity":{}}`,
+		`{"integrity":{"tenant":{"tenant":"acme"},"set":{"tenant":"acme","type":"acme.daily.v1","period":"2026-06-25"}}}`,
+	} {
+		_, err := querier(ctx, json.RawMessage(request))
+		var unsupported wasmvmtypes.UnsupportedRequest
+		require.ErrorAs(t, err, &unsupported, request)
+	}
+
+	_, err := querier(ctx, json.RawMessage(`{"integrity":`))
+	var invalid wasmvmtypes.InvalidRequest
+	require.ErrorAs(t, err, &invalid)
+}
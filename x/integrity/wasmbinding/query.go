@@ -0,0 +1,112 @@
+package wasmbinding
+
+// KudoraQuery is the custom query a contract sends as QueryRequest::Custom. Exactly one variant is set; it
+// mirrors the Rust enum `KudoraQuery::Integrity(IntegrityQuery)`.
+type KudoraQuery struct {
+	Integrity *IntegrityQuery `json:"integrity,omitempty"`
+}
+
+// IntegrityQuery lists the x/integrity reads served to contracts. Exactly one variant is set.
+type IntegrityQuery struct {
+	Tenant      *TenantQuery      `json:"tenant,omitempty"`
+	Set         *SetQuery         `json:"set,omitempty"`
+	Record      *RecordQuery      `json:"record,omitempty"`
+	VerifyProof *VerifyProofQuery `json:"verify_proof,omitempty"`
+}
+
+// TenantQuery reads a registered tenant.
+type TenantQuery struct {
+	Tenant string `json:"tenant"`
+}
+
+// SetQuery reads the header of a set. A zero version reads the latest one.
+type SetQuery struct {
+	Tenant  string `json:"tenant"`
+	Type    string `json:"type"`
+	Period  string `json:"period"`
+	Version uint64 `json:"version,omitempty"`
+}
+
+// RecordQuery reads a record of a set by tag. A zero version reads the latest one.
+type RecordQuery struct {
+	Tenant  string `json:"tenant"`
+	Type    string `json:"type"`
+	Period  string `json:"period"`
+	Tag     string `json:"tag"`
+	Version uint64 `json:"version,omitempty"`
+}
+
+// VerifyProofQuery checks a record inclusion proof against the root of a set. A zero version checks the
+// latest one.
+type VerifyProofQuery struct {
+	Tenant  string `json:"tenant"`
+	Type    string `json:"type"`
+	Period  string `json:"period"`
+	Record  Record `json:"record"`
+	Proof   Proof  `json:"proof"`
+	Version uint64 `json:"version,omitempty"`
+}
+
+// Record is a hex-encoded integrity record.
+type Record struct {
+	Tag        string `json:"tag"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// Proof is a hex-encoded record inclusion proof, as returned by the IntegrityRecordProof query.
+type Proof struct {
+	LeafIndex     uint64   `json:"leaf_index"`
+	LeafCount     uint64   `json:"leaf_count"`
+	Siblings      []string `json:"siblings"`
+	SiblingOnLeft []bool   `json:"sibling_on_left"`
+}
+
+// TenantResponse answers TenantQuery. Tenant is null when the tenant is not registered.
+type TenantResponse struct {
+	Tenant *TenantInfo `json:"tenant"`
+}
+
+// TenantInfo is the contract view of a tenant.
+type TenantInfo struct {
+	Tenant        string   `json:"tenant"`
+	Owner         string   `json:"owner"`
+	Status        string   `json:"status"`
+	CreatedHeight uint64   `json:"created_height"`
+	Signers       []string `json:"signers"`
+	Threshold     uint32   `json:"threshold"`
+}
+
+// SetResponse answers SetQuery. Set is null when the set or version does not exist.
+type SetResponse struct {
+	Set *SetInfo `json:"set"`
+}
+
+// SetInfo is the contract view of a set header.
+type SetInfo struct {
+	Tenant         string `json:"tenant"`
+	Type           string `json:"type"`
+	Period         string `json:"period"`
+	Root           string `json:"root"`
+	Creator        string `json:"creator"`
+	Version        uint64 `json:"version"`
+	RecordCount    uint64 `json:"record_count"`
+	BlockHeight    uint64 `json:"block_height"`
+	Open           bool   `json:"open"`
+	RecordsOnChain bool   `json:"records_on_chain"`
+	HashScheme     string `json:"hash_scheme"`
+	HashAlgorithm  string `json:"hash_algorithm"`
+}
+
+// RecordResponse answers RecordQuery. Record is null when the set or tag does not exist.
+type RecordResponse struct {
+	Record  *Record `json:"record"`
+	Revoked bool    `json:"revoked"`
+}
+
+// VerifyProofResponse answers VerifyProofQuery. Valid is false when the set does not exist or the proof does
+// not resolve to its root.
+type VerifyProofResponse struct {
+	Valid   bool `json:"valid"`
+	Revoked bool `json:"revoked"`
+}